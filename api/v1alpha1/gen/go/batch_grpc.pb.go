@@ -0,0 +1,286 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: batch.proto
+
+package _go
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	LogicalBridgeBatchService_BatchCreateLogicalBridges_FullMethodName = "/opi_evpn_bridge.v1alpha1.LogicalBridgeBatchService/BatchCreateLogicalBridges"
+	LogicalBridgeBatchService_BatchDeleteLogicalBridges_FullMethodName = "/opi_evpn_bridge.v1alpha1.LogicalBridgeBatchService/BatchDeleteLogicalBridges"
+)
+
+// LogicalBridgeBatchServiceClient is the client API for LogicalBridgeBatchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LogicalBridgeBatchServiceClient interface {
+	// Validate all requests, then create the LogicalBridges
+	BatchCreateLogicalBridges(ctx context.Context, in *BatchCreateLogicalBridgesRequest, opts ...grpc.CallOption) (*BatchCreateLogicalBridgesResponse, error)
+	// Validate all requests, then delete the LogicalBridges
+	BatchDeleteLogicalBridges(ctx context.Context, in *BatchDeleteLogicalBridgesRequest, opts ...grpc.CallOption) (*BatchDeleteLogicalBridgesResponse, error)
+}
+
+type logicalBridgeBatchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLogicalBridgeBatchServiceClient(cc grpc.ClientConnInterface) LogicalBridgeBatchServiceClient {
+	return &logicalBridgeBatchServiceClient{cc}
+}
+
+func (c *logicalBridgeBatchServiceClient) BatchCreateLogicalBridges(ctx context.Context, in *BatchCreateLogicalBridgesRequest, opts ...grpc.CallOption) (*BatchCreateLogicalBridgesResponse, error) {
+	out := new(BatchCreateLogicalBridgesResponse)
+	err := c.cc.Invoke(ctx, LogicalBridgeBatchService_BatchCreateLogicalBridges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicalBridgeBatchServiceClient) BatchDeleteLogicalBridges(ctx context.Context, in *BatchDeleteLogicalBridgesRequest, opts ...grpc.CallOption) (*BatchDeleteLogicalBridgesResponse, error) {
+	out := new(BatchDeleteLogicalBridgesResponse)
+	err := c.cc.Invoke(ctx, LogicalBridgeBatchService_BatchDeleteLogicalBridges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LogicalBridgeBatchServiceServer is the server API for LogicalBridgeBatchService service.
+// All implementations must embed UnimplementedLogicalBridgeBatchServiceServer
+// for forward compatibility
+type LogicalBridgeBatchServiceServer interface {
+	// Validate all requests, then create the LogicalBridges
+	BatchCreateLogicalBridges(context.Context, *BatchCreateLogicalBridgesRequest) (*BatchCreateLogicalBridgesResponse, error)
+	// Validate all requests, then delete the LogicalBridges
+	BatchDeleteLogicalBridges(context.Context, *BatchDeleteLogicalBridgesRequest) (*BatchDeleteLogicalBridgesResponse, error)
+	mustEmbedUnimplementedLogicalBridgeBatchServiceServer()
+}
+
+// UnimplementedLogicalBridgeBatchServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedLogicalBridgeBatchServiceServer struct {
+}
+
+func (UnimplementedLogicalBridgeBatchServiceServer) BatchCreateLogicalBridges(context.Context, *BatchCreateLogicalBridgesRequest) (*BatchCreateLogicalBridgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateLogicalBridges not implemented")
+}
+func (UnimplementedLogicalBridgeBatchServiceServer) BatchDeleteLogicalBridges(context.Context, *BatchDeleteLogicalBridgesRequest) (*BatchDeleteLogicalBridgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteLogicalBridges not implemented")
+}
+func (UnimplementedLogicalBridgeBatchServiceServer) mustEmbedUnimplementedLogicalBridgeBatchServiceServer() {
+}
+
+// UnsafeLogicalBridgeBatchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LogicalBridgeBatchServiceServer will
+// result in compilation errors.
+type UnsafeLogicalBridgeBatchServiceServer interface {
+	mustEmbedUnimplementedLogicalBridgeBatchServiceServer()
+}
+
+func RegisterLogicalBridgeBatchServiceServer(s grpc.ServiceRegistrar, srv LogicalBridgeBatchServiceServer) {
+	s.RegisterService(&LogicalBridgeBatchService_ServiceDesc, srv)
+}
+
+func _LogicalBridgeBatchService_BatchCreateLogicalBridges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateLogicalBridgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicalBridgeBatchServiceServer).BatchCreateLogicalBridges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogicalBridgeBatchService_BatchCreateLogicalBridges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicalBridgeBatchServiceServer).BatchCreateLogicalBridges(ctx, req.(*BatchCreateLogicalBridgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogicalBridgeBatchService_BatchDeleteLogicalBridges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteLogicalBridgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicalBridgeBatchServiceServer).BatchDeleteLogicalBridges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogicalBridgeBatchService_BatchDeleteLogicalBridges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicalBridgeBatchServiceServer).BatchDeleteLogicalBridges(ctx, req.(*BatchDeleteLogicalBridgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LogicalBridgeBatchService_ServiceDesc is the grpc.ServiceDesc for LogicalBridgeBatchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LogicalBridgeBatchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "opi_evpn_bridge.v1alpha1.LogicalBridgeBatchService",
+	HandlerType: (*LogicalBridgeBatchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BatchCreateLogicalBridges",
+			Handler:    _LogicalBridgeBatchService_BatchCreateLogicalBridges_Handler,
+		},
+		{
+			MethodName: "BatchDeleteLogicalBridges",
+			Handler:    _LogicalBridgeBatchService_BatchDeleteLogicalBridges_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "batch.proto",
+}
+
+const (
+	BridgePortBatchService_BatchCreateBridgePorts_FullMethodName = "/opi_evpn_bridge.v1alpha1.BridgePortBatchService/BatchCreateBridgePorts"
+	BridgePortBatchService_BatchDeleteBridgePorts_FullMethodName = "/opi_evpn_bridge.v1alpha1.BridgePortBatchService/BatchDeleteBridgePorts"
+)
+
+// BridgePortBatchServiceClient is the client API for BridgePortBatchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BridgePortBatchServiceClient interface {
+	// Validate all requests, then create the BridgePorts
+	BatchCreateBridgePorts(ctx context.Context, in *BatchCreateBridgePortsRequest, opts ...grpc.CallOption) (*BatchCreateBridgePortsResponse, error)
+	// Validate all requests, then delete the BridgePorts
+	BatchDeleteBridgePorts(ctx context.Context, in *BatchDeleteBridgePortsRequest, opts ...grpc.CallOption) (*BatchDeleteBridgePortsResponse, error)
+}
+
+type bridgePortBatchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBridgePortBatchServiceClient(cc grpc.ClientConnInterface) BridgePortBatchServiceClient {
+	return &bridgePortBatchServiceClient{cc}
+}
+
+func (c *bridgePortBatchServiceClient) BatchCreateBridgePorts(ctx context.Context, in *BatchCreateBridgePortsRequest, opts ...grpc.CallOption) (*BatchCreateBridgePortsResponse, error) {
+	out := new(BatchCreateBridgePortsResponse)
+	err := c.cc.Invoke(ctx, BridgePortBatchService_BatchCreateBridgePorts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgePortBatchServiceClient) BatchDeleteBridgePorts(ctx context.Context, in *BatchDeleteBridgePortsRequest, opts ...grpc.CallOption) (*BatchDeleteBridgePortsResponse, error) {
+	out := new(BatchDeleteBridgePortsResponse)
+	err := c.cc.Invoke(ctx, BridgePortBatchService_BatchDeleteBridgePorts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BridgePortBatchServiceServer is the server API for BridgePortBatchService service.
+// All implementations must embed UnimplementedBridgePortBatchServiceServer
+// for forward compatibility
+type BridgePortBatchServiceServer interface {
+	// Validate all requests, then create the BridgePorts
+	BatchCreateBridgePorts(context.Context, *BatchCreateBridgePortsRequest) (*BatchCreateBridgePortsResponse, error)
+	// Validate all requests, then delete the BridgePorts
+	BatchDeleteBridgePorts(context.Context, *BatchDeleteBridgePortsRequest) (*BatchDeleteBridgePortsResponse, error)
+	mustEmbedUnimplementedBridgePortBatchServiceServer()
+}
+
+// UnimplementedBridgePortBatchServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBridgePortBatchServiceServer struct {
+}
+
+func (UnimplementedBridgePortBatchServiceServer) BatchCreateBridgePorts(context.Context, *BatchCreateBridgePortsRequest) (*BatchCreateBridgePortsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateBridgePorts not implemented")
+}
+func (UnimplementedBridgePortBatchServiceServer) BatchDeleteBridgePorts(context.Context, *BatchDeleteBridgePortsRequest) (*BatchDeleteBridgePortsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteBridgePorts not implemented")
+}
+func (UnimplementedBridgePortBatchServiceServer) mustEmbedUnimplementedBridgePortBatchServiceServer() {
+}
+
+// UnsafeBridgePortBatchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BridgePortBatchServiceServer will
+// result in compilation errors.
+type UnsafeBridgePortBatchServiceServer interface {
+	mustEmbedUnimplementedBridgePortBatchServiceServer()
+}
+
+func RegisterBridgePortBatchServiceServer(s grpc.ServiceRegistrar, srv BridgePortBatchServiceServer) {
+	s.RegisterService(&BridgePortBatchService_ServiceDesc, srv)
+}
+
+func _BridgePortBatchService_BatchCreateBridgePorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateBridgePortsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgePortBatchServiceServer).BatchCreateBridgePorts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgePortBatchService_BatchCreateBridgePorts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgePortBatchServiceServer).BatchCreateBridgePorts(ctx, req.(*BatchCreateBridgePortsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgePortBatchService_BatchDeleteBridgePorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteBridgePortsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgePortBatchServiceServer).BatchDeleteBridgePorts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgePortBatchService_BatchDeleteBridgePorts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgePortBatchServiceServer).BatchDeleteBridgePorts(ctx, req.(*BatchDeleteBridgePortsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BridgePortBatchService_ServiceDesc is the grpc.ServiceDesc for BridgePortBatchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BridgePortBatchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "opi_evpn_bridge.v1alpha1.BridgePortBatchService",
+	HandlerType: (*BridgePortBatchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BatchCreateBridgePorts",
+			Handler:    _BridgePortBatchService_BatchCreateBridgePorts_Handler,
+		},
+		{
+			MethodName: "BatchDeleteBridgePorts",
+			Handler:    _BridgePortBatchService_BatchDeleteBridgePorts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "batch.proto",
+}
@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: bridge.proto
+
+package _go
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	LogicalBridgeOptionsService_GetLogicalBridgeOptions_FullMethodName    = "/opi_evpn_bridge.v1alpha1.LogicalBridgeOptionsService/GetLogicalBridgeOptions"
+	LogicalBridgeOptionsService_UpdateLogicalBridgeOptions_FullMethodName = "/opi_evpn_bridge.v1alpha1.LogicalBridgeOptionsService/UpdateLogicalBridgeOptions"
+)
+
+// LogicalBridgeOptionsServiceClient is the client API for LogicalBridgeOptionsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LogicalBridgeOptionsServiceClient interface {
+	// Get the options of a LogicalBridge, defaults for options never set
+	GetLogicalBridgeOptions(ctx context.Context, in *GetLogicalBridgeOptionsRequest, opts ...grpc.CallOption) (*LogicalBridgeOptions, error)
+	// Set the options of a LogicalBridge, they apply at once to an existing
+	// LogicalBridge, otherwise when it is created
+	UpdateLogicalBridgeOptions(ctx context.Context, in *UpdateLogicalBridgeOptionsRequest, opts ...grpc.CallOption) (*LogicalBridgeOptions, error)
+}
+
+type logicalBridgeOptionsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLogicalBridgeOptionsServiceClient(cc grpc.ClientConnInterface) LogicalBridgeOptionsServiceClient {
+	return &logicalBridgeOptionsServiceClient{cc}
+}
+
+func (c *logicalBridgeOptionsServiceClient) GetLogicalBridgeOptions(ctx context.Context, in *GetLogicalBridgeOptionsRequest, opts ...grpc.CallOption) (*LogicalBridgeOptions, error) {
+	out := new(LogicalBridgeOptions)
+	err := c.cc.Invoke(ctx, LogicalBridgeOptionsService_GetLogicalBridgeOptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicalBridgeOptionsServiceClient) UpdateLogicalBridgeOptions(ctx context.Context, in *UpdateLogicalBridgeOptionsRequest, opts ...grpc.CallOption) (*LogicalBridgeOptions, error) {
+	out := new(LogicalBridgeOptions)
+	err := c.cc.Invoke(ctx, LogicalBridgeOptionsService_UpdateLogicalBridgeOptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LogicalBridgeOptionsServiceServer is the server API for LogicalBridgeOptionsService service.
+// All implementations must embed UnimplementedLogicalBridgeOptionsServiceServer
+// for forward compatibility
+type LogicalBridgeOptionsServiceServer interface {
+	// Get the options of a LogicalBridge, defaults for options never set
+	GetLogicalBridgeOptions(context.Context, *GetLogicalBridgeOptionsRequest) (*LogicalBridgeOptions, error)
+	// Set the options of a LogicalBridge, they apply at once to an existing
+	// LogicalBridge, otherwise when it is created
+	UpdateLogicalBridgeOptions(context.Context, *UpdateLogicalBridgeOptionsRequest) (*LogicalBridgeOptions, error)
+	mustEmbedUnimplementedLogicalBridgeOptionsServiceServer()
+}
+
+// UnimplementedLogicalBridgeOptionsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedLogicalBridgeOptionsServiceServer struct {
+}
+
+func (UnimplementedLogicalBridgeOptionsServiceServer) GetLogicalBridgeOptions(context.Context, *GetLogicalBridgeOptionsRequest) (*LogicalBridgeOptions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogicalBridgeOptions not implemented")
+}
+func (UnimplementedLogicalBridgeOptionsServiceServer) UpdateLogicalBridgeOptions(context.Context, *UpdateLogicalBridgeOptionsRequest) (*LogicalBridgeOptions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLogicalBridgeOptions not implemented")
+}
+func (UnimplementedLogicalBridgeOptionsServiceServer) mustEmbedUnimplementedLogicalBridgeOptionsServiceServer() {
+}
+
+// UnsafeLogicalBridgeOptionsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LogicalBridgeOptionsServiceServer will
+// result in compilation errors.
+type UnsafeLogicalBridgeOptionsServiceServer interface {
+	mustEmbedUnimplementedLogicalBridgeOptionsServiceServer()
+}
+
+func RegisterLogicalBridgeOptionsServiceServer(s grpc.ServiceRegistrar, srv LogicalBridgeOptionsServiceServer) {
+	s.RegisterService(&LogicalBridgeOptionsService_ServiceDesc, srv)
+}
+
+func _LogicalBridgeOptionsService_GetLogicalBridgeOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogicalBridgeOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicalBridgeOptionsServiceServer).GetLogicalBridgeOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogicalBridgeOptionsService_GetLogicalBridgeOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicalBridgeOptionsServiceServer).GetLogicalBridgeOptions(ctx, req.(*GetLogicalBridgeOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogicalBridgeOptionsService_UpdateLogicalBridgeOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLogicalBridgeOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicalBridgeOptionsServiceServer).UpdateLogicalBridgeOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogicalBridgeOptionsService_UpdateLogicalBridgeOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicalBridgeOptionsServiceServer).UpdateLogicalBridgeOptions(ctx, req.(*UpdateLogicalBridgeOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LogicalBridgeOptionsService_ServiceDesc is the grpc.ServiceDesc for LogicalBridgeOptionsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LogicalBridgeOptionsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "opi_evpn_bridge.v1alpha1.LogicalBridgeOptionsService",
+	HandlerType: (*LogicalBridgeOptionsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLogicalBridgeOptions",
+			Handler:    _LogicalBridgeOptionsService_GetLogicalBridgeOptions_Handler,
+		},
+		{
+			MethodName: "UpdateLogicalBridgeOptions",
+			Handler:    _LogicalBridgeOptionsService_UpdateLogicalBridgeOptions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bridge.proto",
+}
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: evpn.proto
+
+package _go
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BGP EVPN parameters of an L2 or L3 VNI, like the "vni" blocks of
+// "address-family l2vpn evpn" in FRR, FRR derives the ones not set
+type EvpnOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// route distinguisher, ASN:NN or IPv4:NN, for example 65000:10
+	Rd string `protobuf:"bytes,1,opt,name=rd,proto3" json:"rd,omitempty"`
+	// route targets of the routes imported into the VNI, ASN:NN or IPv4:NN
+	ImportRts []string `protobuf:"bytes,2,rep,name=import_rts,json=importRts,proto3" json:"import_rts,omitempty"`
+	// route targets attached to the routes exported from the VNI
+	ExportRts []string `protobuf:"bytes,3,rep,name=export_rts,json=exportRts,proto3" json:"export_rts,omitempty"`
+}
+
+func (x *EvpnOptions) Reset() {
+	*x = EvpnOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evpn_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvpnOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvpnOptions) ProtoMessage() {}
+
+func (x *EvpnOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_evpn_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvpnOptions.ProtoReflect.Descriptor instead.
+func (*EvpnOptions) Descriptor() ([]byte, []int) {
+	return file_evpn_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EvpnOptions) GetRd() string {
+	if x != nil {
+		return x.Rd
+	}
+	return ""
+}
+
+func (x *EvpnOptions) GetImportRts() []string {
+	if x != nil {
+		return x.ImportRts
+	}
+	return nil
+}
+
+func (x *EvpnOptions) GetExportRts() []string {
+	if x != nil {
+		return x.ExportRts
+	}
+	return nil
+}
+
+var File_evpn_proto protoreflect.FileDescriptor
+
+var file_evpn_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x65, 0x76, 0x70, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x6f, 0x70,
+	0x69, 0x5f, 0x65, 0x76, 0x70, 0x6e, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0x5b, 0x0a, 0x0b, 0x45, 0x76, 0x70, 0x6e, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x74, 0x73, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6f, 0x70, 0x69, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x6f, 0x70, 0x69,
+	0x2d, 0x65, 0x76, 0x70, 0x6e, 0x2d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_evpn_proto_rawDescOnce sync.Once
+	file_evpn_proto_rawDescData = file_evpn_proto_rawDesc
+)
+
+func file_evpn_proto_rawDescGZIP() []byte {
+	file_evpn_proto_rawDescOnce.Do(func() {
+		file_evpn_proto_rawDescData = protoimpl.X.CompressGZIP(file_evpn_proto_rawDescData)
+	})
+	return file_evpn_proto_rawDescData
+}
+
+var file_evpn_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_evpn_proto_goTypes = []interface{}{
+	(*EvpnOptions)(nil), // 0: opi_evpn_bridge.v1alpha1.EvpnOptions
+}
+var file_evpn_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_evpn_proto_init() }
+func file_evpn_proto_init() {
+	if File_evpn_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_evpn_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvpnOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_evpn_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_evpn_proto_goTypes,
+		DependencyIndexes: file_evpn_proto_depIdxs,
+		MessageInfos:      file_evpn_proto_msgTypes,
+	}.Build()
+	File_evpn_proto = out.File
+	file_evpn_proto_rawDesc = nil
+	file_evpn_proto_goTypes = nil
+	file_evpn_proto_depIdxs = nil
+}
@@ -0,0 +1,1092 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: port.proto
+
+package _go
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Options of a single BridgePort, a singleton below the BridgePort
+type BridgePortOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of the BridgePort followed by /options,
+	// for example //network.opiproject.org/ports/bond1/options
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// desired options
+	Spec *BridgePortOptionsSpec `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	// options in effect on the interface of the BridgePort
+	Status *BridgePortOptionsStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *BridgePortOptions) Reset() {
+	*x = BridgePortOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_port_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BridgePortOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgePortOptions) ProtoMessage() {}
+
+func (x *BridgePortOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_port_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgePortOptions.ProtoReflect.Descriptor instead.
+func (*BridgePortOptions) Descriptor() ([]byte, []int) {
+	return file_port_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BridgePortOptions) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BridgePortOptions) GetSpec() *BridgePortOptionsSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *BridgePortOptions) GetStatus() *BridgePortOptionsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Desired options of a BridgePort
+type BridgePortOptionsSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// EVPN multihoming of the interface, for hosts attached to several devices
+	Multihoming *EvpnMultihoming `protobuf:"bytes,1,opt,name=multihoming,proto3" json:"multihoming,omitempty"`
+	// bond created as the interface of the BridgePort, from member interfaces
+	Bond *BondOptions `protobuf:"bytes,2,opt,name=bond,proto3" json:"bond,omitempty"`
+	// virtual device created as the interface of the BridgePort,
+	// for the attachment of VMs and containers
+	Virtual *VirtualInterface `protobuf:"bytes,3,opt,name=virtual,proto3" json:"virtual,omitempty"`
+}
+
+func (x *BridgePortOptionsSpec) Reset() {
+	*x = BridgePortOptionsSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_port_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BridgePortOptionsSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgePortOptionsSpec) ProtoMessage() {}
+
+func (x *BridgePortOptionsSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_port_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgePortOptionsSpec.ProtoReflect.Descriptor instead.
+func (*BridgePortOptionsSpec) Descriptor() ([]byte, []int) {
+	return file_port_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BridgePortOptionsSpec) GetMultihoming() *EvpnMultihoming {
+	if x != nil {
+		return x.Multihoming
+	}
+	return nil
+}
+
+func (x *BridgePortOptionsSpec) GetBond() *BondOptions {
+	if x != nil {
+		return x.Bond
+	}
+	return nil
+}
+
+func (x *BridgePortOptionsSpec) GetVirtual() *VirtualInterface {
+	if x != nil {
+		return x.Virtual
+	}
+	return nil
+}
+
+// Options in effect on the interface of a BridgePort
+type BridgePortOptionsStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ethernet Segment of the interface
+	Multihoming *EvpnMultihomingStatus `protobuf:"bytes,1,opt,name=multihoming,proto3" json:"multihoming,omitempty"`
+	// state of the bond members
+	Bond *BondStatus `protobuf:"bytes,2,opt,name=bond,proto3" json:"bond,omitempty"`
+}
+
+func (x *BridgePortOptionsStatus) Reset() {
+	*x = BridgePortOptionsStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_port_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BridgePortOptionsStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgePortOptionsStatus) ProtoMessage() {}
+
+func (x *BridgePortOptionsStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_port_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgePortOptionsStatus.ProtoReflect.Descriptor instead.
+func (*BridgePortOptionsStatus) Descriptor() ([]byte, []int) {
+	return file_port_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BridgePortOptionsStatus) GetMultihoming() *EvpnMultihomingStatus {
+	if x != nil {
+		return x.Multihoming
+	}
+	return nil
+}
+
+func (x *BridgePortOptionsStatus) GetBond() *BondStatus {
+	if x != nil {
+		return x.Bond
+	}
+	return nil
+}
+
+// Ethernet Segment of an interface, like "evpn mh" of the interface in FRR
+type EvpnMultihoming struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ethernet Segment ID, either 10 bytes of type 0 like
+	// 00:44:38:39:ff:ff:01:00:00:01, or a local discriminator of 1 to
+	// 16777215, which makes a type 3 ESI together with sys_mac
+	EsId string `protobuf:"bytes,1,opt,name=es_id,json=esId,proto3" json:"es_id,omitempty"`
+	// system MAC of the Ethernet Segment, the same on all devices the host
+	// is attached to, set together with a local discriminator only
+	SysMac []byte `protobuf:"bytes,2,opt,name=sys_mac,json=sysMac,proto3" json:"sys_mac,omitempty"`
+	// preference of the device in the designated forwarder election,
+	// 1 to 65535, the highest wins, FRR uses 32767 when not set
+	DfPreference uint32 `protobuf:"varint,3,opt,name=df_preference,json=dfPreference,proto3" json:"df_preference,omitempty"`
+}
+
+func (x *EvpnMultihoming) Reset() {
+	*x = EvpnMultihoming{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_port_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvpnMultihoming) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvpnMultihoming) ProtoMessage() {}
+
+func (x *EvpnMultihoming) ProtoReflect() protoreflect.Message {
+	mi := &file_port_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvpnMultihoming.ProtoReflect.Descriptor instead.
+func (*EvpnMultihoming) Descriptor() ([]byte, []int) {
+	return file_port_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EvpnMultihoming) GetEsId() string {
+	if x != nil {
+		return x.EsId
+	}
+	return ""
+}
+
+func (x *EvpnMultihoming) GetSysMac() []byte {
+	if x != nil {
+		return x.SysMac
+	}
+	return nil
+}
+
+func (x *EvpnMultihoming) GetDfPreference() uint32 {
+	if x != nil {
+		return x.DfPreference
+	}
+	return 0
+}
+
+// Ethernet Segment of an interface in effect
+type EvpnMultihomingStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ethernet Segment ID
+	Esi string `protobuf:"bytes,1,opt,name=esi,proto3" json:"esi,omitempty"`
+	// the device won the designated forwarder election and forwards the
+	// broadcast, unknown unicast and multicast traffic to the host
+	DesignatedForwarder bool `protobuf:"varint,2,opt,name=designated_forwarder,json=designatedForwarder,proto3" json:"designated_forwarder,omitempty"`
+	// the interface of the Ethernet Segment is up
+	OperUp bool `protobuf:"varint,3,opt,name=oper_up,json=operUp,proto3" json:"oper_up,omitempty"`
+}
+
+func (x *EvpnMultihomingStatus) Reset() {
+	*x = EvpnMultihomingStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_port_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvpnMultihomingStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvpnMultihomingStatus) ProtoMessage() {}
+
+func (x *EvpnMultihomingStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_port_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvpnMultihomingStatus.ProtoReflect.Descriptor instead.
+func (*EvpnMultihomingStatus) Descriptor() ([]byte, []int) {
+	return file_port_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EvpnMultihomingStatus) GetEsi() string {
+	if x != nil {
+		return x.Esi
+	}
+	return ""
+}
+
+func (x *EvpnMultihomingStatus) GetDesignatedForwarder() bool {
+	if x != nil {
+		return x.DesignatedForwarder
+	}
+	return false
+}
+
+func (x *EvpnMultihomingStatus) GetOperUp() bool {
+	if x != nil {
+		return x.OperUp
+	}
+	return false
+}
+
+// Bond of a BridgePort, like "ip link add type bond", it is created and
+// deleted with the BridgePort, only its members change while it exists
+type BondOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// names of the member interfaces, enslaved when the bond is created
+	Members []string `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	// bonding mode, like 802.3ad or active-backup, 802.3ad when not set
+	Mode string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	// rate of LACPDUs asked from the partner in 802.3ad mode, slow or fast
+	LacpRate string `protobuf:"bytes,3,opt,name=lacp_rate,json=lacpRate,proto3" json:"lacp_rate,omitempty"`
+	// policy to select the member sending a packet, like layer2 or layer3+4
+	XmitHashPolicy string `protobuf:"bytes,4,opt,name=xmit_hash_policy,json=xmitHashPolicy,proto3" json:"xmit_hash_policy,omitempty"`
+	// minimum number of members up for the bond to be up
+	MinLinks uint32 `protobuf:"varint,5,opt,name=min_links,json=minLinks,proto3" json:"min_links,omitempty"`
+}
+
+func (x *BondOptions) Reset() {
+	*x = BondOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_port_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BondOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BondOptions) ProtoMessage() {}
+
+func (x *BondOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_port_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BondOptions.ProtoReflect.Descriptor instead.
+func (*BondOptions) Descriptor() ([]byte, []int) {
+	return file_port_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BondOptions) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *BondOptions) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *BondOptions) GetLacpRate() string {
+	if x != nil {
+		return x.LacpRate
+	}
+	return ""
+}
+
+func (x *BondOptions) GetXmitHashPolicy() string {
+	if x != nil {
+		return x.XmitHashPolicy
+	}
+	return ""
+}
+
+func (x *BondOptions) GetMinLinks() uint32 {
+	if x != nil {
+		return x.MinLinks
+	}
+	return 0
+}
+
+// Virtual device of a BridgePort, like "ip link add type veth", it is
+// created and deleted with the BridgePort and does not change while it exists
+type VirtualInterface struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// kind of device, veth, dummy or macvlan
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// name of the other end of a veth pair
+	Peer string `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	// network namespace the other end of a veth pair moves into, a name
+	// below /var/run/netns or a path like /proc/1234/ns/net,
+	// the other end stays up next to the BridgePort when not set
+	PeerNetns string `protobuf:"bytes,3,opt,name=peer_netns,json=peerNetns,proto3" json:"peer_netns,omitempty"`
+	// parent interface of a macvlan
+	Parent string `protobuf:"bytes,4,opt,name=parent,proto3" json:"parent,omitempty"`
+	// mode of a macvlan, private, vepa, bridge or passthru, bridge when not set
+	MacvlanMode string `protobuf:"bytes,5,opt,name=macvlan_mode,json=macvlanMode,proto3" json:"macvlan_mode,omitempty"`
+}
+
+func (x *VirtualInterface) Reset() {
+	*x = VirtualInterface{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_port_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VirtualInterface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualInterface) ProtoMessage() {}
+
+func (x *VirtualInterface) ProtoReflect() protoreflect.Message {
+	mi := &file_port_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VirtualInterface.ProtoReflect.Descriptor instead.
+func (*VirtualInterface) Descriptor() ([]byte, []int) {
+	return file_port_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VirtualInterface) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *VirtualInterface) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *VirtualInterface) GetPeerNetns() string {
+	if x != nil {
+		return x.PeerNetns
+	}
+	return ""
+}
+
+func (x *VirtualInterface) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *VirtualInterface) GetMacvlanMode() string {
+	if x != nil {
+		return x.MacvlanMode
+	}
+	return ""
+}
+
+// State of the members of a bond
+type BondStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// members of the bond
+	Members []*BondMemberStatus `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *BondStatus) Reset() {
+	*x = BondStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_port_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BondStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BondStatus) ProtoMessage() {}
+
+func (x *BondStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_port_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BondStatus.ProtoReflect.Descriptor instead.
+func (*BondStatus) Descriptor() ([]byte, []int) {
+	return file_port_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BondStatus) GetMembers() []*BondMemberStatus {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// State of a member of a bond
+type BondMemberStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of the member interface
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the member interface is up
+	OperUp bool `protobuf:"varint,2,opt,name=oper_up,json=operUp,proto3" json:"oper_up,omitempty"`
+	// the member is active in the bond, not a backup
+	Active bool `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *BondMemberStatus) Reset() {
+	*x = BondMemberStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_port_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BondMemberStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BondMemberStatus) ProtoMessage() {}
+
+func (x *BondMemberStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_port_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BondMemberStatus.ProtoReflect.Descriptor instead.
+func (*BondMemberStatus) Descriptor() ([]byte, []int) {
+	return file_port_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BondMemberStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BondMemberStatus) GetOperUp() bool {
+	if x != nil {
+		return x.OperUp
+	}
+	return false
+}
+
+func (x *BondMemberStatus) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+// Request to get the options of a BridgePort
+type GetBridgePortOptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of the options
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetBridgePortOptionsRequest) Reset() {
+	*x = GetBridgePortOptionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_port_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBridgePortOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBridgePortOptionsRequest) ProtoMessage() {}
+
+func (x *GetBridgePortOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_port_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBridgePortOptionsRequest.ProtoReflect.Descriptor instead.
+func (*GetBridgePortOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_port_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetBridgePortOptionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Request to set the options of a BridgePort
+type UpdateBridgePortOptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// options to set, the name selects the BridgePort
+	BridgePortOptions *BridgePortOptions `protobuf:"bytes,1,opt,name=bridge_port_options,json=bridgePortOptions,proto3" json:"bridge_port_options,omitempty"`
+	// fields to set, for example spec.multihoming.df_preference,
+	// the fields present in the request when empty
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *UpdateBridgePortOptionsRequest) Reset() {
+	*x = UpdateBridgePortOptionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_port_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBridgePortOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBridgePortOptionsRequest) ProtoMessage() {}
+
+func (x *UpdateBridgePortOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_port_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBridgePortOptionsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBridgePortOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_port_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateBridgePortOptionsRequest) GetBridgePortOptions() *BridgePortOptions {
+	if x != nil {
+		return x.BridgePortOptions
+	}
+	return nil
+}
+
+func (x *UpdateBridgePortOptionsRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+var File_port_proto protoreflect.FileDescriptor
+
+var file_port_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x6f, 0x70,
+	0x69, 0x5f, 0x65, 0x76, 0x70, 0x6e, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61,
+	0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb7, 0x01, 0x0a, 0x11, 0x42, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x43, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x6f, 0x70, 0x69, 0x5f, 0x65, 0x76, 0x70, 0x6e, 0x5f, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x70, 0x65,
+	0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x49, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6f, 0x70, 0x69, 0x5f, 0x65, 0x76,
+	0x70, 0x6e, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x15, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x50, 0x6f, 0x72,
+	0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x70, 0x65, 0x63, 0x12, 0x4b, 0x0a, 0x0b,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x68, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x70, 0x69, 0x5f, 0x65, 0x76, 0x70, 0x6e, 0x5f, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x70,
+	0x6e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x68, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x68, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x39, 0x0a, 0x04, 0x62, 0x6f, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x70, 0x69, 0x5f, 0x65, 0x76,
+	0x70, 0x6e, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04,
+	0x62, 0x6f, 0x6e, 0x64, 0x12, 0x44, 0x0a, 0x07, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x70, 0x69, 0x5f, 0x65, 0x76, 0x70, 0x6e,
+	0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x52, 0x07, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x22, 0xa6, 0x01, 0x0a, 0x17, 0x42,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x51, 0x0a, 0x0b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x68,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6f, 0x70,
+	0x69, 0x5f, 0x65, 0x76, 0x70, 0x6e, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x70, 0x6e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x68, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x68, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x38, 0x0a, 0x04, 0x62, 0x6f, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x70, 0x69, 0x5f, 0x65, 0x76,
+	0x70, 0x6e, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x62,
+	0x6f, 0x6e, 0x64, 0x22, 0x64, 0x0a, 0x0f, 0x45, 0x76, 0x70, 0x6e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x68, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x13, 0x0a, 0x05, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x73, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73,
+	0x79, 0x73, 0x5f, 0x6d, 0x61, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x79,
+	0x73, 0x4d, 0x61, 0x63, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x66, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x66, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x75, 0x0a, 0x15, 0x45, 0x76, 0x70,
+	0x6e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x68, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x73, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x65, 0x73, 0x69, 0x12, 0x31, 0x0a, 0x14, 0x64, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x13, 0x64, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x72, 0x5f,
+	0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x72, 0x55, 0x70,
+	0x22, 0x9f, 0x01, 0x0a, 0x0b, 0x42, 0x6f, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x6c, 0x61, 0x63, 0x70, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x61, 0x63, 0x70, 0x52, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x78,
+	0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x78, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x6e,
+	0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x6e,
+	0x6b, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x10, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x65, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6e, 0x65, 0x74, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x65, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x6e, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x63, 0x76, 0x6c, 0x61,
+	0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61,
+	0x63, 0x76, 0x6c, 0x61, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x52, 0x0a, 0x0a, 0x42, 0x6f, 0x6e,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x44, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x70, 0x69, 0x5f, 0x65,
+	0x76, 0x70, 0x6e, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x57, 0x0a,
+	0x10, 0x42, 0x6f, 0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x72, 0x55, 0x70, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x31, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x1e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5b, 0x0a, 0x13,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6f, 0x70, 0x69, 0x5f,
+	0x65, 0x76, 0x70, 0x6e, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x11, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x50, 0x6f,
+	0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x32, 0x9d, 0x02, 0x0a, 0x18, 0x42, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x50, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x7c, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x50, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x2e, 0x6f, 0x70,
+	0x69, 0x5f, 0x65, 0x76, 0x70, 0x6e, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x50, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6f, 0x70, 0x69, 0x5f, 0x65, 0x76, 0x70, 0x6e, 0x5f, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x42, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x00, 0x12, 0x82, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x2e,
+	0x6f, 0x70, 0x69, 0x5f, 0x65, 0x76, 0x70, 0x6e, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6f, 0x70, 0x69, 0x5f, 0x65, 0x76,
+	0x70, 0x6e, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x69, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f,
+	0x6f, 0x70, 0x69, 0x2d, 0x65, 0x76, 0x70, 0x6e, 0x2d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x67, 0x65, 0x6e,
+	0x2f, 0x67, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_port_proto_rawDescOnce sync.Once
+	file_port_proto_rawDescData = file_port_proto_rawDesc
+)
+
+func file_port_proto_rawDescGZIP() []byte {
+	file_port_proto_rawDescOnce.Do(func() {
+		file_port_proto_rawDescData = protoimpl.X.CompressGZIP(file_port_proto_rawDescData)
+	})
+	return file_port_proto_rawDescData
+}
+
+var file_port_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_port_proto_goTypes = []interface{}{
+	(*BridgePortOptions)(nil),              // 0: opi_evpn_bridge.v1alpha1.BridgePortOptions
+	(*BridgePortOptionsSpec)(nil),          // 1: opi_evpn_bridge.v1alpha1.BridgePortOptionsSpec
+	(*BridgePortOptionsStatus)(nil),        // 2: opi_evpn_bridge.v1alpha1.BridgePortOptionsStatus
+	(*EvpnMultihoming)(nil),                // 3: opi_evpn_bridge.v1alpha1.EvpnMultihoming
+	(*EvpnMultihomingStatus)(nil),          // 4: opi_evpn_bridge.v1alpha1.EvpnMultihomingStatus
+	(*BondOptions)(nil),                    // 5: opi_evpn_bridge.v1alpha1.BondOptions
+	(*VirtualInterface)(nil),               // 6: opi_evpn_bridge.v1alpha1.VirtualInterface
+	(*BondStatus)(nil),                     // 7: opi_evpn_bridge.v1alpha1.BondStatus
+	(*BondMemberStatus)(nil),               // 8: opi_evpn_bridge.v1alpha1.BondMemberStatus
+	(*GetBridgePortOptionsRequest)(nil),    // 9: opi_evpn_bridge.v1alpha1.GetBridgePortOptionsRequest
+	(*UpdateBridgePortOptionsRequest)(nil), // 10: opi_evpn_bridge.v1alpha1.UpdateBridgePortOptionsRequest
+	(*fieldmaskpb.FieldMask)(nil),          // 11: google.protobuf.FieldMask
+}
+var file_port_proto_depIdxs = []int32{
+	1,  // 0: opi_evpn_bridge.v1alpha1.BridgePortOptions.spec:type_name -> opi_evpn_bridge.v1alpha1.BridgePortOptionsSpec
+	2,  // 1: opi_evpn_bridge.v1alpha1.BridgePortOptions.status:type_name -> opi_evpn_bridge.v1alpha1.BridgePortOptionsStatus
+	3,  // 2: opi_evpn_bridge.v1alpha1.BridgePortOptionsSpec.multihoming:type_name -> opi_evpn_bridge.v1alpha1.EvpnMultihoming
+	5,  // 3: opi_evpn_bridge.v1alpha1.BridgePortOptionsSpec.bond:type_name -> opi_evpn_bridge.v1alpha1.BondOptions
+	6,  // 4: opi_evpn_bridge.v1alpha1.BridgePortOptionsSpec.virtual:type_name -> opi_evpn_bridge.v1alpha1.VirtualInterface
+	4,  // 5: opi_evpn_bridge.v1alpha1.BridgePortOptionsStatus.multihoming:type_name -> opi_evpn_bridge.v1alpha1.EvpnMultihomingStatus
+	7,  // 6: opi_evpn_bridge.v1alpha1.BridgePortOptionsStatus.bond:type_name -> opi_evpn_bridge.v1alpha1.BondStatus
+	8,  // 7: opi_evpn_bridge.v1alpha1.BondStatus.members:type_name -> opi_evpn_bridge.v1alpha1.BondMemberStatus
+	0,  // 8: opi_evpn_bridge.v1alpha1.UpdateBridgePortOptionsRequest.bridge_port_options:type_name -> opi_evpn_bridge.v1alpha1.BridgePortOptions
+	11, // 9: opi_evpn_bridge.v1alpha1.UpdateBridgePortOptionsRequest.update_mask:type_name -> google.protobuf.FieldMask
+	9,  // 10: opi_evpn_bridge.v1alpha1.BridgePortOptionsService.GetBridgePortOptions:input_type -> opi_evpn_bridge.v1alpha1.GetBridgePortOptionsRequest
+	10, // 11: opi_evpn_bridge.v1alpha1.BridgePortOptionsService.UpdateBridgePortOptions:input_type -> opi_evpn_bridge.v1alpha1.UpdateBridgePortOptionsRequest
+	0,  // 12: opi_evpn_bridge.v1alpha1.BridgePortOptionsService.GetBridgePortOptions:output_type -> opi_evpn_bridge.v1alpha1.BridgePortOptions
+	0,  // 13: opi_evpn_bridge.v1alpha1.BridgePortOptionsService.UpdateBridgePortOptions:output_type -> opi_evpn_bridge.v1alpha1.BridgePortOptions
+	12, // [12:14] is the sub-list for method output_type
+	10, // [10:12] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_port_proto_init() }
+func file_port_proto_init() {
+	if File_port_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_port_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgePortOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_port_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgePortOptionsSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_port_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgePortOptionsStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_port_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvpnMultihoming); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_port_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvpnMultihomingStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_port_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BondOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_port_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VirtualInterface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_port_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BondStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_port_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BondMemberStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_port_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBridgePortOptionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_port_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBridgePortOptionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_port_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_port_proto_goTypes,
+		DependencyIndexes: file_port_proto_depIdxs,
+		MessageInfos:      file_port_proto_msgTypes,
+	}.Build()
+	File_port_proto = out.File
+	file_port_proto_rawDesc = nil
+	file_port_proto_goTypes = nil
+	file_port_proto_depIdxs = nil
+}
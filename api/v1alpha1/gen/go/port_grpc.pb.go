@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: port.proto
+
+package _go
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	BridgePortOptionsService_GetBridgePortOptions_FullMethodName    = "/opi_evpn_bridge.v1alpha1.BridgePortOptionsService/GetBridgePortOptions"
+	BridgePortOptionsService_UpdateBridgePortOptions_FullMethodName = "/opi_evpn_bridge.v1alpha1.BridgePortOptionsService/UpdateBridgePortOptions"
+)
+
+// BridgePortOptionsServiceClient is the client API for BridgePortOptionsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BridgePortOptionsServiceClient interface {
+	// Get the options of a BridgePort, defaults for options never set
+	GetBridgePortOptions(ctx context.Context, in *GetBridgePortOptionsRequest, opts ...grpc.CallOption) (*BridgePortOptions, error)
+	// Set the options of a BridgePort, they apply at once to an existing
+	// BridgePort, otherwise when it is created
+	UpdateBridgePortOptions(ctx context.Context, in *UpdateBridgePortOptionsRequest, opts ...grpc.CallOption) (*BridgePortOptions, error)
+}
+
+type bridgePortOptionsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBridgePortOptionsServiceClient(cc grpc.ClientConnInterface) BridgePortOptionsServiceClient {
+	return &bridgePortOptionsServiceClient{cc}
+}
+
+func (c *bridgePortOptionsServiceClient) GetBridgePortOptions(ctx context.Context, in *GetBridgePortOptionsRequest, opts ...grpc.CallOption) (*BridgePortOptions, error) {
+	out := new(BridgePortOptions)
+	err := c.cc.Invoke(ctx, BridgePortOptionsService_GetBridgePortOptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgePortOptionsServiceClient) UpdateBridgePortOptions(ctx context.Context, in *UpdateBridgePortOptionsRequest, opts ...grpc.CallOption) (*BridgePortOptions, error) {
+	out := new(BridgePortOptions)
+	err := c.cc.Invoke(ctx, BridgePortOptionsService_UpdateBridgePortOptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BridgePortOptionsServiceServer is the server API for BridgePortOptionsService service.
+// All implementations must embed UnimplementedBridgePortOptionsServiceServer
+// for forward compatibility
+type BridgePortOptionsServiceServer interface {
+	// Get the options of a BridgePort, defaults for options never set
+	GetBridgePortOptions(context.Context, *GetBridgePortOptionsRequest) (*BridgePortOptions, error)
+	// Set the options of a BridgePort, they apply at once to an existing
+	// BridgePort, otherwise when it is created
+	UpdateBridgePortOptions(context.Context, *UpdateBridgePortOptionsRequest) (*BridgePortOptions, error)
+	mustEmbedUnimplementedBridgePortOptionsServiceServer()
+}
+
+// UnimplementedBridgePortOptionsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBridgePortOptionsServiceServer struct {
+}
+
+func (UnimplementedBridgePortOptionsServiceServer) GetBridgePortOptions(context.Context, *GetBridgePortOptionsRequest) (*BridgePortOptions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBridgePortOptions not implemented")
+}
+func (UnimplementedBridgePortOptionsServiceServer) UpdateBridgePortOptions(context.Context, *UpdateBridgePortOptionsRequest) (*BridgePortOptions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBridgePortOptions not implemented")
+}
+func (UnimplementedBridgePortOptionsServiceServer) mustEmbedUnimplementedBridgePortOptionsServiceServer() {
+}
+
+// UnsafeBridgePortOptionsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BridgePortOptionsServiceServer will
+// result in compilation errors.
+type UnsafeBridgePortOptionsServiceServer interface {
+	mustEmbedUnimplementedBridgePortOptionsServiceServer()
+}
+
+func RegisterBridgePortOptionsServiceServer(s grpc.ServiceRegistrar, srv BridgePortOptionsServiceServer) {
+	s.RegisterService(&BridgePortOptionsService_ServiceDesc, srv)
+}
+
+func _BridgePortOptionsService_GetBridgePortOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBridgePortOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgePortOptionsServiceServer).GetBridgePortOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgePortOptionsService_GetBridgePortOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgePortOptionsServiceServer).GetBridgePortOptions(ctx, req.(*GetBridgePortOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgePortOptionsService_UpdateBridgePortOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBridgePortOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgePortOptionsServiceServer).UpdateBridgePortOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgePortOptionsService_UpdateBridgePortOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgePortOptionsServiceServer).UpdateBridgePortOptions(ctx, req.(*UpdateBridgePortOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BridgePortOptionsService_ServiceDesc is the grpc.ServiceDesc for BridgePortOptionsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BridgePortOptionsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "opi_evpn_bridge.v1alpha1.BridgePortOptionsService",
+	HandlerType: (*BridgePortOptionsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBridgePortOptions",
+			Handler:    _BridgePortOptionsService_GetBridgePortOptions_Handler,
+		},
+		{
+			MethodName: "UpdateBridgePortOptions",
+			Handler:    _BridgePortOptionsService_UpdateBridgePortOptions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "port.proto",
+}
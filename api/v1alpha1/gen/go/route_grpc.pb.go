@@ -0,0 +1,271 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: route.proto
+
+package _go
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	StaticRouteService_CreateStaticRoute_FullMethodName = "/opi_evpn_bridge.v1alpha1.StaticRouteService/CreateStaticRoute"
+	StaticRouteService_DeleteStaticRoute_FullMethodName = "/opi_evpn_bridge.v1alpha1.StaticRouteService/DeleteStaticRoute"
+	StaticRouteService_UpdateStaticRoute_FullMethodName = "/opi_evpn_bridge.v1alpha1.StaticRouteService/UpdateStaticRoute"
+	StaticRouteService_GetStaticRoute_FullMethodName    = "/opi_evpn_bridge.v1alpha1.StaticRouteService/GetStaticRoute"
+	StaticRouteService_ListStaticRoutes_FullMethodName  = "/opi_evpn_bridge.v1alpha1.StaticRouteService/ListStaticRoutes"
+)
+
+// StaticRouteServiceClient is the client API for StaticRouteService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StaticRouteServiceClient interface {
+	// Create a static route in the routing table of a VRF
+	CreateStaticRoute(ctx context.Context, in *CreateStaticRouteRequest, opts ...grpc.CallOption) (*StaticRoute, error)
+	// Delete a static route
+	DeleteStaticRoute(ctx context.Context, in *DeleteStaticRouteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Update a static route, the route is replaced in the routing table
+	UpdateStaticRoute(ctx context.Context, in *UpdateStaticRouteRequest, opts ...grpc.CallOption) (*StaticRoute, error)
+	// Retrieve a static route
+	GetStaticRoute(ctx context.Context, in *GetStaticRouteRequest, opts ...grpc.CallOption) (*StaticRoute, error)
+	// List static routes
+	ListStaticRoutes(ctx context.Context, in *ListStaticRoutesRequest, opts ...grpc.CallOption) (*ListStaticRoutesResponse, error)
+}
+
+type staticRouteServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStaticRouteServiceClient(cc grpc.ClientConnInterface) StaticRouteServiceClient {
+	return &staticRouteServiceClient{cc}
+}
+
+func (c *staticRouteServiceClient) CreateStaticRoute(ctx context.Context, in *CreateStaticRouteRequest, opts ...grpc.CallOption) (*StaticRoute, error) {
+	out := new(StaticRoute)
+	err := c.cc.Invoke(ctx, StaticRouteService_CreateStaticRoute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staticRouteServiceClient) DeleteStaticRoute(ctx context.Context, in *DeleteStaticRouteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StaticRouteService_DeleteStaticRoute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staticRouteServiceClient) UpdateStaticRoute(ctx context.Context, in *UpdateStaticRouteRequest, opts ...grpc.CallOption) (*StaticRoute, error) {
+	out := new(StaticRoute)
+	err := c.cc.Invoke(ctx, StaticRouteService_UpdateStaticRoute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staticRouteServiceClient) GetStaticRoute(ctx context.Context, in *GetStaticRouteRequest, opts ...grpc.CallOption) (*StaticRoute, error) {
+	out := new(StaticRoute)
+	err := c.cc.Invoke(ctx, StaticRouteService_GetStaticRoute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staticRouteServiceClient) ListStaticRoutes(ctx context.Context, in *ListStaticRoutesRequest, opts ...grpc.CallOption) (*ListStaticRoutesResponse, error) {
+	out := new(ListStaticRoutesResponse)
+	err := c.cc.Invoke(ctx, StaticRouteService_ListStaticRoutes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StaticRouteServiceServer is the server API for StaticRouteService service.
+// All implementations must embed UnimplementedStaticRouteServiceServer
+// for forward compatibility
+type StaticRouteServiceServer interface {
+	// Create a static route in the routing table of a VRF
+	CreateStaticRoute(context.Context, *CreateStaticRouteRequest) (*StaticRoute, error)
+	// Delete a static route
+	DeleteStaticRoute(context.Context, *DeleteStaticRouteRequest) (*emptypb.Empty, error)
+	// Update a static route, the route is replaced in the routing table
+	UpdateStaticRoute(context.Context, *UpdateStaticRouteRequest) (*StaticRoute, error)
+	// Retrieve a static route
+	GetStaticRoute(context.Context, *GetStaticRouteRequest) (*StaticRoute, error)
+	// List static routes
+	ListStaticRoutes(context.Context, *ListStaticRoutesRequest) (*ListStaticRoutesResponse, error)
+	mustEmbedUnimplementedStaticRouteServiceServer()
+}
+
+// UnimplementedStaticRouteServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedStaticRouteServiceServer struct {
+}
+
+func (UnimplementedStaticRouteServiceServer) CreateStaticRoute(context.Context, *CreateStaticRouteRequest) (*StaticRoute, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStaticRoute not implemented")
+}
+func (UnimplementedStaticRouteServiceServer) DeleteStaticRoute(context.Context, *DeleteStaticRouteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStaticRoute not implemented")
+}
+func (UnimplementedStaticRouteServiceServer) UpdateStaticRoute(context.Context, *UpdateStaticRouteRequest) (*StaticRoute, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStaticRoute not implemented")
+}
+func (UnimplementedStaticRouteServiceServer) GetStaticRoute(context.Context, *GetStaticRouteRequest) (*StaticRoute, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStaticRoute not implemented")
+}
+func (UnimplementedStaticRouteServiceServer) ListStaticRoutes(context.Context, *ListStaticRoutesRequest) (*ListStaticRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaticRoutes not implemented")
+}
+func (UnimplementedStaticRouteServiceServer) mustEmbedUnimplementedStaticRouteServiceServer() {}
+
+// UnsafeStaticRouteServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StaticRouteServiceServer will
+// result in compilation errors.
+type UnsafeStaticRouteServiceServer interface {
+	mustEmbedUnimplementedStaticRouteServiceServer()
+}
+
+func RegisterStaticRouteServiceServer(s grpc.ServiceRegistrar, srv StaticRouteServiceServer) {
+	s.RegisterService(&StaticRouteService_ServiceDesc, srv)
+}
+
+func _StaticRouteService_CreateStaticRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStaticRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaticRouteServiceServer).CreateStaticRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaticRouteService_CreateStaticRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaticRouteServiceServer).CreateStaticRoute(ctx, req.(*CreateStaticRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaticRouteService_DeleteStaticRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStaticRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaticRouteServiceServer).DeleteStaticRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaticRouteService_DeleteStaticRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaticRouteServiceServer).DeleteStaticRoute(ctx, req.(*DeleteStaticRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaticRouteService_UpdateStaticRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStaticRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaticRouteServiceServer).UpdateStaticRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaticRouteService_UpdateStaticRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaticRouteServiceServer).UpdateStaticRoute(ctx, req.(*UpdateStaticRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaticRouteService_GetStaticRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStaticRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaticRouteServiceServer).GetStaticRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaticRouteService_GetStaticRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaticRouteServiceServer).GetStaticRoute(ctx, req.(*GetStaticRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaticRouteService_ListStaticRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaticRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaticRouteServiceServer).ListStaticRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaticRouteService_ListStaticRoutes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaticRouteServiceServer).ListStaticRoutes(ctx, req.(*ListStaticRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StaticRouteService_ServiceDesc is the grpc.ServiceDesc for StaticRouteService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StaticRouteService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "opi_evpn_bridge.v1alpha1.StaticRouteService",
+	HandlerType: (*StaticRouteServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateStaticRoute",
+			Handler:    _StaticRouteService_CreateStaticRoute_Handler,
+		},
+		{
+			MethodName: "DeleteStaticRoute",
+			Handler:    _StaticRouteService_DeleteStaticRoute_Handler,
+		},
+		{
+			MethodName: "UpdateStaticRoute",
+			Handler:    _StaticRouteService_UpdateStaticRoute_Handler,
+		},
+		{
+			MethodName: "GetStaticRoute",
+			Handler:    _StaticRouteService_GetStaticRoute_Handler,
+		},
+		{
+			MethodName: "ListStaticRoutes",
+			Handler:    _StaticRouteService_ListStaticRoutes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "route.proto",
+}
@@ -0,0 +1,535 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: vrf.proto
+
+package _go
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Options of a single VRF, a singleton below the VRF
+type VrfOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of the VRF followed by /options,
+	// for example //network.opiproject.org/vrfs/blue/options
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// desired options
+	Spec *VrfOptionsSpec `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	// options in effect on the devices of the VRF
+	Status *VrfOptionsStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *VrfOptions) Reset() {
+	*x = VrfOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vrf_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VrfOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VrfOptions) ProtoMessage() {}
+
+func (x *VrfOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_vrf_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VrfOptions.ProtoReflect.Descriptor instead.
+func (*VrfOptions) Descriptor() ([]byte, []int) {
+	return file_vrf_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VrfOptions) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VrfOptions) GetSpec() *VrfOptionsSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *VrfOptions) GetStatus() *VrfOptionsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Desired options of a VRF
+type VrfOptionsSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// parameters of the L3 VXLAN device, changing them replaces the device
+	Vxlan *VxlanOptions `protobuf:"bytes,1,opt,name=vxlan,proto3" json:"vxlan,omitempty"`
+	// VRFs whose routes are leaked into this VRF, with "import vrf" in its
+	// BGP instance, for example //network.opiproject.org/vrfs/shared.
+	// Both VRFs have to exist and have a VNI, deleting either of them
+	// removes the leak
+	ImportVrfs []string `protobuf:"bytes,2,rep,name=import_vrfs,json=importVrfs,proto3" json:"import_vrfs,omitempty"`
+	// route distinguisher and route targets of the L3 VNI,
+	// they apply at once to the BGP instance of the VRF
+	Evpn *EvpnOptions `protobuf:"bytes,3,opt,name=evpn,proto3" json:"evpn,omitempty"`
+}
+
+func (x *VrfOptionsSpec) Reset() {
+	*x = VrfOptionsSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vrf_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VrfOptionsSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VrfOptionsSpec) ProtoMessage() {}
+
+func (x *VrfOptionsSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_vrf_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VrfOptionsSpec.ProtoReflect.Descriptor instead.
+func (*VrfOptionsSpec) Descriptor() ([]byte, []int) {
+	return file_vrf_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VrfOptionsSpec) GetVxlan() *VxlanOptions {
+	if x != nil {
+		return x.Vxlan
+	}
+	return nil
+}
+
+func (x *VrfOptionsSpec) GetImportVrfs() []string {
+	if x != nil {
+		return x.ImportVrfs
+	}
+	return nil
+}
+
+func (x *VrfOptionsSpec) GetEvpn() *EvpnOptions {
+	if x != nil {
+		return x.Evpn
+	}
+	return nil
+}
+
+// Options in effect on the devices of a VRF
+type VrfOptionsStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// parameters of the L3 VXLAN device
+	Vxlan *VxlanOptions `protobuf:"bytes,1,opt,name=vxlan,proto3" json:"vxlan,omitempty"`
+	// route distinguisher and route targets of the L3 VNI in effect in FRR
+	Evpn *EvpnOptions `protobuf:"bytes,2,opt,name=evpn,proto3" json:"evpn,omitempty"`
+}
+
+func (x *VrfOptionsStatus) Reset() {
+	*x = VrfOptionsStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vrf_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VrfOptionsStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VrfOptionsStatus) ProtoMessage() {}
+
+func (x *VrfOptionsStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_vrf_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VrfOptionsStatus.ProtoReflect.Descriptor instead.
+func (*VrfOptionsStatus) Descriptor() ([]byte, []int) {
+	return file_vrf_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VrfOptionsStatus) GetVxlan() *VxlanOptions {
+	if x != nil {
+		return x.Vxlan
+	}
+	return nil
+}
+
+func (x *VrfOptionsStatus) GetEvpn() *EvpnOptions {
+	if x != nil {
+		return x.Evpn
+	}
+	return nil
+}
+
+// Request to get the options of a VRF
+type GetVrfOptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of the options
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetVrfOptionsRequest) Reset() {
+	*x = GetVrfOptionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vrf_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVrfOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVrfOptionsRequest) ProtoMessage() {}
+
+func (x *GetVrfOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vrf_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVrfOptionsRequest.ProtoReflect.Descriptor instead.
+func (*GetVrfOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_vrf_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetVrfOptionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Request to set the options of a VRF
+type UpdateVrfOptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// options to set, the name selects the VRF
+	VrfOptions *VrfOptions `protobuf:"bytes,1,opt,name=vrf_options,json=vrfOptions,proto3" json:"vrf_options,omitempty"`
+	// fields to set, for example spec.vxlan.dst_port or spec.import_vrfs,
+	// the fields present in the request when empty
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *UpdateVrfOptionsRequest) Reset() {
+	*x = UpdateVrfOptionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vrf_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateVrfOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVrfOptionsRequest) ProtoMessage() {}
+
+func (x *UpdateVrfOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vrf_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVrfOptionsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVrfOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_vrf_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateVrfOptionsRequest) GetVrfOptions() *VrfOptions {
+	if x != nil {
+		return x.VrfOptions
+	}
+	return nil
+}
+
+func (x *UpdateVrfOptionsRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+var File_vrf_proto protoreflect.FileDescriptor
+
+var file_vrf_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x76, 0x72, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x6f, 0x70, 0x69,
+	0x5f, 0x65, 0x76, 0x70, 0x6e, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73,
+	0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0a, 0x65, 0x76, 0x70, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x76, 0x78, 0x6c, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xa2, 0x01, 0x0a, 0x0a, 0x56, 0x72, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x70, 0x69, 0x5f, 0x65, 0x76, 0x70, 0x6e, 0x5f, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x72, 0x66,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65,
+	0x63, 0x12, 0x42, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x70, 0x69, 0x5f, 0x65, 0x76, 0x70, 0x6e, 0x5f, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x72, 0x66,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x0e, 0x56, 0x72, 0x66, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x53, 0x70, 0x65, 0x63, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x78, 0x6c, 0x61,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x70, 0x69, 0x5f, 0x65, 0x76,
+	0x70, 0x6e, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x05, 0x76, 0x78, 0x6c, 0x61, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x76, 0x72, 0x66, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x56, 0x72, 0x66, 0x73, 0x12, 0x39, 0x0a, 0x04, 0x65, 0x76, 0x70, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x70, 0x69, 0x5f, 0x65, 0x76, 0x70, 0x6e,
+	0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x45, 0x76, 0x70, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x65, 0x76,
+	0x70, 0x6e, 0x22, 0x8b, 0x01, 0x0a, 0x10, 0x56, 0x72, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x78, 0x6c, 0x61, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x70, 0x69, 0x5f, 0x65, 0x76, 0x70,
+	0x6e, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05,
+	0x76, 0x78, 0x6c, 0x61, 0x6e, 0x12, 0x39, 0x0a, 0x04, 0x65, 0x76, 0x70, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x70, 0x69, 0x5f, 0x65, 0x76, 0x70, 0x6e, 0x5f, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45,
+	0x76, 0x70, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x65, 0x76, 0x70, 0x6e,
+	0x22, 0x2a, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x56, 0x72, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x9d, 0x01, 0x0a,
+	0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x72, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x0b, 0x76, 0x72, 0x66, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x6f, 0x70, 0x69, 0x5f, 0x65, 0x76, 0x70, 0x6e, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x76, 0x72, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b,
+	0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x32, 0xeb, 0x01, 0x0a,
+	0x11, 0x56, 0x72, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x67, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x56, 0x72, 0x66, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x2e, 0x6f, 0x70, 0x69, 0x5f, 0x65, 0x76, 0x70, 0x6e, 0x5f, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x56, 0x72, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x69, 0x5f, 0x65, 0x76, 0x70, 0x6e, 0x5f, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56,
+	0x72, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x10, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x72, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x31, 0x2e, 0x6f, 0x70, 0x69, 0x5f, 0x65, 0x76, 0x70, 0x6e, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x56, 0x72, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x69, 0x5f, 0x65, 0x76, 0x70, 0x6e, 0x5f, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x72,
+	0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x69, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x2f, 0x6f, 0x70, 0x69, 0x2d, 0x65, 0x76, 0x70, 0x6e, 0x2d, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_vrf_proto_rawDescOnce sync.Once
+	file_vrf_proto_rawDescData = file_vrf_proto_rawDesc
+)
+
+func file_vrf_proto_rawDescGZIP() []byte {
+	file_vrf_proto_rawDescOnce.Do(func() {
+		file_vrf_proto_rawDescData = protoimpl.X.CompressGZIP(file_vrf_proto_rawDescData)
+	})
+	return file_vrf_proto_rawDescData
+}
+
+var file_vrf_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_vrf_proto_goTypes = []interface{}{
+	(*VrfOptions)(nil),              // 0: opi_evpn_bridge.v1alpha1.VrfOptions
+	(*VrfOptionsSpec)(nil),          // 1: opi_evpn_bridge.v1alpha1.VrfOptionsSpec
+	(*VrfOptionsStatus)(nil),        // 2: opi_evpn_bridge.v1alpha1.VrfOptionsStatus
+	(*GetVrfOptionsRequest)(nil),    // 3: opi_evpn_bridge.v1alpha1.GetVrfOptionsRequest
+	(*UpdateVrfOptionsRequest)(nil), // 4: opi_evpn_bridge.v1alpha1.UpdateVrfOptionsRequest
+	(*VxlanOptions)(nil),            // 5: opi_evpn_bridge.v1alpha1.VxlanOptions
+	(*EvpnOptions)(nil),             // 6: opi_evpn_bridge.v1alpha1.EvpnOptions
+	(*fieldmaskpb.FieldMask)(nil),   // 7: google.protobuf.FieldMask
+}
+var file_vrf_proto_depIdxs = []int32{
+	1,  // 0: opi_evpn_bridge.v1alpha1.VrfOptions.spec:type_name -> opi_evpn_bridge.v1alpha1.VrfOptionsSpec
+	2,  // 1: opi_evpn_bridge.v1alpha1.VrfOptions.status:type_name -> opi_evpn_bridge.v1alpha1.VrfOptionsStatus
+	5,  // 2: opi_evpn_bridge.v1alpha1.VrfOptionsSpec.vxlan:type_name -> opi_evpn_bridge.v1alpha1.VxlanOptions
+	6,  // 3: opi_evpn_bridge.v1alpha1.VrfOptionsSpec.evpn:type_name -> opi_evpn_bridge.v1alpha1.EvpnOptions
+	5,  // 4: opi_evpn_bridge.v1alpha1.VrfOptionsStatus.vxlan:type_name -> opi_evpn_bridge.v1alpha1.VxlanOptions
+	6,  // 5: opi_evpn_bridge.v1alpha1.VrfOptionsStatus.evpn:type_name -> opi_evpn_bridge.v1alpha1.EvpnOptions
+	0,  // 6: opi_evpn_bridge.v1alpha1.UpdateVrfOptionsRequest.vrf_options:type_name -> opi_evpn_bridge.v1alpha1.VrfOptions
+	7,  // 7: opi_evpn_bridge.v1alpha1.UpdateVrfOptionsRequest.update_mask:type_name -> google.protobuf.FieldMask
+	3,  // 8: opi_evpn_bridge.v1alpha1.VrfOptionsService.GetVrfOptions:input_type -> opi_evpn_bridge.v1alpha1.GetVrfOptionsRequest
+	4,  // 9: opi_evpn_bridge.v1alpha1.VrfOptionsService.UpdateVrfOptions:input_type -> opi_evpn_bridge.v1alpha1.UpdateVrfOptionsRequest
+	0,  // 10: opi_evpn_bridge.v1alpha1.VrfOptionsService.GetVrfOptions:output_type -> opi_evpn_bridge.v1alpha1.VrfOptions
+	0,  // 11: opi_evpn_bridge.v1alpha1.VrfOptionsService.UpdateVrfOptions:output_type -> opi_evpn_bridge.v1alpha1.VrfOptions
+	10, // [10:12] is the sub-list for method output_type
+	8,  // [8:10] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_vrf_proto_init() }
+func file_vrf_proto_init() {
+	if File_vrf_proto != nil {
+		return
+	}
+	file_evpn_proto_init()
+	file_vxlan_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_vrf_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VrfOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vrf_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VrfOptionsSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vrf_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VrfOptionsStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vrf_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVrfOptionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vrf_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateVrfOptionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_vrf_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_vrf_proto_goTypes,
+		DependencyIndexes: file_vrf_proto_depIdxs,
+		MessageInfos:      file_vrf_proto_msgTypes,
+	}.Build()
+	File_vrf_proto = out.File
+	file_vrf_proto_rawDesc = nil
+	file_vrf_proto_goTypes = nil
+	file_vrf_proto_depIdxs = nil
+}
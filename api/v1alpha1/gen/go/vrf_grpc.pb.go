@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: vrf.proto
+
+package _go
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	VrfOptionsService_GetVrfOptions_FullMethodName    = "/opi_evpn_bridge.v1alpha1.VrfOptionsService/GetVrfOptions"
+	VrfOptionsService_UpdateVrfOptions_FullMethodName = "/opi_evpn_bridge.v1alpha1.VrfOptionsService/UpdateVrfOptions"
+)
+
+// VrfOptionsServiceClient is the client API for VrfOptionsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VrfOptionsServiceClient interface {
+	// Get the options of a VRF, defaults for options never set
+	GetVrfOptions(ctx context.Context, in *GetVrfOptionsRequest, opts ...grpc.CallOption) (*VrfOptions, error)
+	// Set the options of a VRF, they apply at once to an existing
+	// VRF, otherwise when it is created
+	UpdateVrfOptions(ctx context.Context, in *UpdateVrfOptionsRequest, opts ...grpc.CallOption) (*VrfOptions, error)
+}
+
+type vrfOptionsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVrfOptionsServiceClient(cc grpc.ClientConnInterface) VrfOptionsServiceClient {
+	return &vrfOptionsServiceClient{cc}
+}
+
+func (c *vrfOptionsServiceClient) GetVrfOptions(ctx context.Context, in *GetVrfOptionsRequest, opts ...grpc.CallOption) (*VrfOptions, error) {
+	out := new(VrfOptions)
+	err := c.cc.Invoke(ctx, VrfOptionsService_GetVrfOptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vrfOptionsServiceClient) UpdateVrfOptions(ctx context.Context, in *UpdateVrfOptionsRequest, opts ...grpc.CallOption) (*VrfOptions, error) {
+	out := new(VrfOptions)
+	err := c.cc.Invoke(ctx, VrfOptionsService_UpdateVrfOptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VrfOptionsServiceServer is the server API for VrfOptionsService service.
+// All implementations must embed UnimplementedVrfOptionsServiceServer
+// for forward compatibility
+type VrfOptionsServiceServer interface {
+	// Get the options of a VRF, defaults for options never set
+	GetVrfOptions(context.Context, *GetVrfOptionsRequest) (*VrfOptions, error)
+	// Set the options of a VRF, they apply at once to an existing
+	// VRF, otherwise when it is created
+	UpdateVrfOptions(context.Context, *UpdateVrfOptionsRequest) (*VrfOptions, error)
+	mustEmbedUnimplementedVrfOptionsServiceServer()
+}
+
+// UnimplementedVrfOptionsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedVrfOptionsServiceServer struct {
+}
+
+func (UnimplementedVrfOptionsServiceServer) GetVrfOptions(context.Context, *GetVrfOptionsRequest) (*VrfOptions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVrfOptions not implemented")
+}
+func (UnimplementedVrfOptionsServiceServer) UpdateVrfOptions(context.Context, *UpdateVrfOptionsRequest) (*VrfOptions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVrfOptions not implemented")
+}
+func (UnimplementedVrfOptionsServiceServer) mustEmbedUnimplementedVrfOptionsServiceServer() {}
+
+// UnsafeVrfOptionsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VrfOptionsServiceServer will
+// result in compilation errors.
+type UnsafeVrfOptionsServiceServer interface {
+	mustEmbedUnimplementedVrfOptionsServiceServer()
+}
+
+func RegisterVrfOptionsServiceServer(s grpc.ServiceRegistrar, srv VrfOptionsServiceServer) {
+	s.RegisterService(&VrfOptionsService_ServiceDesc, srv)
+}
+
+func _VrfOptionsService_GetVrfOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVrfOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VrfOptionsServiceServer).GetVrfOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VrfOptionsService_GetVrfOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VrfOptionsServiceServer).GetVrfOptions(ctx, req.(*GetVrfOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VrfOptionsService_UpdateVrfOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVrfOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VrfOptionsServiceServer).UpdateVrfOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VrfOptionsService_UpdateVrfOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VrfOptionsServiceServer).UpdateVrfOptions(ctx, req.(*UpdateVrfOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VrfOptionsService_ServiceDesc is the grpc.ServiceDesc for VrfOptionsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VrfOptionsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "opi_evpn_bridge.v1alpha1.VrfOptionsService",
+	HandlerType: (*VrfOptionsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetVrfOptions",
+			Handler:    _VrfOptionsService_GetVrfOptions_Handler,
+		},
+		{
+			MethodName: "UpdateVrfOptions",
+			Handler:    _VrfOptionsService_UpdateVrfOptions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "vrf.proto",
+}
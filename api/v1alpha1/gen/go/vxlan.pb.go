@@ -0,0 +1,229 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: vxlan.proto
+
+package _go
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Parameters of a VXLAN device, like the options of `ip link add type vxlan`
+type VxlanOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UDP destination port, linux.vxlanport of the config when not set
+	DstPort *uint32 `protobuf:"varint,1,opt,name=dst_port,json=dstPort,proto3,oneof" json:"dst_port,omitempty"`
+	// TTL of the outer IP header, 1 to 255, 0 lets the kernel choose
+	Ttl uint32 `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// TOS of the outer IP header, 0 to 255
+	Tos uint32 `protobuf:"varint,3,opt,name=tos,proto3" json:"tos,omitempty"`
+	// copy the TOS of the inner packet to the outer IP header, instead of tos
+	TosInherit bool `protobuf:"varint,4,opt,name=tos_inherit,json=tosInherit,proto3" json:"tos_inherit,omitempty"`
+	// compute the checksum of the outer UDP header over IPv4
+	UdpCsum bool `protobuf:"varint,5,opt,name=udp_csum,json=udpCsum,proto3" json:"udp_csum,omitempty"`
+	// lowest UDP source port, set together with src_port_high,
+	// the kernel chooses the range when both are 0
+	SrcPortLow uint32 `protobuf:"varint,6,opt,name=src_port_low,json=srcPortLow,proto3" json:"src_port_low,omitempty"`
+	// highest UDP source port
+	SrcPortHigh uint32 `protobuf:"varint,7,opt,name=src_port_high,json=srcPortHigh,proto3" json:"src_port_high,omitempty"`
+	// learn remote MAC addresses from received packets, EVPN installs them otherwise
+	Learning bool `protobuf:"varint,8,opt,name=learning,proto3" json:"learning,omitempty"`
+}
+
+func (x *VxlanOptions) Reset() {
+	*x = VxlanOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vxlan_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VxlanOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VxlanOptions) ProtoMessage() {}
+
+func (x *VxlanOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_vxlan_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VxlanOptions.ProtoReflect.Descriptor instead.
+func (*VxlanOptions) Descriptor() ([]byte, []int) {
+	return file_vxlan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VxlanOptions) GetDstPort() uint32 {
+	if x != nil && x.DstPort != nil {
+		return *x.DstPort
+	}
+	return 0
+}
+
+func (x *VxlanOptions) GetTtl() uint32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *VxlanOptions) GetTos() uint32 {
+	if x != nil {
+		return x.Tos
+	}
+	return 0
+}
+
+func (x *VxlanOptions) GetTosInherit() bool {
+	if x != nil {
+		return x.TosInherit
+	}
+	return false
+}
+
+func (x *VxlanOptions) GetUdpCsum() bool {
+	if x != nil {
+		return x.UdpCsum
+	}
+	return false
+}
+
+func (x *VxlanOptions) GetSrcPortLow() uint32 {
+	if x != nil {
+		return x.SrcPortLow
+	}
+	return 0
+}
+
+func (x *VxlanOptions) GetSrcPortHigh() uint32 {
+	if x != nil {
+		return x.SrcPortHigh
+	}
+	return 0
+}
+
+func (x *VxlanOptions) GetLearning() bool {
+	if x != nil {
+		return x.Learning
+	}
+	return false
+}
+
+var File_vxlan_proto protoreflect.FileDescriptor
+
+var file_vxlan_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x76, 0x78, 0x6c, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x6f,
+	0x70, 0x69, 0x5f, 0x65, 0x76, 0x70, 0x6e, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0xfd, 0x01, 0x0a, 0x0c, 0x56, 0x78, 0x6c, 0x61,
+	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x07, 0x64, 0x73,
+	0x74, 0x50, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x6f,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x74, 0x6f, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x6f, 0x73, 0x5f, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x74, 0x6f, 0x73, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x75, 0x64, 0x70, 0x5f, 0x63, 0x73, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x75, 0x64, 0x70, 0x43, 0x73, 0x75, 0x6d, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x72, 0x63, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6c, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x73, 0x72, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x4c, 0x6f, 0x77, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x72,
+	0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x68, 0x69, 0x67, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x73, 0x72, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x48, 0x69, 0x67, 0x68, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64,
+	0x73, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x69, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x2f, 0x6f, 0x70, 0x69, 0x2d, 0x65, 0x76, 0x70, 0x6e, 0x2d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x67, 0x65,
+	0x6e, 0x2f, 0x67, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_vxlan_proto_rawDescOnce sync.Once
+	file_vxlan_proto_rawDescData = file_vxlan_proto_rawDesc
+)
+
+func file_vxlan_proto_rawDescGZIP() []byte {
+	file_vxlan_proto_rawDescOnce.Do(func() {
+		file_vxlan_proto_rawDescData = protoimpl.X.CompressGZIP(file_vxlan_proto_rawDescData)
+	})
+	return file_vxlan_proto_rawDescData
+}
+
+var file_vxlan_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_vxlan_proto_goTypes = []interface{}{
+	(*VxlanOptions)(nil), // 0: opi_evpn_bridge.v1alpha1.VxlanOptions
+}
+var file_vxlan_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_vxlan_proto_init() }
+func file_vxlan_proto_init() {
+	if File_vxlan_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_vxlan_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VxlanOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_vxlan_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_vxlan_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_vxlan_proto_goTypes,
+		DependencyIndexes: file_vxlan_proto_depIdxs,
+		MessageInfos:      file_vxlan_proto_msgTypes,
+	}.Build()
+	File_vxlan_proto = out.File
+	file_vxlan_proto_rawDesc = nil
+	file_vxlan_proto_goTypes = nil
+	file_vxlan_proto_depIdxs = nil
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package main implements evpnctl, the command line client of the EVPN bridge
+package main
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/admin"
+)
+
+func newApplyCommand(opts *options) *cobra.Command {
+	var file string
+	var prune, dryRun bool
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Converge the bridge to the desired state described by a manifest",
+		Long: "Compare the manifest with the objects on the bridge, print the plan of creates, updates " +
+			"and deletes and execute it. The manifest has the format of the snapshot written by export.",
+		Args: cobra.NoArgs,
+		RunE: func(c *cobra.Command, _ []string) error {
+			manifest, err := readSnapshot(c.InOrStdin(), file, "")
+			if err != nil {
+				return err
+			}
+			conn, ctx, cancel, err := opts.connect()
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			defer cancel()
+			response, err := pa.NewAdminServiceClient(conn).ApplyConfig(ctx, &pa.ApplyConfigRequest{
+				Manifest: manifest,
+				Prune:    prune,
+				PlanOnly: dryRun,
+			})
+			if err != nil {
+				return err
+			}
+			return printPlan(c.OutOrStdout(), opts.output, response)
+		},
+	}
+	cmd.Flags().StringVarP(&file, "file", "f", "", "Manifest file in JSON or YAML format, - for stdin")
+	cmd.Flags().BoolVar(&prune, "prune", false, "Delete objects which are not in the manifest")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only print the plan, do not change anything")
+	_ = cmd.MarkFlagRequired("file")
+	return cmd
+}
+
+// snapshotFormat returns format, or JSON for a .json file and YAML otherwise
+func snapshotFormat(file, format string) string {
+	if format != "" {
+		return format
+	}
+	if strings.EqualFold(filepath.Ext(file), ".json") {
+		return admin.FormatJSON
+	}
+	return admin.FormatYAML
+}
+
+// printPlan writes the actions of the plan, as table or as the full response
+func printPlan(w io.Writer, format string, response *pa.ApplyConfigResponse) error {
+	if format != outputTable {
+		return printObjects(w, format, nil, []proto.Message{response}, false)
+	}
+	if len(response.Actions) == 0 {
+		_, err := fmt.Fprintln(w, "Nothing to do, the bridge matches the manifest")
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(tw, "ACTION\tNAME\tFIELDS")
+	for _, action := range response.Actions {
+		operation := formatEnum(action.Operation.String(), "OPERATION_")
+		fmt.Fprintf(tw, "%v\t%v\t%v\n", operation, action.Name, strings.Join(action.Paths, ","))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if !response.Applied {
+		_, err := fmt.Fprintln(w, "Dry run, nothing was changed")
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package main implements evpnctl, the command line client of the EVPN bridge
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+)
+
+type bridgeResource struct {
+	vlan uint32
+	vni  uint32
+	vtep string
+}
+
+func newBridgeResource() resource {
+	return &bridgeResource{}
+}
+
+func (r *bridgeResource) kind() string {
+	return "bridge"
+}
+
+func (r *bridgeResource) aliases() []string {
+	return []string{"bridges", "lb"}
+}
+
+func (r *bridgeResource) addFlags(fs *pflag.FlagSet) {
+	fs.Uint32Var(&r.vlan, "vlan", 0, "VLAN ID of the bridge")
+	fs.Uint32Var(&r.vni, "vni", 0, "VXLAN network identifier")
+	fs.StringVar(&r.vtep, "vtep", "", "VTEP IP prefix in CIDR form, e.g. 10.0.0.2/32")
+}
+
+// applyFlags copies the flags given on the command line into spec
+// and returns the field paths they correspond to
+func (r *bridgeResource) applyFlags(fs *pflag.FlagSet, spec *pb.LogicalBridgeSpec) ([]string, error) {
+	var paths []string
+	if fs.Changed("vlan") {
+		spec.VlanId = r.vlan
+		paths = append(paths, "spec.vlan_id")
+	}
+	if fs.Changed("vni") {
+		spec.Vni = proto.Uint32(r.vni)
+		paths = append(paths, "spec.vni")
+	}
+	if fs.Changed("vtep") {
+		prefix, err := parsePrefix(r.vtep)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --vtep: %w", err)
+		}
+		spec.VtepIpPrefix = prefix
+		paths = append(paths, "spec.vtep_ip_prefix")
+	}
+	return paths, nil
+}
+
+func (r *bridgeResource) create(ctx context.Context, conn grpc.ClientConnInterface, fs *pflag.FlagSet, name string) (proto.Message, error) {
+	spec := &pb.LogicalBridgeSpec{}
+	if _, err := r.applyFlags(fs, spec); err != nil {
+		return nil, err
+	}
+	return pb.NewLogicalBridgeServiceClient(conn).CreateLogicalBridge(ctx, &pb.CreateLogicalBridgeRequest{
+		LogicalBridgeId: path.Base(name),
+		LogicalBridge:   &pb.LogicalBridge{Spec: spec},
+	})
+}
+
+func (r *bridgeResource) update(ctx context.Context, conn grpc.ClientConnInterface, fs *pflag.FlagSet, name string) (proto.Message, error) {
+	client := pb.NewLogicalBridgeServiceClient(conn)
+	obj, err := client.GetLogicalBridge(ctx, &pb.GetLogicalBridgeRequest{Name: fullName("bridges", name)})
+	if err != nil {
+		return nil, err
+	}
+	if obj.Spec == nil {
+		obj.Spec = &pb.LogicalBridgeSpec{}
+	}
+	paths, err := r.applyFlags(fs, obj.Spec)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, errors.New("nothing to update, set at least one flag")
+	}
+	obj.Status = nil
+	return client.UpdateLogicalBridge(ctx, &pb.UpdateLogicalBridgeRequest{LogicalBridge: obj, UpdateMask: &fieldmaskpb.FieldMask{Paths: paths}})
+}
+
+func (r *bridgeResource) get(ctx context.Context, conn grpc.ClientConnInterface, name string) (proto.Message, error) {
+	return pb.NewLogicalBridgeServiceClient(conn).GetLogicalBridge(ctx, &pb.GetLogicalBridgeRequest{Name: fullName("bridges", name)})
+}
+
+func (r *bridgeResource) list(ctx context.Context, conn grpc.ClientConnInterface) ([]proto.Message, error) {
+	client := pb.NewLogicalBridgeServiceClient(conn)
+	var objs []proto.Message
+	token := ""
+	for {
+		response, err := client.ListLogicalBridges(ctx, &pb.ListLogicalBridgesRequest{PageToken: token})
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range response.LogicalBridges {
+			objs = append(objs, obj)
+		}
+		if token = response.NextPageToken; token == "" {
+			return objs, nil
+		}
+	}
+}
+
+func (r *bridgeResource) remove(ctx context.Context, conn grpc.ClientConnInterface, name string, allowMissing bool) error {
+	_, err := pb.NewLogicalBridgeServiceClient(conn).DeleteLogicalBridge(ctx, &pb.DeleteLogicalBridgeRequest{Name: fullName("bridges", name), AllowMissing: allowMissing})
+	return err
+}
+
+func (r *bridgeResource) columns() []string {
+	return []string{"NAME", "VLAN", "VNI", "VTEP", "STATUS"}
+}
+
+func (r *bridgeResource) row(obj proto.Message) []string {
+	bridge := obj.(*pb.LogicalBridge)
+	return []string{
+		shortName(bridge.GetName()),
+		fmt.Sprint(bridge.GetSpec().GetVlanId()),
+		formatOptional(bridge.GetSpec().GetVni(), bridge.GetSpec() != nil && bridge.Spec.Vni != nil),
+		formatPrefix(bridge.GetSpec().GetVtepIpPrefix()),
+		formatEnum(bridge.GetStatus().GetOperStatus().String(), "LB_OPER_STATUS_"),
+	}
+}
@@ -0,0 +1,360 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package main implements evpnctl, the command line client of the EVPN bridge
+package main
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+	pc "github.com/opiproject/opi-api/network/opinetcommon/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+)
+
+func TestEvpnctl_ParsePrefix(t *testing.T) {
+	tests := map[string]struct {
+		in        string
+		out       *pc.IPPrefix
+		formatted string
+		expectErr bool
+	}{
+		"ipv4": {
+			in: "10.0.0.2/24",
+			out: &pc.IPPrefix{
+				Addr: &pc.IPAddress{Af: pc.IpAf_IP_AF_INET, V4OrV6: &pc.IPAddress_V4Addr{V4Addr: 167772162}},
+				Len:  24,
+			},
+			formatted: "10.0.0.2/24",
+		},
+		"ipv6": {
+			in: "fd00::1/64",
+			out: &pc.IPPrefix{
+				Addr: &pc.IPAddress{Af: pc.IpAf_IP_AF_INET6, V4OrV6: &pc.IPAddress_V6Addr{V6Addr: net.ParseIP("fd00::1")}},
+				Len:  64,
+			},
+			formatted: "fd00::1/64",
+		},
+		"missing length": {
+			in:        "10.0.0.2",
+			expectErr: true,
+		},
+		"not an address": {
+			in:        "bridge/24",
+			expectErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			out, err := parsePrefix(tt.in)
+			if (err != nil) != tt.expectErr {
+				t.Error("Expect error", tt.expectErr, "received", err)
+			}
+			if !proto.Equal(out, tt.out) {
+				t.Error("Expect", tt.out, "received", out)
+			}
+			if !tt.expectErr && formatPrefix(out) != tt.formatted {
+				t.Error("Expect", tt.formatted, "received", formatPrefix(out))
+			}
+		})
+	}
+}
+
+func TestEvpnctl_ParseHelpers(t *testing.T) {
+	if name := fullName("bridges", "red"); name != "//network.opiproject.org/bridges/red" {
+		t.Error("Expect full name, received", name)
+	}
+	if name := fullName("bridges", "//network.opiproject.org/bridges/red"); name != "//network.opiproject.org/bridges/red" {
+		t.Error("Expect unchanged full name, received", name)
+	}
+	mac, err := parseMAC("aa:bb:cc:00:00:01")
+	if err != nil || formatMAC(mac) != "aa:bb:cc:00:00:01" {
+		t.Error("Expect valid MAC, received", mac, err)
+	}
+	if _, err := parseMAC("00:00:00:00:fe:80:00:00"); err == nil {
+		t.Error("Expect error for 8 byte MAC")
+	}
+	if ptype, err := parsePortType("Trunk"); err != nil || ptype != pb.BridgePortType_TRUNK {
+		t.Error("Expect trunk, received", ptype, err)
+	}
+	if _, err := parsePortType("unknown"); err == nil {
+		t.Error("Expect error for unknown port type")
+	}
+}
+
+// fakeVrfServer records the requests it receives
+type fakeVrfServer struct {
+	pb.UnimplementedVrfServiceServer
+	created *pb.CreateVrfRequest
+	updated *pb.UpdateVrfRequest
+	deleted *pb.DeleteVrfRequest
+	etag    string
+}
+
+var testVrf = pb.Vrf{
+	Name: "//network.opiproject.org/vrfs/blue",
+	Spec: &pb.VrfSpec{
+		Vni: proto.Uint32(1000),
+		LoopbackIpPrefix: &pc.IPPrefix{
+			Addr: &pc.IPAddress{Af: pc.IpAf_IP_AF_INET, V4OrV6: &pc.IPAddress_V4Addr{V4Addr: 167772161}},
+			Len:  32,
+		},
+	},
+	Status: &pb.VrfStatus{RoutingTable: 1001, Rmac: []byte{0xaa, 0xbb, 0xcc, 0x00, 0x00, 0x01}},
+}
+
+func (s *fakeVrfServer) CreateVrf(ctx context.Context, in *pb.CreateVrfRequest) (*pb.Vrf, error) {
+	s.created = in
+	if utils.IsDryRun(ctx) {
+		_ = grpc.SetHeader(ctx, metadata.Pairs(
+			utils.DryRunHeader, "true",
+			utils.DryRunNetlinkHeader, "ip link add blue type vrf table 1001",
+			utils.DryRunFrrHeader, "zebra: vrf blue",
+		))
+	}
+	return &testVrf, nil
+}
+
+func (s *fakeVrfServer) UpdateVrf(ctx context.Context, in *pb.UpdateVrfRequest) (*pb.Vrf, error) {
+	s.updated = in
+	if etags := metadata.ValueFromIncomingContext(ctx, utils.EtagHeader); len(etags) > 0 {
+		s.etag = etags[0]
+	}
+	return in.Vrf, nil
+}
+
+func (s *fakeVrfServer) GetVrf(ctx context.Context, _ *pb.GetVrfRequest) (*pb.Vrf, error) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs(utils.EtagHeader, "0123456789abcdef"))
+	return proto.Clone(&testVrf).(*pb.Vrf), nil
+}
+
+func (s *fakeVrfServer) ListVrfs(_ context.Context, in *pb.ListVrfsRequest) (*pb.ListVrfsResponse, error) {
+	// return the same object on two pages to check that all pages are fetched
+	if in.PageToken == "" {
+		return &pb.ListVrfsResponse{Vrfs: []*pb.Vrf{&testVrf}, NextPageToken: "next"}, nil
+	}
+	return &pb.ListVrfsResponse{Vrfs: []*pb.Vrf{&testVrf}}, nil
+}
+
+func (s *fakeVrfServer) DeleteVrf(_ context.Context, in *pb.DeleteVrfRequest) (*emptypb.Empty, error) {
+	s.deleted = in
+	return &emptypb.Empty{}, nil
+}
+
+func TestEvpnctl_VrfCommands(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	fake := &fakeVrfServer{}
+	pb.RegisterVrfServiceServer(server, fake)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	defer server.Stop()
+
+	tests := map[string]struct {
+		args     []string
+		contains []string
+		check    func(t *testing.T)
+	}{
+		"create": {
+			args:     []string{"create", "vrf", "blue", "--vni", "1000", "--loopback", "10.0.0.1/32"},
+			contains: []string{"NAME", "blue", "1000", "10.0.0.1/32", "1001", "aa:bb:cc:00:00:01"},
+			check: func(t *testing.T) {
+				if fake.created.VrfId != "blue" || fake.created.Vrf.Spec.GetVni() != 1000 ||
+					formatPrefix(fake.created.Vrf.Spec.LoopbackIpPrefix) != "10.0.0.1/32" {
+					t.Error("Unexpected create request", fake.created)
+				}
+			},
+		},
+		"create dry run": {
+			args:     []string{"create", "vrf", "blue", "--vni", "1000", "--dry-run"},
+			contains: []string{"blue", "Dry run", "netlink: ip link add blue type vrf table 1001", "frr: zebra: vrf blue"},
+		},
+		"update sends only changed fields": {
+			args:     []string{"update", "vrf", "blue", "--vni", "2000", "-o", "json"},
+			contains: []string{`"vni": 2000`},
+			check: func(t *testing.T) {
+				paths := fake.updated.UpdateMask.GetPaths()
+				if len(paths) != 1 || paths[0] != "spec.vni" {
+					t.Error("Unexpected update mask", paths)
+				}
+				if fake.updated.Vrf.Status != nil {
+					t.Error("Expect status to be dropped, received", fake.updated.Vrf.Status)
+				}
+				if fake.etag != "0123456789abcdef" {
+					t.Error("Expect etag read by get to be sent, received", fake.etag)
+				}
+			},
+		},
+		"list all pages as yaml": {
+			args:     []string{"list", "vrfs", "-o", "yaml"},
+			contains: []string{"- name: //network.opiproject.org/vrfs/blue\n", "  spec:\n"},
+		},
+		"get shows etag": {
+			args:     []string{"get", "vrf", "blue"},
+			contains: []string{"ETAG: 0123456789abcdef"},
+		},
+		"delete with full name": {
+			args: []string{"delete", "vrf", "//network.opiproject.org/vrfs/blue", "--allow-missing"},
+			check: func(t *testing.T) {
+				if fake.deleted.Name != "//network.opiproject.org/vrfs/blue" || !fake.deleted.AllowMissing {
+					t.Error("Unexpected delete request", fake.deleted)
+				}
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			cmd := newRootCommand()
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetArgs(append(tt.args, "--addr", lis.Addr().String()))
+			if err := cmd.Execute(); err != nil {
+				t.Fatal("Expect no error, received", err)
+			}
+			for _, s := range tt.contains {
+				if !strings.Contains(out.String(), s) {
+					t.Errorf("Expect %q in output, received\n%s", s, out.String())
+				}
+			}
+			if tt.check != nil {
+				tt.check(t)
+			}
+		})
+	}
+}
+
+// fakeAdminServer returns a fixed plan and snapshot
+type fakeAdminServer struct {
+	pa.UnimplementedAdminServiceServer
+	request  *pa.ApplyConfigRequest
+	imported *pa.ImportConfigRequest
+}
+
+func (s *fakeAdminServer) ExportConfig(_ context.Context, _ *pa.ExportConfigRequest) (*pa.ConfigSnapshot, error) {
+	return &pa.ConfigSnapshot{
+		Version: 1,
+		Vrfs:    []*pb.Vrf{{Name: "//network.opiproject.org/vrfs/blue", Spec: &pb.VrfSpec{Vni: proto.Uint32(2000)}}},
+	}, nil
+}
+
+func (s *fakeAdminServer) ImportConfig(_ context.Context, in *pa.ImportConfigRequest) (*pa.ImportConfigResponse, error) {
+	s.imported = in
+	return &pa.ImportConfigResponse{Created: []string{in.Snapshot.Vrfs[0].Name}}, nil
+}
+
+func (s *fakeAdminServer) ApplyConfig(_ context.Context, in *pa.ApplyConfigRequest) (*pa.ApplyConfigResponse, error) {
+	s.request = in
+	return &pa.ApplyConfigResponse{
+		Actions: []*pa.PlanAction{
+			{Operation: pa.PlanAction_OPERATION_UPDATE, Name: "//network.opiproject.org/vrfs/blue", Paths: []string{"spec.vni"}},
+			{Operation: pa.PlanAction_OPERATION_DELETE, Name: "//network.opiproject.org/svis/yellow"},
+		},
+		Applied: !in.PlanOnly,
+	}, nil
+}
+
+func TestEvpnctl_Apply(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	fake := &fakeAdminServer{}
+	pa.RegisterAdminServiceServer(server, fake)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	defer server.Stop()
+
+	manifest := filepath.Join(t.TempDir(), "manifest.yaml")
+	data := "version: 1\nvrfs:\n  - name: //network.opiproject.org/vrfs/blue\n    spec:\n      vni: 2000\n"
+	if err := os.WriteFile(manifest, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := newRootCommand()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"apply", "-f", manifest, "--prune", "--dry-run", "--addr", lis.Addr().String()})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal("Expect no error, received", err)
+	}
+	if !fake.request.Prune || !fake.request.PlanOnly || fake.request.Manifest.Vrfs[0].Spec.GetVni() != 2000 {
+		t.Error("Unexpected apply request", fake.request)
+	}
+	for _, s := range []string{"update   //network.opiproject.org/vrfs/blue     spec.vni", "delete   //network.opiproject.org/svis/yellow", "Dry run"} {
+		if !strings.Contains(out.String(), s) {
+			t.Errorf("Expect %q in output, received\n%s", s, out.String())
+		}
+	}
+}
+
+func TestEvpnctl_ExportImport(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	fake := &fakeAdminServer{}
+	pa.RegisterAdminServiceServer(server, fake)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	defer server.Stop()
+
+	snapshot := filepath.Join(t.TempDir(), "snapshot.json")
+	run := func(args ...string) string {
+		cmd := newRootCommand()
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetArgs(append(args, "--addr", lis.Addr().String()))
+		if err := cmd.Execute(); err != nil {
+			t.Fatal("Expect no error, received", err)
+		}
+		return out.String()
+	}
+
+	if out := run("export", "-f", snapshot); !strings.Contains(out, "Exported 1 VRFs") {
+		t.Error("Unexpected export output", out)
+	}
+	data, err := os.ReadFile(snapshot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"vni": 2000`) {
+		t.Error("Expect JSON snapshot, received", string(data))
+	}
+	if out := run("export", "--format", "yaml"); !strings.Contains(out, "vni: 2000") {
+		t.Error("Expect YAML snapshot on stdout, received", out)
+	}
+
+	if out := run("import", "-f", snapshot, "--validate-only"); !strings.Contains(out, "is valid") {
+		t.Error("Unexpected validate output", out)
+	}
+	if !fake.imported.ValidateOnly || fake.imported.Snapshot.Vrfs[0].Spec.GetVni() != 2000 {
+		t.Error("Unexpected import request", fake.imported)
+	}
+	if out := run("import", "-f", snapshot); !strings.Contains(out, "Created //network.opiproject.org/vrfs/blue") {
+		t.Error("Unexpected import output", out)
+	}
+	if fake.imported.ValidateOnly {
+		t.Error("Expect import, received validation only")
+	}
+}
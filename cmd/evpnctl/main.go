@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package main implements evpnctl, the command line client of the EVPN bridge
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+)
+
+// options are the global flags shared by all subcommands
+type options struct {
+	addr     string
+	tlsFiles string
+	output   string
+	timeout  time.Duration
+	// dryRun asks the bridge to only report the changes of mutating calls,
+	// which are collected in dryRunHeader
+	dryRun       bool
+	dryRunHeader metadata.MD
+	// etag is sent with Update and Delete calls, when empty the etag
+	// returned by the last call is used, so read-modify-write is safe
+	etag     string
+	lastEtag string
+}
+
+// connect dials the bridge and returns a context bound to the request timeout
+func (o *options) connect() (*grpc.ClientConn, context.Context, context.CancelFunc, error) {
+	dialOption := grpc.WithTransportCredentials(insecure.NewCredentials())
+	if o.tlsFiles != "" {
+		tlsConfig, err := utils.ParseTLSFiles(o.tlsFiles)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if dialOption, err = utils.SetupTLSClientCredentials(tlsConfig); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	ctx := context.Background()
+	if o.dryRun {
+		ctx = metadata.AppendToOutgoingContext(ctx, utils.DryRunHeader, "true")
+	}
+	conn, err := grpc.Dial(o.addr, dialOption, grpc.WithUnaryInterceptor(o.intercept))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	return conn, ctx, cancel, nil
+}
+
+// intercept sends the etag with Update and Delete calls and keeps
+// the etag and the dry run operations returned in the response header
+func (o *options) intercept(ctx context.Context, method string, req, reply interface{},
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if strings.Contains(method, "/Update") || strings.Contains(method, "/Delete") {
+		etag := o.etag
+		if etag == "" {
+			etag = o.lastEtag
+		}
+		if etag != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, utils.EtagHeader, etag)
+		}
+	}
+	var header metadata.MD
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...)
+	if len(header.Get(utils.DryRunHeader)) > 0 {
+		o.dryRunHeader = header
+	}
+	if etags := header.Get(utils.EtagHeader); len(etags) > 0 {
+		o.lastEtag = etags[0]
+	}
+	return err
+}
+
+func newRootCommand() *cobra.Command {
+	opts := &options{}
+	cmd := &cobra.Command{
+		Use:          "evpnctl",
+		Short:        "Manage VRFs, logical bridges, bridge ports and SVIs of the EVPN bridge",
+		SilenceUsage: true,
+		PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+			switch opts.output {
+			case outputTable, outputJSON, outputYAML:
+				return nil
+			default:
+				return fmt.Errorf("unsupported output format %q, use %v, %v or %v", opts.output, outputTable, outputJSON, outputYAML)
+			}
+		},
+	}
+
+	flags := cmd.PersistentFlags()
+	flags.StringVar(&opts.addr, "addr", fmt.Sprintf("localhost:%d", config.Default().GRPCPort), "The gRPC server address")
+	flags.StringVar(&opts.tlsFiles, "tls", "", "TLS files in client_cert:client_key:ca_cert format")
+	flags.StringVarP(&opts.output, "output", "o", outputTable, "Output format: table, json or yaml")
+	flags.DurationVar(&opts.timeout, "timeout", 10*time.Second, "Timeout of the request")
+
+	resources := []func() resource{newVrfResource, newBridgeResource, newPortResource, newSviResource}
+	cmd.AddCommand(
+		newCreateCommand(opts, resources),
+		newGetCommand(opts, resources),
+		newListCommand(opts, resources),
+		newUpdateCommand(opts, resources),
+		newDeleteCommand(opts, resources),
+		newApplyCommand(opts),
+		newExportCommand(opts),
+		newImportCommand(opts),
+	)
+	return cmd
+}
+
+func main() {
+	if err := newRootCommand().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package main implements evpnctl, the command line client of the EVPN bridge
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// output formats
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// printObjects writes the objects in the requested format, results of list
+// are printed as JSON or YAML array and single objects as they are
+func printObjects(w io.Writer, format string, r resource, objs []proto.Message, list bool) error {
+	switch format {
+	case outputTable:
+		tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(tw, strings.Join(r.columns(), "\t"))
+		for _, obj := range objs {
+			fmt.Fprintln(tw, strings.Join(r.row(obj), "\t"))
+		}
+		return tw.Flush()
+	case outputJSON:
+		data, err := marshalJSON(objs, list)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	case outputYAML:
+		data, err := marshalJSON(objs, list)
+		if err != nil {
+			return err
+		}
+		// JSON is valid YAML, decode it into nodes to keep the field order
+		var node yaml.Node
+		if err := yaml.Unmarshal(data, &node); err != nil {
+			return err
+		}
+		resetStyle(&node)
+		data, err = yaml.Marshal(&node)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	default:
+		return fmt.Errorf("unsupported output format %q", format)
+	}
+}
+
+// marshalJSON encodes the objects as JSON array or a single JSON object
+func marshalJSON(objs []proto.Message, list bool) ([]byte, error) {
+	var buf bytes.Buffer
+	if list {
+		buf.WriteString("[")
+	}
+	for i, obj := range objs {
+		data, err := protojson.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		buf.Write(data)
+	}
+	if list {
+		buf.WriteString("]")
+	}
+	// indent with encoding/json, protojson output is deliberately unstable
+	var out bytes.Buffer
+	if err := json.Indent(&out, buf.Bytes(), "", "  "); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// resetStyle switches JSON flow style nodes to YAML block style
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, n := range node.Content {
+		resetStyle(n)
+	}
+}
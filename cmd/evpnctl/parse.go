@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package main implements evpnctl, the command line client of the EVPN bridge
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"path"
+	"strings"
+
+	"go.einride.tech/aip/resourcename"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+	pc "github.com/opiproject/opi-api/network/opinetcommon/v1alpha1/gen/go"
+)
+
+// fullName turns a short name like blue into the full resource name of the
+// collection, names which are already full are returned unchanged
+func fullName(collection string, name string) string {
+	if strings.HasPrefix(name, "//") {
+		return name
+	}
+	return resourcename.Join("//network.opiproject.org/", collection, name)
+}
+
+// shortName returns the resource id of a full resource name
+func shortName(name string) string {
+	if name == "" {
+		return ""
+	}
+	return path.Base(name)
+}
+
+// parsePrefix converts CIDR notation like 10.0.0.1/24 into IPPrefix
+func parsePrefix(s string) (*pc.IPPrefix, error) {
+	ip, ipNet, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil, err
+	}
+	ones, _ := ipNet.Mask.Size()
+	prefix := &pc.IPPrefix{Len: int32(ones)}
+	if v4 := ip.To4(); v4 != nil {
+		prefix.Addr = &pc.IPAddress{
+			Af:     pc.IpAf_IP_AF_INET,
+			V4OrV6: &pc.IPAddress_V4Addr{V4Addr: binary.BigEndian.Uint32(v4)},
+		}
+	} else {
+		prefix.Addr = &pc.IPAddress{
+			Af:     pc.IpAf_IP_AF_INET6,
+			V4OrV6: &pc.IPAddress_V6Addr{V6Addr: ip.To16()},
+		}
+	}
+	return prefix, nil
+}
+
+// formatPrefix converts IPPrefix into CIDR notation
+func formatPrefix(prefix *pc.IPPrefix) string {
+	if prefix == nil {
+		return ""
+	}
+	var ip net.IP
+	switch addr := prefix.GetAddr().GetV4OrV6().(type) {
+	case *pc.IPAddress_V4Addr:
+		ip = make(net.IP, net.IPv4len)
+		binary.BigEndian.PutUint32(ip, addr.V4Addr)
+	case *pc.IPAddress_V6Addr:
+		ip = net.IP(addr.V6Addr)
+	default:
+		return fmt.Sprintf("/%d", prefix.Len)
+	}
+	return fmt.Sprintf("%v/%d", ip, prefix.Len)
+}
+
+// formatPrefixes joins the prefixes with comma
+func formatPrefixes(prefixes []*pc.IPPrefix) string {
+	s := make([]string, len(prefixes))
+	for i, prefix := range prefixes {
+		s[i] = formatPrefix(prefix)
+	}
+	return strings.Join(s, ",")
+}
+
+// parseMAC converts MAC address like aa:bb:cc:00:00:01 into bytes
+func parseMAC(s string) ([]byte, error) {
+	mac, err := net.ParseMAC(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(mac) != 6 {
+		return nil, fmt.Errorf("MAC address %v has to be 6 bytes long", s)
+	}
+	return mac, nil
+}
+
+// formatMAC converts bytes into MAC address notation
+func formatMAC(mac []byte) string {
+	if len(mac) == 0 {
+		return ""
+	}
+	return net.HardwareAddr(mac).String()
+}
+
+// parsePortType converts access or trunk into BridgePortType
+func parsePortType(s string) (pb.BridgePortType, error) {
+	value, ok := pb.BridgePortType_value[strings.ToUpper(s)]
+	if !ok || value == int32(pb.BridgePortType_UNKNOWN) {
+		return pb.BridgePortType_UNKNOWN, fmt.Errorf("unknown port type %q, use access or trunk", s)
+	}
+	return pb.BridgePortType(value), nil
+}
+
+// formatEnum strips the common prefix of enum value names, e.g.
+// LB_OPER_STATUS_UP becomes up
+func formatEnum(name string, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(name, prefix))
+}
+
+// formatOptional prints the value of an optional field, or nothing when it is not set
+func formatOptional(value uint32, set bool) string {
+	if !set {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
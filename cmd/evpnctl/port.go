@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package main implements evpnctl, the command line client of the EVPN bridge
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+)
+
+type portResource struct {
+	mac     string
+	ptype   string
+	bridges []string
+}
+
+func newPortResource() resource {
+	return &portResource{}
+}
+
+func (r *portResource) kind() string {
+	return "port"
+}
+
+func (r *portResource) aliases() []string {
+	return []string{"ports", "bp"}
+}
+
+func (r *portResource) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&r.mac, "mac", "", "MAC address, e.g. aa:bb:cc:00:00:01")
+	fs.StringVar(&r.ptype, "type", "", "Port type: access or trunk")
+	fs.StringSliceVar(&r.bridges, "bridge", nil, "Logical bridge of the port, repeat or separate with comma for trunk ports")
+}
+
+// applyFlags copies the flags given on the command line into spec
+// and returns the field paths they correspond to
+func (r *portResource) applyFlags(fs *pflag.FlagSet, spec *pb.BridgePortSpec) ([]string, error) {
+	var paths []string
+	if fs.Changed("mac") {
+		mac, err := parseMAC(r.mac)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --mac: %w", err)
+		}
+		spec.MacAddress = mac
+		paths = append(paths, "spec.mac_address")
+	}
+	if fs.Changed("type") {
+		ptype, err := parsePortType(r.ptype)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --type: %w", err)
+		}
+		spec.Ptype = ptype
+		paths = append(paths, "spec.ptype")
+	}
+	if fs.Changed("bridge") {
+		spec.LogicalBridges = make([]string, len(r.bridges))
+		for i, bridge := range r.bridges {
+			spec.LogicalBridges[i] = fullName("bridges", bridge)
+		}
+		paths = append(paths, "spec.logical_bridges")
+	}
+	return paths, nil
+}
+
+func (r *portResource) create(ctx context.Context, conn grpc.ClientConnInterface, fs *pflag.FlagSet, name string) (proto.Message, error) {
+	spec := &pb.BridgePortSpec{}
+	if _, err := r.applyFlags(fs, spec); err != nil {
+		return nil, err
+	}
+	return pb.NewBridgePortServiceClient(conn).CreateBridgePort(ctx, &pb.CreateBridgePortRequest{
+		BridgePortId: path.Base(name),
+		BridgePort:   &pb.BridgePort{Spec: spec},
+	})
+}
+
+func (r *portResource) update(ctx context.Context, conn grpc.ClientConnInterface, fs *pflag.FlagSet, name string) (proto.Message, error) {
+	client := pb.NewBridgePortServiceClient(conn)
+	obj, err := client.GetBridgePort(ctx, &pb.GetBridgePortRequest{Name: fullName("ports", name)})
+	if err != nil {
+		return nil, err
+	}
+	if obj.Spec == nil {
+		obj.Spec = &pb.BridgePortSpec{}
+	}
+	paths, err := r.applyFlags(fs, obj.Spec)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, errors.New("nothing to update, set at least one flag")
+	}
+	obj.Status = nil
+	return client.UpdateBridgePort(ctx, &pb.UpdateBridgePortRequest{BridgePort: obj, UpdateMask: &fieldmaskpb.FieldMask{Paths: paths}})
+}
+
+func (r *portResource) get(ctx context.Context, conn grpc.ClientConnInterface, name string) (proto.Message, error) {
+	return pb.NewBridgePortServiceClient(conn).GetBridgePort(ctx, &pb.GetBridgePortRequest{Name: fullName("ports", name)})
+}
+
+func (r *portResource) list(ctx context.Context, conn grpc.ClientConnInterface) ([]proto.Message, error) {
+	client := pb.NewBridgePortServiceClient(conn)
+	var objs []proto.Message
+	token := ""
+	for {
+		response, err := client.ListBridgePorts(ctx, &pb.ListBridgePortsRequest{PageToken: token})
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range response.BridgePorts {
+			objs = append(objs, obj)
+		}
+		if token = response.NextPageToken; token == "" {
+			return objs, nil
+		}
+	}
+}
+
+func (r *portResource) remove(ctx context.Context, conn grpc.ClientConnInterface, name string, allowMissing bool) error {
+	_, err := pb.NewBridgePortServiceClient(conn).DeleteBridgePort(ctx, &pb.DeleteBridgePortRequest{Name: fullName("ports", name), AllowMissing: allowMissing})
+	return err
+}
+
+func (r *portResource) columns() []string {
+	return []string{"NAME", "TYPE", "MAC", "BRIDGES", "STATUS"}
+}
+
+func (r *portResource) row(obj proto.Message) []string {
+	port := obj.(*pb.BridgePort)
+	bridges := make([]string, len(port.GetSpec().GetLogicalBridges()))
+	for i, bridge := range port.GetSpec().GetLogicalBridges() {
+		bridges[i] = shortName(bridge)
+	}
+	return []string{
+		shortName(port.GetName()),
+		strings.ToLower(port.GetSpec().GetPtype().String()),
+		formatMAC(port.GetSpec().GetMacAddress()),
+		strings.Join(bridges, ","),
+		formatEnum(port.GetStatus().GetOperStatus().String(), "BP_OPER_STATUS_"),
+	}
+}
@@ -0,0 +1,205 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package main implements evpnctl, the command line client of the EVPN bridge
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+)
+
+// resource is one of the EVPN collections managed by evpnctl
+type resource interface {
+	// kind is the singular name used on the command line, e.g. vrf
+	kind() string
+	// aliases are alternative names of the kind, e.g. vrfs
+	aliases() []string
+	// addFlags registers the spec flags used by create and update
+	addFlags(fs *pflag.FlagSet)
+	create(ctx context.Context, conn grpc.ClientConnInterface, fs *pflag.FlagSet, name string) (proto.Message, error)
+	update(ctx context.Context, conn grpc.ClientConnInterface, fs *pflag.FlagSet, name string) (proto.Message, error)
+	get(ctx context.Context, conn grpc.ClientConnInterface, name string) (proto.Message, error)
+	list(ctx context.Context, conn grpc.ClientConnInterface) ([]proto.Message, error)
+	remove(ctx context.Context, conn grpc.ClientConnInterface, name string, allowMissing bool) error
+	// columns and row describe the table output
+	columns() []string
+	row(obj proto.Message) []string
+}
+
+// run connects to the bridge, executes the call and prints the returned objects,
+// list tells whether the call returns a collection or a single object
+func run(cmd *cobra.Command, opts *options, r resource, list bool,
+	call func(ctx context.Context, conn grpc.ClientConnInterface) ([]proto.Message, error)) error {
+	conn, ctx, cancel, err := opts.connect()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	defer cancel()
+	objs, err := call(ctx, conn)
+	if opts.dryRun {
+		// operations are reported also when the dry run failed
+		defer printDryRun(cmd, opts)
+	}
+	if err != nil {
+		return err
+	}
+	if objs == nil {
+		return nil
+	}
+	return printObjects(cmd.OutOrStdout(), opts.output, r, objs, list)
+}
+
+// printDryRun writes the operations the bridge would execute, next to the
+// table or to stderr, so that json and yaml output stays parsable
+func printDryRun(cmd *cobra.Command, opts *options) {
+	w := cmd.OutOrStdout()
+	if opts.output != outputTable {
+		w = cmd.ErrOrStderr()
+	}
+	fmt.Fprintln(w, "Dry run, nothing was changed")
+	for _, op := range opts.dryRunHeader.Get(utils.DryRunNetlinkHeader) {
+		fmt.Fprintln(w, "netlink:", op)
+	}
+	for _, command := range opts.dryRunHeader.Get(utils.DryRunFrrHeader) {
+		fmt.Fprintln(w, "frr:", command)
+	}
+}
+
+// addEtagFlag registers --etag on a group of commands changing existing resources
+func addEtagFlag(cmd *cobra.Command, opts *options) {
+	cmd.PersistentFlags().StringVar(&opts.etag, "etag", "",
+		"Fail with Aborted if the resource changed since it was read with this etag")
+}
+
+// addDryRunFlag registers --dry-run on a group of mutating commands
+func addDryRunFlag(cmd *cobra.Command, opts *options) {
+	cmd.PersistentFlags().BoolVar(&opts.dryRun, "dry-run", false,
+		"Only validate the request and print the netlink operations and FRR commands it would execute")
+}
+
+func newCreateCommand(opts *options, resources []func() resource) *cobra.Command {
+	cmd := &cobra.Command{Use: "create", Short: "Create a resource"}
+	addDryRunFlag(cmd, opts)
+	for _, newResource := range resources {
+		r := newResource()
+		sub := &cobra.Command{
+			Use:     r.kind() + " NAME",
+			Aliases: r.aliases(),
+			Short:   "Create a " + r.kind(),
+			Args:    cobra.ExactArgs(1),
+		}
+		sub.RunE = func(c *cobra.Command, args []string) error {
+			return run(c, opts, r, false, func(ctx context.Context, conn grpc.ClientConnInterface) ([]proto.Message, error) {
+				obj, err := r.create(ctx, conn, c.Flags(), args[0])
+				return []proto.Message{obj}, err
+			})
+		}
+		r.addFlags(sub.Flags())
+		cmd.AddCommand(sub)
+	}
+	return cmd
+}
+
+func newUpdateCommand(opts *options, resources []func() resource) *cobra.Command {
+	cmd := &cobra.Command{Use: "update", Short: "Update flags given on the command line of a resource"}
+	addDryRunFlag(cmd, opts)
+	addEtagFlag(cmd, opts)
+	for _, newResource := range resources {
+		r := newResource()
+		sub := &cobra.Command{
+			Use:     r.kind() + " NAME",
+			Aliases: r.aliases(),
+			Short:   "Update a " + r.kind(),
+			Args:    cobra.ExactArgs(1),
+		}
+		sub.RunE = func(c *cobra.Command, args []string) error {
+			return run(c, opts, r, false, func(ctx context.Context, conn grpc.ClientConnInterface) ([]proto.Message, error) {
+				obj, err := r.update(ctx, conn, c.Flags(), args[0])
+				return []proto.Message{obj}, err
+			})
+		}
+		r.addFlags(sub.Flags())
+		cmd.AddCommand(sub)
+	}
+	return cmd
+}
+
+func newGetCommand(opts *options, resources []func() resource) *cobra.Command {
+	cmd := &cobra.Command{Use: "get", Short: "Show a resource"}
+	for _, newResource := range resources {
+		r := newResource()
+		cmd.AddCommand(&cobra.Command{
+			Use:     r.kind() + " NAME",
+			Aliases: r.aliases(),
+			Short:   "Show a " + r.kind(),
+			Args:    cobra.ExactArgs(1),
+			RunE: func(c *cobra.Command, args []string) error {
+				err := run(c, opts, r, false, func(ctx context.Context, conn grpc.ClientConnInterface) ([]proto.Message, error) {
+					obj, err := r.get(ctx, conn, args[0])
+					return []proto.Message{obj}, err
+				})
+				if err == nil && opts.output == outputTable && opts.lastEtag != "" {
+					fmt.Fprintln(c.OutOrStdout(), "ETAG:", opts.lastEtag)
+				}
+				return err
+			},
+		})
+	}
+	return cmd
+}
+
+func newListCommand(opts *options, resources []func() resource) *cobra.Command {
+	cmd := &cobra.Command{Use: "list", Short: "List all resources of a kind"}
+	for _, newResource := range resources {
+		r := newResource()
+		cmd.AddCommand(&cobra.Command{
+			Use:     r.kind(),
+			Aliases: r.aliases(),
+			Short:   "List all " + r.kind() + "s",
+			Args:    cobra.NoArgs,
+			RunE: func(c *cobra.Command, _ []string) error {
+				return run(c, opts, r, true, func(ctx context.Context, conn grpc.ClientConnInterface) ([]proto.Message, error) {
+					objs, err := r.list(ctx, conn)
+					if objs == nil {
+						objs = []proto.Message{}
+					}
+					return objs, err
+				})
+			},
+		})
+	}
+	return cmd
+}
+
+func newDeleteCommand(opts *options, resources []func() resource) *cobra.Command {
+	cmd := &cobra.Command{Use: "delete", Short: "Delete a resource"}
+	addDryRunFlag(cmd, opts)
+	addEtagFlag(cmd, opts)
+	for _, newResource := range resources {
+		r := newResource()
+		var allowMissing bool
+		sub := &cobra.Command{
+			Use:     r.kind() + " NAME",
+			Aliases: r.aliases(),
+			Short:   "Delete a " + r.kind(),
+			Args:    cobra.ExactArgs(1),
+			RunE: func(c *cobra.Command, args []string) error {
+				return run(c, opts, r, false, func(ctx context.Context, conn grpc.ClientConnInterface) ([]proto.Message, error) {
+					return nil, r.remove(ctx, conn, args[0], allowMissing)
+				})
+			},
+		}
+		sub.Flags().BoolVar(&allowMissing, "allow-missing", false, "Do not fail if the resource does not exist")
+		cmd.AddCommand(sub)
+	}
+	return cmd
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package main implements evpnctl, the command line client of the EVPN bridge
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/admin"
+)
+
+func newExportCommand(opts *options) *cobra.Command {
+	var file, format string
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Save the VRFs, static routes, logical bridges, bridge ports and SVIs of the bridge with their options to a snapshot",
+		Args:  cobra.NoArgs,
+		RunE: func(c *cobra.Command, _ []string) error {
+			conn, ctx, cancel, err := opts.connect()
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			defer cancel()
+			snapshot, err := pa.NewAdminServiceClient(conn).ExportConfig(ctx, &pa.ExportConfigRequest{})
+			if err != nil {
+				return err
+			}
+			data, err := admin.MarshalSnapshot(snapshot, snapshotFormat(file, format))
+			if err != nil {
+				return err
+			}
+			if file == "-" {
+				_, err = c.OutOrStdout().Write(data)
+				return err
+			}
+			if err := os.WriteFile(file, data, 0600); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(c.OutOrStdout(), "Exported %d VRFs, %d static routes, %d LogicalBridges, %d BridgePorts and %d SVIs to %v\n",
+				len(snapshot.Vrfs), len(snapshot.StaticRoutes), len(snapshot.LogicalBridges), len(snapshot.BridgePorts), len(snapshot.Svis), file)
+			return err
+		},
+	}
+	cmd.Flags().StringVarP(&file, "file", "f", "-", "Snapshot file, - for stdout")
+	cmd.Flags().StringVar(&format, "format", "", "Snapshot format: json or yaml, by default derived from the file extension")
+	return cmd
+}
+
+func newImportCommand(opts *options) *cobra.Command {
+	var file, format string
+	var validateOnly bool
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Create the objects of a snapshot which do not exist on the bridge yet",
+		Args:  cobra.NoArgs,
+		RunE: func(c *cobra.Command, _ []string) error {
+			snapshot, err := readSnapshot(c.InOrStdin(), file, format)
+			if err != nil {
+				return err
+			}
+			conn, ctx, cancel, err := opts.connect()
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			defer cancel()
+			response, err := pa.NewAdminServiceClient(conn).ImportConfig(ctx, &pa.ImportConfigRequest{
+				Snapshot:     snapshot,
+				ValidateOnly: validateOnly,
+			})
+			if err != nil {
+				return err
+			}
+			w := c.OutOrStdout()
+			if validateOnly {
+				_, err := fmt.Fprintf(w, "Snapshot %v is valid\n", file)
+				return err
+			}
+			for _, name := range response.Created {
+				fmt.Fprintf(w, "Created %v\n", name)
+			}
+			for _, name := range response.Existing {
+				fmt.Fprintf(w, "Already existing %v, left unchanged\n", name)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&file, "file", "f", "-", "Snapshot file, - for stdin")
+	cmd.Flags().StringVar(&format, "format", "", "Snapshot format: json or yaml, by default derived from the file extension")
+	cmd.Flags().BoolVar(&validateOnly, "validate-only", false, "Only validate the snapshot against the bridge")
+	return cmd
+}
+
+// readSnapshot reads a snapshot or manifest from file or from stdin for -
+func readSnapshot(stdin io.Reader, file, format string) (*pa.ConfigSnapshot, error) {
+	var data []byte
+	var err error
+	if file == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := admin.UnmarshalSnapshot(data, snapshotFormat(file, format))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %v: %w", file, err)
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package main implements evpnctl, the command line client of the EVPN bridge
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+	pc "github.com/opiproject/opi-api/network/opinetcommon/v1alpha1/gen/go"
+)
+
+type sviResource struct {
+	vrf       string
+	bridge    string
+	mac       string
+	gateways  []string
+	enableBgp bool
+	remoteAs  uint32
+}
+
+func newSviResource() resource {
+	return &sviResource{}
+}
+
+func (r *sviResource) kind() string {
+	return "svi"
+}
+
+func (r *sviResource) aliases() []string {
+	return []string{"svis"}
+}
+
+func (r *sviResource) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&r.vrf, "vrf", "", "VRF the SVI belongs to")
+	fs.StringVar(&r.bridge, "bridge", "", "Logical bridge the SVI is attached to")
+	fs.StringVar(&r.mac, "mac", "", "MAC address, e.g. aa:bb:cc:00:00:01")
+	fs.StringSliceVar(&r.gateways, "gw", nil, "Gateway IP prefix in CIDR form, repeat or separate with comma for several")
+	fs.BoolVar(&r.enableBgp, "bgp", false, "Enable BGP peering on the SVI")
+	fs.Uint32Var(&r.remoteAs, "remote-as", 0, "Remote AS of the BGP peer")
+}
+
+// applyFlags copies the flags given on the command line into spec
+// and returns the field paths they correspond to
+func (r *sviResource) applyFlags(fs *pflag.FlagSet, spec *pb.SviSpec) ([]string, error) {
+	var paths []string
+	if fs.Changed("vrf") {
+		spec.Vrf = fullName("vrfs", r.vrf)
+		paths = append(paths, "spec.vrf")
+	}
+	if fs.Changed("bridge") {
+		spec.LogicalBridge = fullName("bridges", r.bridge)
+		paths = append(paths, "spec.logical_bridge")
+	}
+	if fs.Changed("mac") {
+		mac, err := parseMAC(r.mac)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --mac: %w", err)
+		}
+		spec.MacAddress = mac
+		paths = append(paths, "spec.mac_address")
+	}
+	if fs.Changed("gw") {
+		spec.GwIpPrefix = make([]*pc.IPPrefix, len(r.gateways))
+		for i, gw := range r.gateways {
+			prefix, err := parsePrefix(gw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid --gw: %w", err)
+			}
+			spec.GwIpPrefix[i] = prefix
+		}
+		paths = append(paths, "spec.gw_ip_prefix")
+	}
+	if fs.Changed("bgp") {
+		spec.EnableBgp = r.enableBgp
+		paths = append(paths, "spec.enable_bgp")
+	}
+	if fs.Changed("remote-as") {
+		spec.RemoteAs = r.remoteAs
+		paths = append(paths, "spec.remote_as")
+	}
+	return paths, nil
+}
+
+func (r *sviResource) create(ctx context.Context, conn grpc.ClientConnInterface, fs *pflag.FlagSet, name string) (proto.Message, error) {
+	spec := &pb.SviSpec{}
+	if _, err := r.applyFlags(fs, spec); err != nil {
+		return nil, err
+	}
+	return pb.NewSviServiceClient(conn).CreateSvi(ctx, &pb.CreateSviRequest{
+		SviId: path.Base(name),
+		Svi:   &pb.Svi{Spec: spec},
+	})
+}
+
+func (r *sviResource) update(ctx context.Context, conn grpc.ClientConnInterface, fs *pflag.FlagSet, name string) (proto.Message, error) {
+	client := pb.NewSviServiceClient(conn)
+	obj, err := client.GetSvi(ctx, &pb.GetSviRequest{Name: fullName("svis", name)})
+	if err != nil {
+		return nil, err
+	}
+	if obj.Spec == nil {
+		obj.Spec = &pb.SviSpec{}
+	}
+	paths, err := r.applyFlags(fs, obj.Spec)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, errors.New("nothing to update, set at least one flag")
+	}
+	obj.Status = nil
+	return client.UpdateSvi(ctx, &pb.UpdateSviRequest{Svi: obj, UpdateMask: &fieldmaskpb.FieldMask{Paths: paths}})
+}
+
+func (r *sviResource) get(ctx context.Context, conn grpc.ClientConnInterface, name string) (proto.Message, error) {
+	return pb.NewSviServiceClient(conn).GetSvi(ctx, &pb.GetSviRequest{Name: fullName("svis", name)})
+}
+
+func (r *sviResource) list(ctx context.Context, conn grpc.ClientConnInterface) ([]proto.Message, error) {
+	client := pb.NewSviServiceClient(conn)
+	var objs []proto.Message
+	token := ""
+	for {
+		response, err := client.ListSvis(ctx, &pb.ListSvisRequest{PageToken: token})
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range response.Svis {
+			objs = append(objs, obj)
+		}
+		if token = response.NextPageToken; token == "" {
+			return objs, nil
+		}
+	}
+}
+
+func (r *sviResource) remove(ctx context.Context, conn grpc.ClientConnInterface, name string, allowMissing bool) error {
+	_, err := pb.NewSviServiceClient(conn).DeleteSvi(ctx, &pb.DeleteSviRequest{Name: fullName("svis", name), AllowMissing: allowMissing})
+	return err
+}
+
+func (r *sviResource) columns() []string {
+	return []string{"NAME", "VRF", "BRIDGE", "MAC", "GATEWAYS", "BGP", "STATUS"}
+}
+
+func (r *sviResource) row(obj proto.Message) []string {
+	svi := obj.(*pb.Svi)
+	bgp := ""
+	if svi.GetSpec().GetEnableBgp() {
+		bgp = fmt.Sprintf("as %d", svi.GetSpec().GetRemoteAs())
+	}
+	return []string{
+		shortName(svi.GetName()),
+		shortName(svi.GetSpec().GetVrf()),
+		shortName(svi.GetSpec().GetLogicalBridge()),
+		formatMAC(svi.GetSpec().GetMacAddress()),
+		formatPrefixes(svi.GetSpec().GetGwIpPrefix()),
+		bgp,
+		formatEnum(svi.GetStatus().GetOperStatus().String(), "SVI_OPER_STATUS_"),
+	}
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package main implements evpnctl, the command line client of the EVPN bridge
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+)
+
+type vrfResource struct {
+	vni      uint32
+	loopback string
+	vtep     string
+}
+
+func newVrfResource() resource {
+	return &vrfResource{}
+}
+
+func (r *vrfResource) kind() string {
+	return "vrf"
+}
+
+func (r *vrfResource) aliases() []string {
+	return []string{"vrfs"}
+}
+
+func (r *vrfResource) addFlags(fs *pflag.FlagSet) {
+	fs.Uint32Var(&r.vni, "vni", 0, "VXLAN network identifier")
+	fs.StringVar(&r.loopback, "loopback", "", "Loopback IP prefix in CIDR form, e.g. 10.0.0.1/32")
+	fs.StringVar(&r.vtep, "vtep", "", "VTEP IP prefix in CIDR form, e.g. 10.0.0.2/32")
+}
+
+// applyFlags copies the flags given on the command line into spec
+// and returns the field paths they correspond to
+func (r *vrfResource) applyFlags(fs *pflag.FlagSet, spec *pb.VrfSpec) ([]string, error) {
+	var paths []string
+	if fs.Changed("vni") {
+		spec.Vni = proto.Uint32(r.vni)
+		paths = append(paths, "spec.vni")
+	}
+	if fs.Changed("loopback") {
+		prefix, err := parsePrefix(r.loopback)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --loopback: %w", err)
+		}
+		spec.LoopbackIpPrefix = prefix
+		paths = append(paths, "spec.loopback_ip_prefix")
+	}
+	if fs.Changed("vtep") {
+		prefix, err := parsePrefix(r.vtep)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --vtep: %w", err)
+		}
+		spec.VtepIpPrefix = prefix
+		paths = append(paths, "spec.vtep_ip_prefix")
+	}
+	return paths, nil
+}
+
+func (r *vrfResource) create(ctx context.Context, conn grpc.ClientConnInterface, fs *pflag.FlagSet, name string) (proto.Message, error) {
+	spec := &pb.VrfSpec{}
+	if _, err := r.applyFlags(fs, spec); err != nil {
+		return nil, err
+	}
+	return pb.NewVrfServiceClient(conn).CreateVrf(ctx, &pb.CreateVrfRequest{
+		VrfId: path.Base(name),
+		Vrf:   &pb.Vrf{Spec: spec},
+	})
+}
+
+func (r *vrfResource) update(ctx context.Context, conn grpc.ClientConnInterface, fs *pflag.FlagSet, name string) (proto.Message, error) {
+	client := pb.NewVrfServiceClient(conn)
+	obj, err := client.GetVrf(ctx, &pb.GetVrfRequest{Name: fullName("vrfs", name)})
+	if err != nil {
+		return nil, err
+	}
+	if obj.Spec == nil {
+		obj.Spec = &pb.VrfSpec{}
+	}
+	paths, err := r.applyFlags(fs, obj.Spec)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, errors.New("nothing to update, set at least one flag")
+	}
+	obj.Status = nil
+	return client.UpdateVrf(ctx, &pb.UpdateVrfRequest{Vrf: obj, UpdateMask: &fieldmaskpb.FieldMask{Paths: paths}})
+}
+
+func (r *vrfResource) get(ctx context.Context, conn grpc.ClientConnInterface, name string) (proto.Message, error) {
+	return pb.NewVrfServiceClient(conn).GetVrf(ctx, &pb.GetVrfRequest{Name: fullName("vrfs", name)})
+}
+
+func (r *vrfResource) list(ctx context.Context, conn grpc.ClientConnInterface) ([]proto.Message, error) {
+	client := pb.NewVrfServiceClient(conn)
+	var objs []proto.Message
+	token := ""
+	for {
+		response, err := client.ListVrfs(ctx, &pb.ListVrfsRequest{PageToken: token})
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range response.Vrfs {
+			objs = append(objs, obj)
+		}
+		if token = response.NextPageToken; token == "" {
+			return objs, nil
+		}
+	}
+}
+
+func (r *vrfResource) remove(ctx context.Context, conn grpc.ClientConnInterface, name string, allowMissing bool) error {
+	_, err := pb.NewVrfServiceClient(conn).DeleteVrf(ctx, &pb.DeleteVrfRequest{Name: fullName("vrfs", name), AllowMissing: allowMissing})
+	return err
+}
+
+func (r *vrfResource) columns() []string {
+	return []string{"NAME", "VNI", "LOOPBACK", "VTEP", "TABLE", "RMAC"}
+}
+
+func (r *vrfResource) row(obj proto.Message) []string {
+	vrf := obj.(*pb.Vrf)
+	return []string{
+		shortName(vrf.GetName()),
+		formatOptional(vrf.GetSpec().GetVni(), vrf.GetSpec() != nil && vrf.Spec.Vni != nil),
+		formatPrefix(vrf.GetSpec().GetLoopbackIpPrefix()),
+		formatPrefix(vrf.GetSpec().GetVtepIpPrefix()),
+		fmt.Sprint(vrf.GetStatus().GetRoutingTable()),
+		formatMAC(vrf.GetStatus().GetRmac()),
+	}
+}
@@ -7,163 +7,376 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
+	"os/signal"
+	"syscall"
 	"time"
 
 	pc "github.com/opiproject/opi-api/inventory/v1/gen/go"
 	pe "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/admin"
+	"github.com/opiproject/opi-evpn-bridge/pkg/audit"
 	"github.com/opiproject/opi-evpn-bridge/pkg/bridge"
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
 	"github.com/opiproject/opi-evpn-bridge/pkg/port"
+	"github.com/opiproject/opi-evpn-bridge/pkg/ratelimit"
+	"github.com/opiproject/opi-evpn-bridge/pkg/rbac"
+	"github.com/opiproject/opi-evpn-bridge/pkg/route"
+	"github.com/opiproject/opi-evpn-bridge/pkg/store"
 	"github.com/opiproject/opi-evpn-bridge/pkg/svi"
 	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
 	"github.com/opiproject/opi-evpn-bridge/pkg/vrf"
+	"github.com/opiproject/opi-evpn-bridge/pkg/watch"
 	"github.com/opiproject/opi-smbios-bridge/pkg/inventory"
 
-	"github.com/philippgille/gokv"
-	"github.com/philippgille/gokv/redis"
-
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 func main() {
+	defaults := config.Default()
+
+	var configFile string
+	flag.StringVar(&configFile, "config", "", "Path to a YAML or JSON configuration file")
+
 	var grpcPort int
-	flag.IntVar(&grpcPort, "grpc_port", 50151, "The gRPC server port")
+	flag.IntVar(&grpcPort, "grpc_port", defaults.GRPCPort, "The gRPC server port")
 
 	var httpPort int
-	flag.IntVar(&httpPort, "http_port", 8082, "The HTTP server port")
+	flag.IntVar(&httpPort, "http_port", defaults.HTTPPort, "The HTTP server port")
 
 	var tlsFiles string
-	flag.StringVar(&tlsFiles, "tls", "", "TLS files in server_cert:server_key:ca_cert format.")
+	flag.StringVar(&tlsFiles, "tls", defaults.TLSFiles, "TLS files in server_cert:server_key:ca_cert format.")
+
+	var httpTLSFiles string
+	flag.StringVar(&httpTLSFiles, "http_tls", defaults.HTTP.TLSFiles, "HTTPS files of the gateway in server_cert:server_key[:ca_cert] format.")
+
+	var httpClientTLSFiles string
+	flag.StringVar(&httpClientTLSFiles, "http_client_tls", defaults.HTTP.ClientTLSFiles, "Client certificate of the gateway towards the gRPC server with TLS in client_cert:client_key format.")
+
+	var storeType string
+	flag.StringVar(&storeType, "store", defaults.Database.Type, "Persistence backend: memory, redis, bolt or etcd")
+
+	var storeAddress string
+	flag.StringVar(&storeAddress, "store_addr", defaults.Database.Address, "Store address, ip_address:port for redis or comma separated endpoints for etcd")
 
 	var redisAddress string
-	flag.StringVar(&redisAddress, "redis_addr", "127.0.0.1:6379", "Redis address in ip_address:port format")
+	flag.StringVar(&redisAddress, "redis_addr", defaults.Database.Address, "Deprecated, use -store_addr instead")
+
+	var storePath string
+	flag.StringVar(&storePath, "store_path", defaults.Database.Path, "Database file path for bolt store")
 
 	var frrAddress string
-	flag.StringVar(&frrAddress, "frr_addr", "127.0.0.1", "Frr address in ip_address format, no port")
+	flag.StringVar(&frrAddress, "frr_addr", defaults.Frr.Address, "Frr address in ip_address format, no port")
 
 	flag.Parse()
 
-	// Create KV store for persistence
-	options := redis.DefaultOptions
-	options.Address = redisAddress
-	options.Codec = utils.ProtoCodec{}
-	store, err := redis.NewClient(options)
+	// config file is overridden by environment, which is overridden by flags
+	cfg, err := config.LoadConfig(configFile)
 	if err != nil {
 		log.Panic(err)
 	}
-	defer func(store gokv.Store) {
-		err := store.Close()
-		if err != nil {
-			log.Panic(err)
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "grpc_port":
+			cfg.GRPCPort = grpcPort
+		case "http_port":
+			cfg.HTTPPort = httpPort
+		case "tls":
+			cfg.TLSFiles = tlsFiles
+		case "http_tls":
+			cfg.HTTP.TLSFiles = httpTLSFiles
+		case "http_client_tls":
+			cfg.HTTP.ClientTLSFiles = httpClientTLSFiles
+		case "store":
+			cfg.Database.Type = storeType
+		case "store_addr":
+			cfg.Database.Address = storeAddress
+		case "redis_addr":
+			cfg.Database.Address = redisAddress
+		case "store_path":
+			cfg.Database.Path = storePath
+		case "frr_addr":
+			cfg.Frr.Address = frrAddress
 		}
-	}(store)
+	})
+	if err := cfg.Validate(); err != nil {
+		log.Panicf("Invalid configuration: %v", err)
+	}
+	config.SetConfig(cfg)
 
-	go runGatewayServer(grpcPort, httpPort)
-	runGrpcServer(grpcPort, tlsFiles, frrAddress, store)
-}
+	// Create KV store for persistence
+	log.Printf("Using %v store", cfg.Database.Type)
+	kvStore, err := store.NewStore(cfg.Database)
+	if err != nil {
+		log.Panic(err)
+	}
+	// upgrade records written by older versions before serving them
+	upgraded, err := kvStore.Migrate()
+	if err != nil {
+		log.Panicf("Failed to migrate store to schema version %d: %v", store.SchemaVersion, err)
+	}
+	log.Printf("Store is at schema version %d, upgraded %d records", store.SchemaVersion, upgraded)
+	// all writes go through the watch store, which publishes them as events
+	watchStore := watch.NewStore(kvStore)
+	auditLog := audit.NewLog(watchStore, cfg.Audit.MaxEntries)
+
+	// stop serving on the first SIGINT or SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
 
-func runGrpcServer(grpcPort int, tlsFiles, frrAddress string, store gokv.Store) {
 	tp := utils.InitTracerProvider("opi-evpn-bridge")
-	defer func() {
-		if err := tp.Shutdown(context.Background()); err != nil {
-			log.Panicf("Tracer Provider Shutdown: %v", err)
+
+	// status transitions of the devices are published as watch events
+	if err := watchStore.MonitorLinks(ctx, utils.NewNetlinkWrapper()); err != nil {
+		log.Printf("Oper status of resources is not tracked: %v", err)
+	}
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GRPCPort))
+	if err != nil {
+		log.Panicf("failed to listen: %v", err)
+	}
+	grpcCerts := newCertReloader(ctx, "gRPC", cfg.TLSFiles, utils.ParseTLSFiles, cfg.TLSReloadInterval)
+	httpCerts := newCertReloader(ctx, "HTTP gateway", cfg.HTTP.TLSFiles, utils.ParseGatewayTLSFiles, cfg.TLSReloadInterval)
+	var gatewayCerts *utils.CertReloader
+	if grpcCerts != nil {
+		gatewayCerts = newCertReloader(ctx, "HTTP gateway client", cfg.HTTP.ClientTLSFiles, utils.ParseClientTLSFiles, cfg.TLSReloadInterval)
+	}
+	grpcServer := newGrpcServer(cfg, grpcCerts, gatewayCerts, watchStore, auditLog)
+	httpServer := newGatewayServer(ctx, cfg, grpcCerts, gatewayCerts, httpCerts)
+
+	serveErr := make(chan error, 2)
+	go func() {
+		log.Printf("gRPC server listening at %v", lis.Addr())
+		serveErr <- grpcServer.Serve(lis)
+	}()
+	go func() {
+		log.Printf("HTTP Server listening at %v", cfg.HTTPPort)
+		serve := httpServer.ListenAndServe
+		if httpServer.TLSConfig != nil {
+			// certificates come from TLSConfig
+			serve = func() error { return httpServer.ListenAndServeTLS("", "") }
+		}
+		if err := serve(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serveErr <- fmt.Errorf("HTTP gateway server: %w", err)
 		}
 	}()
 
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
+	select {
+	case <-ctx.Done():
+		log.Println("Received shutdown signal")
+	case err := <-serveErr:
+		log.Printf("Server failed: %v", err)
+	}
+	// restore default signal handling, so a second signal terminates immediately
+	stop()
+
+	shutdown(cfg.ShutdownTimeout, grpcServer, httpServer, tp, watchStore)
+}
+
+// shutdown stops accepting new requests, waits for in-flight requests until
+// timeout expires and then flushes traces and closes the store
+func shutdown(timeout time.Duration, grpcServer *grpc.Server, httpServer *http.Server, tp *sdktrace.TracerProvider, store *watch.Store) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	log.Printf("Shutting down, waiting up to %v for in-flight requests", timeout)
+	if err := httpServer.Shutdown(ctx); err != nil {
+		log.Printf("HTTP gateway server shutdown: %v", err)
+	}
+
+	// watch streams never complete on their own
+	store.StopWatches()
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		log.Println("All in-flight gRPC requests completed")
+	case <-ctx.Done():
+		log.Println("Timeout waiting for in-flight gRPC requests, forcing stop")
+		grpcServer.Stop()
+	}
+
+	// use a fresh context, so traces are flushed even after timeout expired
+	flushCtx, flushCancel := context.WithTimeout(context.Background(), timeout)
+	defer flushCancel()
+	if err := tp.Shutdown(flushCtx); err != nil {
+		log.Printf("Tracer Provider Shutdown: %v", err)
+	}
+
+	if err := store.Close(); err != nil {
+		log.Printf("Store Close: %v", err)
+	}
+	log.Println("Shutdown complete")
+}
+
+// newCertReloader loads the TLS files and reloads them on change until ctx is done,
+// it returns nil when tlsFiles is empty
+func newCertReloader(ctx context.Context, name, tlsFiles string, parse func(string) (utils.TLSConfig, error), interval time.Duration) *utils.CertReloader {
+	if tlsFiles == "" {
+		log.Printf("TLS files of %v are not specified. Use insecure connection.", name)
+		return nil
+	}
+	log.Printf("Use TLS certificate files of %v: %v", name, tlsFiles)
+	tlsConfig, err := parse(tlsFiles)
 	if err != nil {
-		log.Panicf("failed to listen: %v", err)
+		log.Panic("Failed to parse string with tls paths:", err)
 	}
+	certs, err := utils.NewCertReloader(tlsConfig)
+	if err != nil {
+		log.Panic("Failed to setup TLS:", err)
+	}
+	if interval > 0 {
+		go certs.Watch(ctx, interval)
+	}
+	return certs
+}
 
+func newGrpcServer(cfg config.Config, certs, gatewayCerts *utils.CertReloader, store *watch.Store, auditLog *audit.Log) *grpc.Server {
 	var serverOptions []grpc.ServerOption
-	if tlsFiles == "" {
-		log.Println("TLS files are not specified. Use insecure connection.")
-	} else {
-		log.Println("Use TLS certificate files:", tlsFiles)
-		config, err := utils.ParseTLSFiles(tlsFiles)
-		if err != nil {
-			log.Panic("Failed to parse string with tls paths:", err)
-		}
-		log.Println("TLS config:", config)
-		var option grpc.ServerOption
-		if option, err = utils.SetupTLSCredentials(config); err != nil {
-			log.Panic("Failed to setup TLS:", err)
+	if certs != nil {
+		serverOptions = append(serverOptions, certs.ServerOption())
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		logging.UnaryServerInterceptor(utils.InterceptorLogger(log.Default()),
+			logging.WithLogOnEvents(
+				logging.StartCall,
+				logging.FinishCall,
+				logging.PayloadReceived,
+				logging.PayloadSent,
+			),
+		),
+	}
+	if cfg.RateLimit.Rate > 0 || cfg.RateLimit.ClientRate > 0 {
+		log.Printf("Limiting calls to %v per second globally and %v per second per client",
+			cfg.RateLimit.Rate, cfg.RateLimit.ClientRate)
+		// before audit, so rejected floods do not push out the audited changes
+		limiter := ratelimit.NewLimiter(cfg.RateLimit, prometheus.DefaultRegisterer)
+		unaryInterceptors = append(unaryInterceptors, limiter.UnaryServerInterceptor())
+	}
+	// before authorization, so denied changes are audited too
+	unaryInterceptors = append(unaryInterceptors, auditLog.UnaryServerInterceptor())
+	var streamInterceptors []grpc.StreamServerInterceptor
+	if cfg.Authorization.Enabled {
+		policy := cfg.Authorization
+		// the gateway proxies the calls of its clients with its own identity
+		if gatewayCerts != nil && policy.GatewayRole != "" {
+			gateway, err := gatewayCerts.Leaf()
+			if err != nil {
+				log.Panicf("Failed to parse client certificate of the gateway: %v", err)
+			}
+			log.Printf("Binding role %v to the gateway %v", policy.GatewayRole, gateway.Subject)
+			policy.Bindings = append(append([]config.RoleBinding{}, policy.Bindings...),
+				config.RoleBinding{Role: policy.GatewayRole, Subjects: []string{gateway.Subject.String()}})
 		}
-		serverOptions = append(serverOptions, option)
+		log.Printf("Authorizing callers by %d role bindings", len(policy.Bindings))
+		authorizer := rbac.NewAuthorizer(policy)
+		unaryInterceptors = append(unaryInterceptors, authorizer.UnaryServerInterceptor())
+		streamInterceptors = append(streamInterceptors, authorizer.StreamServerInterceptor())
 	}
 	serverOptions = append(serverOptions,
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
-		grpc.UnaryInterceptor(
-			logging.UnaryServerInterceptor(utils.InterceptorLogger(log.Default()),
-				logging.WithLogOnEvents(
-					logging.StartCall,
-					logging.FinishCall,
-					logging.PayloadReceived,
-					logging.PayloadSent,
-				),
-			)),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
 	)
 	s := grpc.NewServer(serverOptions...)
 
-	nLink := utils.NewNetlinkWrapper()
-	frr := utils.NewFrrWrapperWithArgs(frrAddress)
+	// the recorder adds the executed operations to the audit entry of the call
+	recorder := utils.NewRecorder(utils.NewNetlinkWrapper(), utils.NewFrrWrapperWithArgs(cfg.Frr.Address, cfg.Frr.Password))
 
-	bridgeServer := bridge.NewServerWithArgs(nLink, frr, store)
-	portServer := port.NewServerWithArgs(nLink, frr, store)
-	vrfServer := vrf.NewServerWithArgs(nLink, frr, store)
-	sviServer := svi.NewServerWithArgs(nLink, frr, store)
+	bridgeServer := bridge.NewServerWithArgs(recorder, recorder, store)
+	portServer := port.NewServerWithArgs(recorder, recorder, store)
+	vrfServer := vrf.NewServerWithArgs(recorder, recorder, store)
+	sviServer := svi.NewServerWithArgs(recorder, recorder, store)
+	routeServer := route.NewServerWithArgs(recorder, store)
+
+	// move the VNIs to the devices of linux.vxlanmode, in case the mode changed
+	migrated, err := bridgeServer.MigrateVxlanMode(context.Background(), store.Keys())
+	if err != nil {
+		log.Printf("Failed to migrate LogicalBridges to vxlan mode %v: %v", cfg.Linux.VxlanMode, err)
+	} else if migrated > 0 {
+		log.Printf("Migrated %d LogicalBridges to vxlan mode %v", migrated, cfg.Linux.VxlanMode)
+	}
+
+	// add the IPv6 throw route to the tables of VRFs created without it
+	added, err := vrfServer.MigrateThrowRoutes(context.Background(), store.Keys())
+	if err != nil {
+		log.Printf("Failed to add throw routes to VRF tables: %v", err)
+	} else if added > 0 {
+		log.Printf("Added %d throw routes to VRF tables", added)
+	}
 
 	pe.RegisterLogicalBridgeServiceServer(s, bridgeServer)
 	pe.RegisterBridgePortServiceServer(s, portServer)
 	pe.RegisterVrfServiceServer(s, vrfServer)
 	pe.RegisterSviServiceServer(s, sviServer)
+	pa.RegisterLogicalBridgeBatchServiceServer(s, bridgeServer)
+	pa.RegisterLogicalBridgeOptionsServiceServer(s, bridgeServer)
+	pa.RegisterVrfOptionsServiceServer(s, vrfServer)
+	pa.RegisterStaticRouteServiceServer(s, routeServer)
+	pa.RegisterBridgePortBatchServiceServer(s, portServer)
+	pa.RegisterBridgePortOptionsServiceServer(s, portServer)
+	pa.RegisterAdminServiceServer(s, admin.NewServer(store, vrfServer, bridgeServer, portServer, sviServer, routeServer))
+	pa.RegisterWatchServiceServer(s, watch.NewServer(store))
+	pa.RegisterAuditServiceServer(s, audit.NewServer(auditLog))
 	pc.RegisterInventoryServiceServer(s, &inventory.Server{})
 
 	reflection.Register(s)
 
-	log.Printf("gRPC server listening at %v", lis.Addr())
-	if err := s.Serve(lis); err != nil {
-		log.Panicf("failed to serve: %v", err)
-	}
+	return s
 }
 
-func runGatewayServer(grpcPort int, httpPort int) {
-	ctx := context.Background()
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
+func newGatewayServer(ctx context.Context, cfg config.Config, grpcCerts, gatewayCerts, httpCerts *utils.CertReloader) *http.Server {
 	// Register gRPC server endpoint
 	// Note: Make sure the gRPC server is running properly and accessible
 	mux := runtime.NewServeMux()
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if grpcCerts != nil {
+		// the gateway has its own client certificate and verifies the name of the gRPC server
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(grpcCerts.ClientTLSConfig(gatewayCerts)))}
+	}
 
 	// TODO: add/replace with more/less registrations, once opi-api compiler fixed
-	err := pc.RegisterInventoryServiceHandlerFromEndpoint(ctx, mux, fmt.Sprintf(":%d", grpcPort), opts)
+	err := pc.RegisterInventoryServiceHandlerFromEndpoint(ctx, mux, fmt.Sprintf("localhost:%d", cfg.GRPCPort), opts)
 	if err != nil {
 		log.Panic("cannot register handler server")
 	}
+	err = mux.HandlePath(http.MethodGet, "/metrics", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		promhttp.Handler().ServeHTTP(w, r)
+	})
+	if err != nil {
+		log.Panic("cannot register metrics handler")
+	}
 
-	// Start HTTP server (and proxy calls to gRPC server endpoint)
-	log.Printf("HTTP Server listening at %v", httpPort)
+	// HTTP server proxies calls to gRPC server endpoint
 	server := &http.Server{
-		Addr:         fmt.Sprintf(":%d", httpPort),
+		Addr:         fmt.Sprintf(":%d", cfg.HTTPPort),
 		Handler:      mux,
-		ReadTimeout:  5 * time.Second,
-		WriteTimeout: 10 * time.Second,
+		ReadTimeout:  cfg.HTTP.ReadTimeout,
+		WriteTimeout: cfg.HTTP.WriteTimeout,
 	}
-	err = server.ListenAndServe()
-	if err != nil {
-		log.Panic("cannot start HTTP gateway server")
+	if httpCerts != nil {
+		server.TLSConfig = httpCerts.ServerTLSConfig()
 	}
+	return server
 }
@@ -0,0 +1,755 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package admin implements operations spanning all EVPN resources
+package admin
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"go.einride.tech/aip/fieldbehavior"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+	pc "github.com/opiproject/opi-api/network/opinetcommon/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+	"github.com/opiproject/opi-evpn-bridge/pkg/store"
+)
+
+const (
+	testVrfName    = "//network.opiproject.org/vrfs/blue"
+	testBridgeName = "//network.opiproject.org/bridges/crimson"
+	testPortName   = "//network.opiproject.org/ports/green"
+	testSviName    = "//network.opiproject.org/svis/yellow"
+	testRouteName  = "//network.opiproject.org/routes/default"
+)
+
+var (
+	testVrf = pb.Vrf{
+		Name: testVrfName,
+		Spec: &pb.VrfSpec{
+			Vni:              proto.Uint32(1000),
+			LoopbackIpPrefix: &pc.IPPrefix{Len: 24},
+			VtepIpPrefix:     &pc.IPPrefix{Len: 24},
+		},
+		Status: &pb.VrfStatus{RoutingTable: 1001},
+	}
+	testBridge = pb.LogicalBridge{
+		Name: testBridgeName,
+		Spec: &pb.LogicalBridgeSpec{VlanId: 11, Vni: proto.Uint32(11)},
+	}
+	testPort = pb.BridgePort{
+		Name: testPortName,
+		Spec: &pb.BridgePortSpec{
+			MacAddress:     []byte{0xCB, 0xB8, 0x33, 0x4C, 0x88, 0x4F},
+			Ptype:          pb.BridgePortType_ACCESS,
+			LogicalBridges: []string{testBridgeName},
+		},
+	}
+	testVrfOptions = pa.VrfOptions{
+		Name: testVrfName + "/options",
+		Spec: &pa.VrfOptionsSpec{Evpn: &pa.EvpnOptions{Rd: "65000:1000"}},
+	}
+	testBridgeOptions = pa.LogicalBridgeOptions{
+		Name: testBridgeName + "/options",
+		Spec: &pa.LogicalBridgeOptionsSpec{NeighSuppress: proto.Bool(false)},
+	}
+	testPortOptions = pa.BridgePortOptions{
+		Name: testPortName + "/options",
+		Spec: &pa.BridgePortOptionsSpec{Multihoming: &pa.EvpnMultihoming{EsId: "1", SysMac: []byte{0x44, 0x38, 0x39, 0xff, 0x00, 0x01}}},
+	}
+	testRoute = pa.StaticRoute{
+		Name: testRouteName,
+		Spec: &pa.StaticRouteSpec{
+			Vrf:     testVrfName,
+			Prefix:  &pc.IPPrefix{Addr: &pc.IPAddress{Af: pc.IpAf_IP_AF_INET, V4OrV6: &pc.IPAddress_V4Addr{V4Addr: 0}}},
+			NextHop: &pc.IPAddress{Af: pc.IpAf_IP_AF_INET, V4OrV6: &pc.IPAddress_V4Addr{V4Addr: 167772161}},
+		},
+	}
+	testSvi = pb.Svi{
+		Name: testSviName,
+		Spec: &pb.SviSpec{
+			Vrf:           testVrfName,
+			LogicalBridge: testBridgeName,
+			MacAddress:    []byte{0xCB, 0xB8, 0x33, 0x4C, 0x88, 0x4F},
+			GwIpPrefix:    []*pc.IPPrefix{{Len: 24}},
+		},
+	}
+)
+
+// fakeServers creates objects directly in the store and records the order
+type fakeServers struct {
+	pb.UnimplementedVrfServiceServer
+	pb.UnimplementedLogicalBridgeServiceServer
+	pb.UnimplementedBridgePortServiceServer
+	pb.UnimplementedSviServiceServer
+	pa.UnimplementedVrfOptionsServiceServer
+	pa.UnimplementedLogicalBridgeOptionsServiceServer
+	pa.UnimplementedBridgePortOptionsServiceServer
+	pa.UnimplementedStaticRouteServiceServer
+	store   gokvStore
+	created []string
+	updated []string
+	deleted []string
+	fail    string
+	invalid string
+}
+
+type gokvStore interface {
+	Set(k string, v interface{}) error
+	Delete(k string) error
+}
+
+func (f *fakeServers) create(name string, obj proto.Message) error {
+	if name == f.fail {
+		return status.Errorf(codes.Internal, "cannot create %s", name)
+	}
+	f.created = append(f.created, name)
+	return f.store.Set(name, obj)
+}
+
+// validate checks required fields and rejects the invalid object
+func (f *fakeServers) validate(name string, in proto.Message) error {
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		return err
+	}
+	if name == f.invalid {
+		return status.Errorf(codes.InvalidArgument, "%s is invalid", name)
+	}
+	return nil
+}
+
+func (f *fakeServers) ValidateCreateVrfRequest(in *pb.CreateVrfRequest) error {
+	return f.validate(in.Vrf.GetName(), in)
+}
+
+func (f *fakeServers) ValidateCreateLogicalBridgeRequest(in *pb.CreateLogicalBridgeRequest) error {
+	return f.validate(in.LogicalBridge.GetName(), in)
+}
+
+func (f *fakeServers) ValidateCreateBridgePortRequest(in *pb.CreateBridgePortRequest) error {
+	return f.validate(in.BridgePort.GetName(), in)
+}
+
+func (f *fakeServers) ValidateCreateSviRequest(in *pb.CreateSviRequest) error {
+	return f.validate(in.Svi.GetName(), in)
+}
+
+func (f *fakeServers) ValidateCreateStaticRouteRequest(in *pa.CreateStaticRouteRequest) error {
+	return f.validate(in.StaticRoute.GetName(), in)
+}
+
+func (f *fakeServers) ValidateUpdateVrfOptionsRequest(in *pa.UpdateVrfOptionsRequest) error {
+	return f.validate(in.VrfOptions.GetName(), in)
+}
+
+func (f *fakeServers) ValidateUpdateLogicalBridgeOptionsRequest(in *pa.UpdateLogicalBridgeOptionsRequest) error {
+	return f.validate(in.LogicalBridgeOptions.GetName(), in)
+}
+
+func (f *fakeServers) ValidateUpdateBridgePortOptionsRequest(in *pa.UpdateBridgePortOptionsRequest) error {
+	return f.validate(in.BridgePortOptions.GetName(), in)
+}
+
+func (f *fakeServers) CreateVrf(_ context.Context, in *pb.CreateVrfRequest) (*pb.Vrf, error) {
+	return in.Vrf, f.create(in.Vrf.Name, in.Vrf)
+}
+
+func (f *fakeServers) CreateLogicalBridge(_ context.Context, in *pb.CreateLogicalBridgeRequest) (*pb.LogicalBridge, error) {
+	return in.LogicalBridge, f.create(in.LogicalBridge.Name, in.LogicalBridge)
+}
+
+func (f *fakeServers) CreateBridgePort(_ context.Context, in *pb.CreateBridgePortRequest) (*pb.BridgePort, error) {
+	return in.BridgePort, f.create(in.BridgePort.Name, in.BridgePort)
+}
+
+func (f *fakeServers) CreateSvi(_ context.Context, in *pb.CreateSviRequest) (*pb.Svi, error) {
+	return in.Svi, f.create(in.Svi.Name, in.Svi)
+}
+
+func (f *fakeServers) CreateStaticRoute(_ context.Context, in *pa.CreateStaticRouteRequest) (*pa.StaticRoute, error) {
+	return in.StaticRoute, f.create(in.StaticRoute.Name, in.StaticRoute)
+}
+
+func (f *fakeServers) update(name string, obj proto.Message, mask *fieldmaskpb.FieldMask) error {
+	if name == f.fail {
+		return status.Errorf(codes.Internal, "cannot update %s", name)
+	}
+	f.updated = append(f.updated, name+" "+strings.Join(mask.GetPaths(), ","))
+	return f.store.Set(name, obj)
+}
+
+func (f *fakeServers) remove(name string) (*emptypb.Empty, error) {
+	f.deleted = append(f.deleted, name)
+	return &emptypb.Empty{}, f.store.Delete(name)
+}
+
+func (f *fakeServers) UpdateVrf(_ context.Context, in *pb.UpdateVrfRequest) (*pb.Vrf, error) {
+	return in.Vrf, f.update(in.Vrf.Name, in.Vrf, in.UpdateMask)
+}
+
+func (f *fakeServers) UpdateLogicalBridge(_ context.Context, in *pb.UpdateLogicalBridgeRequest) (*pb.LogicalBridge, error) {
+	return in.LogicalBridge, f.update(in.LogicalBridge.Name, in.LogicalBridge, in.UpdateMask)
+}
+
+func (f *fakeServers) UpdateBridgePort(_ context.Context, in *pb.UpdateBridgePortRequest) (*pb.BridgePort, error) {
+	return in.BridgePort, f.update(in.BridgePort.Name, in.BridgePort, in.UpdateMask)
+}
+
+func (f *fakeServers) UpdateSvi(_ context.Context, in *pb.UpdateSviRequest) (*pb.Svi, error) {
+	return in.Svi, f.update(in.Svi.Name, in.Svi, in.UpdateMask)
+}
+
+func (f *fakeServers) UpdateStaticRoute(_ context.Context, in *pa.UpdateStaticRouteRequest) (*pa.StaticRoute, error) {
+	return in.StaticRoute, f.update(in.StaticRoute.Name, in.StaticRoute, in.UpdateMask)
+}
+
+// updateOptions records options set without mask as created, since
+// options have no Create, and all others as updated
+func (f *fakeServers) updateOptions(name string, obj proto.Message, mask *fieldmaskpb.FieldMask) error {
+	if len(mask.GetPaths()) == 0 {
+		return f.create(name, obj)
+	}
+	return f.update(name, obj, mask)
+}
+
+func (f *fakeServers) UpdateVrfOptions(_ context.Context, in *pa.UpdateVrfOptionsRequest) (*pa.VrfOptions, error) {
+	return in.VrfOptions, f.updateOptions(in.VrfOptions.Name, in.VrfOptions, in.UpdateMask)
+}
+
+func (f *fakeServers) UpdateLogicalBridgeOptions(_ context.Context, in *pa.UpdateLogicalBridgeOptionsRequest) (*pa.LogicalBridgeOptions, error) {
+	return in.LogicalBridgeOptions, f.updateOptions(in.LogicalBridgeOptions.Name, in.LogicalBridgeOptions, in.UpdateMask)
+}
+
+func (f *fakeServers) UpdateBridgePortOptions(_ context.Context, in *pa.UpdateBridgePortOptionsRequest) (*pa.BridgePortOptions, error) {
+	return in.BridgePortOptions, f.updateOptions(in.BridgePortOptions.Name, in.BridgePortOptions, in.UpdateMask)
+}
+
+func (f *fakeServers) DeleteVrf(_ context.Context, in *pb.DeleteVrfRequest) (*emptypb.Empty, error) {
+	return f.remove(in.Name)
+}
+
+func (f *fakeServers) DeleteLogicalBridge(_ context.Context, in *pb.DeleteLogicalBridgeRequest) (*emptypb.Empty, error) {
+	return f.remove(in.Name)
+}
+
+func (f *fakeServers) DeleteBridgePort(_ context.Context, in *pb.DeleteBridgePortRequest) (*emptypb.Empty, error) {
+	return f.remove(in.Name)
+}
+
+func (f *fakeServers) DeleteSvi(_ context.Context, in *pb.DeleteSviRequest) (*emptypb.Empty, error) {
+	return f.remove(in.Name)
+}
+
+func (f *fakeServers) DeleteStaticRoute(_ context.Context, in *pa.DeleteStaticRouteRequest) (*emptypb.Empty, error) {
+	return f.remove(in.Name)
+}
+
+func newTestServer(t *testing.T) (*Server, *fakeServers) {
+	kvStore, err := store.NewStore(config.DatabaseConfig{Type: config.MemoryStore})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake := &fakeServers{store: kvStore}
+	return NewServer(kvStore, fake, fake, fake, fake, fake), fake
+}
+
+func testSnapshot() *pa.ConfigSnapshot {
+	return &pa.ConfigSnapshot{
+		Version:        SnapshotVersion,
+		Vrfs:           []*pb.Vrf{proto.Clone(&testVrf).(*pb.Vrf)},
+		LogicalBridges: []*pb.LogicalBridge{proto.Clone(&testBridge).(*pb.LogicalBridge)},
+		BridgePorts:    []*pb.BridgePort{proto.Clone(&testPort).(*pb.BridgePort)},
+		Svis:           []*pb.Svi{proto.Clone(&testSvi).(*pb.Svi)},
+	}
+}
+
+// testFullSnapshot is testSnapshot with a static route and options of
+// the VRF, LogicalBridge and BridgePort
+func testFullSnapshot() *pa.ConfigSnapshot {
+	s := testSnapshot()
+	s.StaticRoutes = []*pa.StaticRoute{proto.Clone(&testRoute).(*pa.StaticRoute)}
+	s.VrfOptions = []*pa.VrfOptions{proto.Clone(&testVrfOptions).(*pa.VrfOptions)}
+	s.LogicalBridgeOptions = []*pa.LogicalBridgeOptions{proto.Clone(&testBridgeOptions).(*pa.LogicalBridgeOptions)}
+	s.BridgePortOptions = []*pa.BridgePortOptions{proto.Clone(&testPortOptions).(*pa.BridgePortOptions)}
+	return s
+}
+
+func TestAdmin_ExportConfig(t *testing.T) {
+	opi, fake := newTestServer(t)
+	// options reset to defaults are not exported
+	reset := &pa.VrfOptions{Name: "//network.opiproject.org/vrfs/red/options", Spec: &pa.VrfOptionsSpec{}}
+	// insert in reverse order, export has to group objects by type
+	for _, obj := range []proto.Message{&testSvi, &testPortOptions, &testPort, &testBridgeOptions, &testBridge, &testRoute, &testVrfOptions, &testVrf, reset} {
+		name := obj.ProtoReflect().Get(obj.ProtoReflect().Descriptor().Fields().ByName("name")).String()
+		if err := fake.store.Set(name, obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+	response, err := opi.ExportConfig(context.Background(), &pa.ExportConfigRequest{})
+	if err != nil {
+		t.Fatal("Expect no error, received", err)
+	}
+	if !proto.Equal(response, testFullSnapshot()) {
+		t.Error("response: expected", testFullSnapshot(), "received", response)
+	}
+}
+
+func TestAdmin_ImportConfig(t *testing.T) {
+	tests := map[string]struct {
+		snapshot     func() *pa.ConfigSnapshot
+		validateOnly bool
+		exist        []proto.Message
+		fail         string
+		invalid      string
+		created      []string
+		existing     []string
+		errCode      codes.Code
+		errMsg       string
+	}{
+		"valid import in dependency order": {
+			snapshot: testSnapshot,
+			created:  []string{testVrfName, testBridgeName, testPortName, testSviName},
+		},
+		"options before their objects": {
+			snapshot: testFullSnapshot,
+			exist:    []proto.Message{&testBridgeOptions},
+			created:  []string{testVrfOptions.Name, testVrfName, testRouteName, testBridgeName, testPortOptions.Name, testPortName, testSviName},
+			existing: []string{testBridgeOptions.Name},
+		},
+		"invalid options": {
+			snapshot:     testFullSnapshot,
+			validateOnly: true,
+			invalid:      testBridgeOptions.Name,
+			errCode:      codes.InvalidArgument,
+			errMsg:       `invalid object "//network.opiproject.org/bridges/crimson/options": //network.opiproject.org/bridges/crimson/options is invalid`,
+		},
+		"route of unknown vrf": {
+			snapshot: func() *pa.ConfigSnapshot {
+				s := testFullSnapshot()
+				s.StaticRoutes[0].Spec.Vrf = "//network.opiproject.org/vrfs/red"
+				return s
+			},
+			errCode: codes.NotFound,
+			errMsg:  `object "//network.opiproject.org/routes/default" references "//network.opiproject.org/vrfs/red", which is neither in the snapshot nor in the store`,
+		},
+		"existing objects are skipped": {
+			snapshot: testSnapshot,
+			exist:    []proto.Message{&testBridge},
+			created:  []string{testVrfName, testPortName, testSviName},
+			existing: []string{testBridgeName},
+		},
+		"validate only": {
+			snapshot:     testSnapshot,
+			validateOnly: true,
+		},
+		"missing snapshot": {
+			snapshot: func() *pa.ConfigSnapshot { return nil },
+			errCode:  codes.InvalidArgument,
+			errMsg:   "missing snapshot",
+		},
+		"unsupported version": {
+			snapshot: func() *pa.ConfigSnapshot {
+				s := testSnapshot()
+				s.Version = SnapshotVersion + 1
+				return s
+			},
+			errCode: codes.InvalidArgument,
+			errMsg:  "unsupported snapshot version 2, expected 1",
+		},
+		"wrong collection": {
+			snapshot: func() *pa.ConfigSnapshot {
+				s := testSnapshot()
+				s.Vrfs[0].Name = testBridgeName
+				return s
+			},
+			errCode: codes.InvalidArgument,
+			errMsg:  `invalid object "//network.opiproject.org/bridges/crimson": name has to start with //network.opiproject.org/vrfs/`,
+		},
+		"missing spec": {
+			snapshot: func() *pa.ConfigSnapshot {
+				s := testSnapshot()
+				s.LogicalBridges[0].Spec = nil
+				return s
+			},
+			errCode: codes.InvalidArgument,
+			errMsg:  `invalid object "//network.opiproject.org/bridges/crimson": missing required field: logical_bridge.spec`,
+		},
+		"rejected by server on validate only": {
+			snapshot:     testSnapshot,
+			validateOnly: true,
+			invalid:      testPortName,
+			errCode:      codes.InvalidArgument,
+			errMsg:       `invalid object "//network.opiproject.org/ports/green": //network.opiproject.org/ports/green is invalid`,
+		},
+		"duplicate object": {
+			snapshot: func() *pa.ConfigSnapshot {
+				s := testSnapshot()
+				s.Vrfs = append(s.Vrfs, s.Vrfs[0])
+				return s
+			},
+			errCode: codes.InvalidArgument,
+			errMsg:  `duplicate object "//network.opiproject.org/vrfs/blue"`,
+		},
+		"unresolved reference": {
+			snapshot: func() *pa.ConfigSnapshot {
+				s := testSnapshot()
+				s.Vrfs = nil
+				return s
+			},
+			errCode: codes.NotFound,
+			errMsg:  `object "//network.opiproject.org/svis/yellow" references "//network.opiproject.org/vrfs/blue", which is neither in the snapshot nor in the store`,
+		},
+		"reference satisfied by store": {
+			snapshot: func() *pa.ConfigSnapshot {
+				s := testSnapshot()
+				s.Vrfs = nil
+				return s
+			},
+			exist:   []proto.Message{&testVrf},
+			created: []string{testBridgeName, testPortName, testSviName},
+		},
+		"create failure": {
+			snapshot: testSnapshot,
+			fail:     testPortName,
+			errCode:  codes.Internal,
+			errMsg:   "failed to import //network.opiproject.org/ports/green after creating 2 objects: cannot create //network.opiproject.org/ports/green",
+		},
+	}
+
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			opi, fake := newTestServer(t)
+			fake.fail = tt.fail
+			fake.invalid = tt.invalid
+			for _, obj := range tt.exist {
+				name := obj.ProtoReflect().Get(obj.ProtoReflect().Descriptor().Fields().ByName("name")).String()
+				if err := fake.store.Set(name, obj); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			request := &pa.ImportConfigRequest{Snapshot: tt.snapshot(), ValidateOnly: tt.validateOnly}
+			response, err := opi.ImportConfig(context.Background(), request)
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+			if response == nil {
+				return
+			}
+			if !reflect.DeepEqual(response.Created, tt.created) {
+				t.Error("created: expected", tt.created, "received", response.Created)
+			}
+			if !reflect.DeepEqual(response.Existing, tt.existing) {
+				t.Error("existing: expected", tt.existing, "received", response.Existing)
+			}
+			if !reflect.DeepEqual(fake.created, tt.created) {
+				t.Error("create calls: expected", tt.created, "received", fake.created)
+			}
+		})
+	}
+}
+
+func TestAdmin_ApplyConfig(t *testing.T) {
+	const otherBridgeName = "//network.opiproject.org/bridges/orange"
+	otherBridge := &pb.LogicalBridge{Name: otherBridgeName, Spec: &pb.LogicalBridgeSpec{VlanId: 12}}
+	changedPort := proto.Clone(&testPort).(*pb.BridgePort)
+	changedPort.Spec.Ptype = pb.BridgePortType_TRUNK
+	changedPort.Spec.LogicalBridges = []string{testBridgeName, otherBridgeName}
+
+	tests := map[string]struct {
+		manifest func() *pa.ConfigSnapshot
+		exist    []proto.Message
+		prune    bool
+		planOnly bool
+		actions  []string
+		created  []string
+		updated  []string
+		deleted  []string
+		errCode  codes.Code
+		errMsg   string
+	}{
+		"empty store": {
+			manifest: testSnapshot,
+			actions: []string{
+				"OPERATION_CREATE " + testVrfName,
+				"OPERATION_CREATE " + testBridgeName,
+				"OPERATION_CREATE " + testPortName,
+				"OPERATION_CREATE " + testSviName,
+			},
+			created: []string{testVrfName, testBridgeName, testPortName, testSviName},
+		},
+		"nothing to do": {
+			manifest: testSnapshot,
+			exist:    []proto.Message{&testVrf, &testBridge, &testPort, &testSvi},
+		},
+		"status is not compared": {
+			manifest: func() *pa.ConfigSnapshot {
+				s := testSnapshot()
+				s.Vrfs[0].Status = nil
+				return s
+			},
+			exist: []proto.Message{&testVrf, &testBridge, &testPort, &testSvi},
+		},
+		"port spec can not change in place": {
+			manifest: func() *pa.ConfigSnapshot {
+				s := testSnapshot()
+				s.LogicalBridges = append(s.LogicalBridges, otherBridge)
+				s.BridgePorts[0] = changedPort
+				return s
+			},
+			exist:   []proto.Message{&testVrf, &testBridge, &testPort, &testSvi},
+			errCode: codes.FailedPrecondition,
+			errMsg:  testPortName + " can not be updated in place, delete and create it to change spec.ptype, spec.logical_bridges",
+		},
+		"vrf spec can not change in place on plan only": {
+			manifest: func() *pa.ConfigSnapshot {
+				s := testSnapshot()
+				s.Vrfs[0] = proto.Clone(&testVrf).(*pb.Vrf)
+				s.Vrfs[0].Spec.Vni = proto.Uint32(2000)
+				return s
+			},
+			exist:    []proto.Message{&testVrf, &testBridge, &testPort, &testSvi},
+			planOnly: true,
+			errCode:  codes.FailedPrecondition,
+			errMsg:   testVrfName + " can not be updated in place, delete and create it to change spec.vni",
+		},
+		"without prune objects are kept": {
+			manifest: func() *pa.ConfigSnapshot {
+				s := testSnapshot()
+				s.Svis = nil
+				return s
+			},
+			exist: []proto.Message{&testVrf, &testBridge, &testPort, &testSvi},
+		},
+		"prune deletes in reverse order": {
+			manifest: func() *pa.ConfigSnapshot {
+				return &pa.ConfigSnapshot{Version: SnapshotVersion, Vrfs: []*pb.Vrf{&testVrf}}
+			},
+			exist: []proto.Message{&testVrf, &testBridge, &testPort, &testSvi},
+			prune: true,
+			actions: []string{
+				"OPERATION_DELETE " + testSviName,
+				"OPERATION_DELETE " + testPortName,
+				"OPERATION_DELETE " + testBridgeName,
+			},
+			deleted: []string{testSviName, testPortName, testBridgeName},
+		},
+		"plan only": {
+			manifest: func() *pa.ConfigSnapshot {
+				return &pa.ConfigSnapshot{Version: SnapshotVersion, Vrfs: []*pb.Vrf{&testVrf}}
+			},
+			exist:    []proto.Message{&testVrf, &testBridge},
+			prune:    true,
+			planOnly: true,
+			actions:  []string{"OPERATION_DELETE " + testBridgeName},
+		},
+		"prune cannot keep references outside of manifest": {
+			manifest: func() *pa.ConfigSnapshot {
+				s := testSnapshot()
+				s.Vrfs = nil
+				return s
+			},
+			exist:   []proto.Message{&testVrf},
+			prune:   true,
+			errCode: codes.NotFound,
+			errMsg:  `object "//network.opiproject.org/svis/yellow" references "//network.opiproject.org/vrfs/blue", which is not in the manifest`,
+		},
+		"options before their objects": {
+			manifest: testFullSnapshot,
+			actions: []string{
+				"OPERATION_CREATE " + testVrfOptions.Name,
+				"OPERATION_CREATE " + testVrfName,
+				"OPERATION_CREATE " + testRouteName,
+				"OPERATION_CREATE " + testBridgeOptions.Name,
+				"OPERATION_CREATE " + testBridgeName,
+				"OPERATION_CREATE " + testPortOptions.Name,
+				"OPERATION_CREATE " + testPortName,
+				"OPERATION_CREATE " + testSviName,
+			},
+			created: []string{testVrfOptions.Name, testVrfName, testRouteName, testBridgeOptions.Name, testBridgeName, testPortOptions.Name, testPortName, testSviName},
+		},
+		"update changed options": {
+			manifest: func() *pa.ConfigSnapshot {
+				s := testFullSnapshot()
+				s.LogicalBridgeOptions[0].Spec.NeighSuppress = nil
+				s.LogicalBridgeOptions[0].Spec.Vxlan = &pa.VxlanOptions{DstPort: proto.Uint32(4790)}
+				return s
+			},
+			exist: []proto.Message{&testVrfOptions, &testVrf, &testRoute, &testBridgeOptions, &testBridge, &testPortOptions, &testPort, &testSvi},
+			actions: []string{
+				"OPERATION_UPDATE " + testBridgeOptions.Name + " spec.neigh_suppress,spec.vxlan",
+			},
+			updated: []string{testBridgeOptions.Name + " spec.neigh_suppress,spec.vxlan"},
+		},
+		"prune resets options after their object": {
+			manifest: func() *pa.ConfigSnapshot {
+				return &pa.ConfigSnapshot{Version: SnapshotVersion, Vrfs: []*pb.Vrf{&testVrf}, VrfOptions: []*pa.VrfOptions{&testVrfOptions}}
+			},
+			exist: []proto.Message{&testVrfOptions, &testVrf, &testBridgeOptions, &testBridge},
+			prune: true,
+			actions: []string{
+				"OPERATION_DELETE " + testBridgeName,
+				"OPERATION_DELETE " + testBridgeOptions.Name,
+			},
+			updated: []string{testBridgeOptions.Name + " spec"},
+			deleted: []string{testBridgeName},
+		},
+		"prune deletes routes before their vrf": {
+			manifest: func() *pa.ConfigSnapshot {
+				return &pa.ConfigSnapshot{Version: SnapshotVersion}
+			},
+			exist: []proto.Message{&testVrf, &testRoute},
+			prune: true,
+			actions: []string{
+				"OPERATION_DELETE " + testRouteName,
+				"OPERATION_DELETE " + testVrfName,
+			},
+			deleted: []string{testRouteName, testVrfName},
+		},
+		"update changed route": {
+			manifest: func() *pa.ConfigSnapshot {
+				s := testFullSnapshot()
+				s.StaticRoutes[0].Spec.Metric = 10
+				return s
+			},
+			exist: []proto.Message{&testVrfOptions, &testVrf, &testRoute, &testBridgeOptions, &testBridge, &testPortOptions, &testPort, &testSvi},
+			actions: []string{
+				"OPERATION_UPDATE " + testRouteName + " spec.metric",
+			},
+			updated: []string{testRouteName + " spec.metric"},
+		},
+		"missing manifest": {
+			manifest: func() *pa.ConfigSnapshot { return nil },
+			errCode:  codes.InvalidArgument,
+			errMsg:   "missing manifest",
+		},
+	}
+
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			opi, fake := newTestServer(t)
+			for _, obj := range tt.exist {
+				name := obj.ProtoReflect().Get(obj.ProtoReflect().Descriptor().Fields().ByName("name")).String()
+				if err := fake.store.Set(name, obj); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			request := &pa.ApplyConfigRequest{Manifest: tt.manifest(), Prune: tt.prune, PlanOnly: tt.planOnly}
+			response, err := opi.ApplyConfig(context.Background(), request)
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+			if response == nil {
+				return
+			}
+			var actions []string
+			for _, action := range response.Actions {
+				s := action.Operation.String() + " " + action.Name
+				if len(action.Paths) > 0 {
+					s += " " + strings.Join(action.Paths, ",")
+				}
+				actions = append(actions, s)
+			}
+			if !reflect.DeepEqual(actions, tt.actions) {
+				t.Error("actions: expected", tt.actions, "received", actions)
+			}
+			if response.Applied == tt.planOnly {
+				t.Error("applied: expected", !tt.planOnly, "received", response.Applied)
+			}
+			if !reflect.DeepEqual(fake.created, tt.created) {
+				t.Error("create calls: expected", tt.created, "received", fake.created)
+			}
+			if !reflect.DeepEqual(fake.updated, tt.updated) {
+				t.Error("update calls: expected", tt.updated, "received", fake.updated)
+			}
+			if !reflect.DeepEqual(fake.deleted, tt.deleted) {
+				t.Error("delete calls: expected", tt.deleted, "received", fake.deleted)
+			}
+		})
+	}
+}
+
+func TestAdmin_ImportConfigDropsStatus(t *testing.T) {
+	opi, fake := newTestServer(t)
+	snapshot := &pa.ConfigSnapshot{Version: SnapshotVersion, Vrfs: []*pb.Vrf{&testVrf}}
+	if _, err := opi.ImportConfig(context.Background(), &pa.ImportConfigRequest{Snapshot: snapshot}); err != nil {
+		t.Fatal(err)
+	}
+	obj := new(pb.Vrf)
+	if _, err := opi.store.Get(testVrfName, obj); err != nil {
+		t.Fatal(err)
+	}
+	if obj.Status != nil {
+		t.Error("Expect status to be computed by Create, received", obj.Status)
+	}
+	if len(fake.created) != 1 {
+		t.Error("Expect 1 object created, received", fake.created)
+	}
+}
+
+func TestAdmin_Snapshot(t *testing.T) {
+	tests := map[string]struct {
+		format   string
+		contains string
+	}{
+		"json": {
+			format:   FormatJSON,
+			contains: `"logicalBridges": [`,
+		},
+		"yaml": {
+			format:   FormatYAML,
+			contains: "logicalBridges:\n",
+		},
+	}
+
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			data, err := MarshalSnapshot(testFullSnapshot(), tt.format)
+			if err != nil {
+				t.Fatal("Expect no error, received", err)
+			}
+			if !strings.Contains(string(data), tt.contains) {
+				t.Errorf("Expect %q in document, received %s", tt.contains, data)
+			}
+			snapshot, err := UnmarshalSnapshot(data, tt.format)
+			if err != nil {
+				t.Fatal("Expect no error, received", err)
+			}
+			if !proto.Equal(snapshot, testFullSnapshot()) {
+				t.Error("Expect", testFullSnapshot(), "received", snapshot)
+			}
+		})
+	}
+
+	if _, err := MarshalSnapshot(testSnapshot(), "xml"); err == nil {
+		t.Error("Expect error for unsupported format")
+	}
+	if _, err := UnmarshalSnapshot([]byte("{}"), "xml"); err == nil {
+		t.Error("Expect error for unsupported format")
+	}
+}
@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package admin implements operations spanning all EVPN resources
+package admin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/watch"
+)
+
+// SnapshotVersion is the snapshot format version produced by ExportConfig
+const SnapshotVersion = uint32(1)
+
+// name prefixes of the EVPN collections kept in the store
+const (
+	vrfPrefix    = "//network.opiproject.org/vrfs/"
+	bridgePrefix = "//network.opiproject.org/bridges/"
+	portPrefix   = "//network.opiproject.org/ports/"
+	sviPrefix    = "//network.opiproject.org/svis/"
+	routePrefix  = "//network.opiproject.org/routes/"
+)
+
+// optionsSuffix follows the name of a resource in the name of its options
+const optionsSuffix = "/options"
+
+// ExportConfig dumps all EVPN objects and their options from the store
+func (s *Server) ExportConfig(_ context.Context, _ *pa.ExportConfigRequest) (*pa.ConfigSnapshot, error) {
+	snapshot := &pa.ConfigSnapshot{Version: SnapshotVersion}
+	for _, key := range s.store.Keys() {
+		obj := watch.NewResource(key)
+		if obj == nil {
+			obj = newOptions(key)
+		}
+		if obj == nil {
+			continue
+		}
+		ok, err := s.store.Get(key, obj)
+		if err != nil {
+			fmt.Printf("Failed to interact with store: %v", err)
+			return nil, err
+		}
+		if !ok {
+			log.Printf("Indexed key %s is missing in store", key)
+			continue
+		}
+		switch o := obj.(type) {
+		case *pb.Vrf:
+			snapshot.Vrfs = append(snapshot.Vrfs, o)
+		case *pb.LogicalBridge:
+			snapshot.LogicalBridges = append(snapshot.LogicalBridges, o)
+		case *pb.BridgePort:
+			snapshot.BridgePorts = append(snapshot.BridgePorts, o)
+		case *pb.Svi:
+			snapshot.Svis = append(snapshot.Svis, o)
+		case *pa.StaticRoute:
+			snapshot.StaticRoutes = append(snapshot.StaticRoutes, o)
+		// options reset to their defaults are left out, like options never set
+		case *pa.VrfOptions:
+			if proto.Size(o.Spec) > 0 {
+				snapshot.VrfOptions = append(snapshot.VrfOptions, o)
+			}
+		case *pa.LogicalBridgeOptions:
+			if proto.Size(o.Spec) > 0 {
+				snapshot.LogicalBridgeOptions = append(snapshot.LogicalBridgeOptions, o)
+			}
+		case *pa.BridgePortOptions:
+			if proto.Size(o.Spec) > 0 {
+				snapshot.BridgePortOptions = append(snapshot.BridgePortOptions, o)
+			}
+		}
+	}
+	return snapshot, nil
+}
+
+// newOptions returns an empty message of the options singleton stored
+// under key, or nil if the key holds no options
+func newOptions(k string) proto.Message {
+	if !strings.HasSuffix(k, optionsSuffix) {
+		return nil
+	}
+	switch watch.NewResource(strings.TrimSuffix(k, optionsSuffix)).(type) {
+	case *pb.Vrf:
+		return new(pa.VrfOptions)
+	case *pb.LogicalBridge:
+		return new(pa.LogicalBridgeOptions)
+	case *pb.BridgePort:
+		return new(pa.BridgePortOptions)
+	}
+	return nil
+}
+
+// ImportConfig validates the snapshot and creates its objects through the
+// EVPN servers in order VRF, static route, LogicalBridge, BridgePort, SVI,
+// options are set before the object they belong to
+func (s *Server) ImportConfig(ctx context.Context, in *pa.ImportConfigRequest) (*pa.ImportConfigResponse, error) {
+	// check input correctness
+	if err := s.validateImportConfigRequest(in); err != nil {
+		return nil, err
+	}
+	response := &pa.ImportConfigResponse{}
+	if in.ValidateOnly {
+		return response, nil
+	}
+	current, err := s.ExportConfig(ctx, &pa.ExportConfigRequest{})
+	if err != nil {
+		return nil, err
+	}
+	// objects that already exist are left as they are, like Create does
+	for _, step := range s.plan(current, in.Snapshot, false) {
+		if step.action.Operation != pa.PlanAction_OPERATION_CREATE {
+			continue
+		}
+		if err := step.run(ctx); err != nil {
+			st := status.Convert(err)
+			return nil, status.Errorf(st.Code(), "failed to import %s after creating %d objects: %s",
+				step.action.Name, len(response.Created), st.Message())
+		}
+		response.Created = append(response.Created, step.action.Name)
+	}
+	created := make(map[string]bool)
+	for _, name := range response.Created {
+		created[name] = true
+	}
+	for _, name := range snapshotNames(in.Snapshot) {
+		if !created[name] {
+			log.Printf("Already existing object %v, skipping", name)
+			response.Existing = append(response.Existing, name)
+		}
+	}
+	return response, nil
+}
+
+// ApplyConfig converges the store to the manifest, objects missing in the
+// store are created, objects with different spec are updated and, on prune,
+// objects missing in the manifest are deleted. Plans updating objects which
+// can not change in place are rejected.
+func (s *Server) ApplyConfig(ctx context.Context, in *pa.ApplyConfigRequest) (*pa.ApplyConfigResponse, error) {
+	// check input correctness
+	if err := s.validateApplyConfigRequest(in); err != nil {
+		return nil, err
+	}
+	current, err := s.ExportConfig(ctx, &pa.ExportConfigRequest{})
+	if err != nil {
+		return nil, err
+	}
+	steps := s.plan(current, in.Manifest, in.Prune)
+	response := &pa.ApplyConfigResponse{Applied: !in.PlanOnly}
+	for _, step := range steps {
+		// a plan which can not be executed completely is not started
+		if step.err != nil {
+			return nil, step.err
+		}
+		response.Actions = append(response.Actions, step.action)
+	}
+	if in.PlanOnly {
+		return response, nil
+	}
+	for i, step := range steps {
+		log.Printf("Applying %v %v", step.action.Operation, step.action.Name)
+		if err := step.run(ctx); err != nil {
+			st := status.Convert(err)
+			return nil, status.Errorf(st.Code(), "failed to %v %s after %d of %d actions: %s",
+				step.action.Operation, step.action.Name, i, len(steps), st.Message())
+		}
+	}
+	return response, nil
+}
+
+// snapshotNames returns names of all objects in the snapshot in creation order
+func snapshotNames(snapshot *pa.ConfigSnapshot) []string {
+	var names []string
+	for _, obj := range snapshot.VrfOptions {
+		names = append(names, obj.Name)
+	}
+	for _, obj := range snapshot.Vrfs {
+		names = append(names, obj.Name)
+	}
+	for _, obj := range snapshot.StaticRoutes {
+		names = append(names, obj.Name)
+	}
+	for _, obj := range snapshot.LogicalBridgeOptions {
+		names = append(names, obj.Name)
+	}
+	for _, obj := range snapshot.LogicalBridges {
+		names = append(names, obj.Name)
+	}
+	for _, obj := range snapshot.BridgePortOptions {
+		names = append(names, obj.Name)
+	}
+	for _, obj := range snapshot.BridgePorts {
+		names = append(names, obj.Name)
+	}
+	for _, obj := range snapshot.Svis {
+		names = append(names, obj.Name)
+	}
+	return names
+}
@@ -0,0 +1,338 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package admin implements operations spanning all EVPN resources
+package admin
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+)
+
+// planStep is an action of the plan together with the call executing it,
+// err is set when the action can not be executed
+type planStep struct {
+	action *pa.PlanAction
+	run    func(ctx context.Context) error
+	err    error
+}
+
+// object is one of the EVPN resources
+type object interface {
+	proto.Message
+	GetName() string
+}
+
+// collection changes objects of one EVPN kind through its server,
+// status is never sent since it is computed by the server. Kinds without
+// update can not change their spec in place.
+type collection[T object] struct {
+	validate func(obj T) error
+	create   func(ctx context.Context, obj T) error
+	update   func(ctx context.Context, obj T, paths []string) error
+	remove   func(ctx context.Context, name string) error
+}
+
+// diff compares current and desired objects of one kind, it returns steps
+// creating or updating objects and, on prune, steps deleting objects
+// which are not desired anymore
+func (c collection[T]) diff(current []T, desired []T, prune bool) ([]planStep, []planStep) {
+	existing := make(map[string]T)
+	for _, obj := range current {
+		existing[obj.GetName()] = obj
+	}
+	var changes, deletes []planStep
+	wanted := make(map[string]bool)
+	for _, obj := range desired {
+		obj := obj
+		wanted[obj.GetName()] = true
+		old, ok := existing[obj.GetName()]
+		if !ok {
+			changes = append(changes, planStep{
+				action: &pa.PlanAction{Operation: pa.PlanAction_OPERATION_CREATE, Name: obj.GetName()},
+				run:    func(ctx context.Context) error { return c.create(ctx, obj) },
+			})
+			continue
+		}
+		paths := specDiff(old, obj)
+		if len(paths) == 0 {
+			continue
+		}
+		if c.update == nil {
+			err := status.Errorf(codes.FailedPrecondition, "%s can not be updated in place, delete and create it to change %v",
+				obj.GetName(), strings.Join(paths, ", "))
+			changes = append(changes, planStep{
+				action: &pa.PlanAction{Operation: pa.PlanAction_OPERATION_UPDATE, Name: obj.GetName(), Paths: paths},
+				run:    func(ctx context.Context) error { return err },
+				err:    err,
+			})
+			continue
+		}
+		changes = append(changes, planStep{
+			action: &pa.PlanAction{Operation: pa.PlanAction_OPERATION_UPDATE, Name: obj.GetName(), Paths: paths},
+			run:    func(ctx context.Context) error { return c.update(ctx, obj, paths) },
+		})
+	}
+	if !prune {
+		return changes, deletes
+	}
+	for _, obj := range current {
+		name := obj.GetName()
+		if wanted[name] {
+			continue
+		}
+		deletes = append(deletes, planStep{
+			action: &pa.PlanAction{Operation: pa.PlanAction_OPERATION_DELETE, Name: name},
+			run:    func(ctx context.Context) error { return c.remove(ctx, name) },
+		})
+	}
+	return changes, deletes
+}
+
+// specDiff returns the paths of the spec fields that differ
+func specDiff(current proto.Message, desired proto.Message) []string {
+	fd := current.ProtoReflect().Descriptor().Fields().ByName("spec")
+	a := current.ProtoReflect().Get(fd).Message()
+	b := desired.ProtoReflect().Get(fd).Message()
+	var paths []string
+	fields := fd.Message().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if a.Has(field) != b.Has(field) || !equalValues(field, a.Get(field), b.Get(field)) {
+			paths = append(paths, "spec."+string(field.Name()))
+		}
+	}
+	return paths
+}
+
+// equalValues compares values of the field, handling lists and messages
+func equalValues(field protoreflect.FieldDescriptor, a protoreflect.Value, b protoreflect.Value) bool {
+	if field.IsList() {
+		if a.List().Len() != b.List().Len() {
+			return false
+		}
+		for i := 0; i < a.List().Len(); i++ {
+			if !equalScalar(field, a.List().Get(i), b.List().Get(i)) {
+				return false
+			}
+		}
+		return true
+	}
+	return equalScalar(field, a, b)
+}
+
+func equalScalar(field protoreflect.FieldDescriptor, a protoreflect.Value, b protoreflect.Value) bool {
+	if field.Message() != nil {
+		return proto.Equal(a.Message().Interface(), b.Message().Interface())
+	}
+	return a.Equal(b)
+}
+
+// plan lists the steps converging current to desired state, creates and
+// updates go in order VRF, static route, LogicalBridge, BridgePort, SVI and deletes follow
+// in reverse order, so references are always resolvable. Deleting options
+// resets them to their defaults, since options can not be deleted.
+func (s *Server) plan(current *pa.ConfigSnapshot, desired *pa.ConfigSnapshot, prune bool) []planStep {
+	vrfOptionsChanges, vrfOptionsDeletes := s.vrfOptions().diff(current.VrfOptions, desired.VrfOptions, prune)
+	vrfChanges, vrfDeletes := s.vrfs().diff(current.Vrfs, desired.Vrfs, prune)
+	routeChanges, routeDeletes := s.routes().diff(current.StaticRoutes, desired.StaticRoutes, prune)
+	bridgeOptionsChanges, bridgeOptionsDeletes := s.bridgeOptions().diff(current.LogicalBridgeOptions, desired.LogicalBridgeOptions, prune)
+	bridgeChanges, bridgeDeletes := s.bridges().diff(current.LogicalBridges, desired.LogicalBridges, prune)
+	portOptionsChanges, portOptionsDeletes := s.portOptions().diff(current.BridgePortOptions, desired.BridgePortOptions, prune)
+	portChanges, portDeletes := s.ports().diff(current.BridgePorts, desired.BridgePorts, prune)
+	sviChanges, sviDeletes := s.svis().diff(current.Svis, desired.Svis, prune)
+
+	// options are set before the object they belong to is created,
+	// and reset after it is deleted
+	var steps []planStep
+	for _, group := range [][]planStep{
+		vrfOptionsChanges, vrfChanges, routeChanges, bridgeOptionsChanges, bridgeChanges, portOptionsChanges, portChanges, sviChanges,
+		sviDeletes, portDeletes, portOptionsDeletes, bridgeDeletes, bridgeOptionsDeletes, routeDeletes, vrfDeletes, vrfOptionsDeletes,
+	} {
+		steps = append(steps, group...)
+	}
+	return steps
+}
+
+func (s *Server) vrfs() collection[*pb.Vrf] {
+	return collection[*pb.Vrf]{
+		validate: func(obj *pb.Vrf) error {
+			return s.vrf.ValidateCreateVrfRequest(createVrfRequest(obj))
+		},
+		create: func(ctx context.Context, obj *pb.Vrf) error {
+			_, err := s.vrf.CreateVrf(ctx, createVrfRequest(obj))
+			return err
+		},
+		remove: func(ctx context.Context, name string) error {
+			_, err := s.vrf.DeleteVrf(ctx, &pb.DeleteVrfRequest{Name: name})
+			return err
+		},
+	}
+}
+
+func (s *Server) bridges() collection[*pb.LogicalBridge] {
+	return collection[*pb.LogicalBridge]{
+		validate: func(obj *pb.LogicalBridge) error {
+			return s.bridge.ValidateCreateLogicalBridgeRequest(createLogicalBridgeRequest(obj))
+		},
+		create: func(ctx context.Context, obj *pb.LogicalBridge) error {
+			_, err := s.bridge.CreateLogicalBridge(ctx, createLogicalBridgeRequest(obj))
+			return err
+		},
+		remove: func(ctx context.Context, name string) error {
+			_, err := s.bridge.DeleteLogicalBridge(ctx, &pb.DeleteLogicalBridgeRequest{Name: name})
+			return err
+		},
+	}
+}
+
+func (s *Server) ports() collection[*pb.BridgePort] {
+	return collection[*pb.BridgePort]{
+		validate: func(obj *pb.BridgePort) error {
+			return s.port.ValidateCreateBridgePortRequest(createBridgePortRequest(obj))
+		},
+		create: func(ctx context.Context, obj *pb.BridgePort) error {
+			_, err := s.port.CreateBridgePort(ctx, createBridgePortRequest(obj))
+			return err
+		},
+		remove: func(ctx context.Context, name string) error {
+			_, err := s.port.DeleteBridgePort(ctx, &pb.DeleteBridgePortRequest{Name: name})
+			return err
+		},
+	}
+}
+
+func (s *Server) svis() collection[*pb.Svi] {
+	return collection[*pb.Svi]{
+		validate: func(obj *pb.Svi) error {
+			return s.svi.ValidateCreateSviRequest(createSviRequest(obj))
+		},
+		create: func(ctx context.Context, obj *pb.Svi) error {
+			_, err := s.svi.CreateSvi(ctx, createSviRequest(obj))
+			return err
+		},
+		remove: func(ctx context.Context, name string) error {
+			_, err := s.svi.DeleteSvi(ctx, &pb.DeleteSviRequest{Name: name})
+			return err
+		},
+	}
+}
+
+func (s *Server) routes() collection[*pa.StaticRoute] {
+	return collection[*pa.StaticRoute]{
+		validate: func(obj *pa.StaticRoute) error {
+			return s.route.ValidateCreateStaticRouteRequest(createStaticRouteRequest(obj))
+		},
+		create: func(ctx context.Context, obj *pa.StaticRoute) error {
+			_, err := s.route.CreateStaticRoute(ctx, createStaticRouteRequest(obj))
+			return err
+		},
+		update: func(ctx context.Context, obj *pa.StaticRoute, paths []string) error {
+			_, err := s.route.UpdateStaticRoute(ctx, &pa.UpdateStaticRouteRequest{StaticRoute: &pa.StaticRoute{Name: obj.Name, Spec: obj.Spec}, UpdateMask: &fieldmaskpb.FieldMask{Paths: paths}})
+			return err
+		},
+		remove: func(ctx context.Context, name string) error {
+			_, err := s.route.DeleteStaticRoute(ctx, &pa.DeleteStaticRouteRequest{Name: name})
+			return err
+		},
+	}
+}
+
+func (s *Server) vrfOptions() collection[*pa.VrfOptions] {
+	return collection[*pa.VrfOptions]{
+		validate: func(obj *pa.VrfOptions) error {
+			return s.vrf.ValidateUpdateVrfOptionsRequest(&pa.UpdateVrfOptionsRequest{VrfOptions: &pa.VrfOptions{Name: obj.Name, Spec: obj.Spec}})
+		},
+		create: func(ctx context.Context, obj *pa.VrfOptions) error {
+			_, err := s.vrf.UpdateVrfOptions(ctx, &pa.UpdateVrfOptionsRequest{VrfOptions: &pa.VrfOptions{Name: obj.Name, Spec: obj.Spec}})
+			return err
+		},
+		update: func(ctx context.Context, obj *pa.VrfOptions, paths []string) error {
+			_, err := s.vrf.UpdateVrfOptions(ctx, &pa.UpdateVrfOptionsRequest{VrfOptions: &pa.VrfOptions{Name: obj.Name, Spec: obj.Spec}, UpdateMask: &fieldmaskpb.FieldMask{Paths: paths}})
+			return err
+		},
+		remove: func(ctx context.Context, name string) error {
+			_, err := s.vrf.UpdateVrfOptions(ctx, &pa.UpdateVrfOptionsRequest{VrfOptions: &pa.VrfOptions{Name: name, Spec: &pa.VrfOptionsSpec{}}, UpdateMask: resetMask})
+			return err
+		},
+	}
+}
+
+func (s *Server) bridgeOptions() collection[*pa.LogicalBridgeOptions] {
+	return collection[*pa.LogicalBridgeOptions]{
+		validate: func(obj *pa.LogicalBridgeOptions) error {
+			return s.bridge.ValidateUpdateLogicalBridgeOptionsRequest(&pa.UpdateLogicalBridgeOptionsRequest{LogicalBridgeOptions: &pa.LogicalBridgeOptions{Name: obj.Name, Spec: obj.Spec}})
+		},
+		create: func(ctx context.Context, obj *pa.LogicalBridgeOptions) error {
+			_, err := s.bridge.UpdateLogicalBridgeOptions(ctx, &pa.UpdateLogicalBridgeOptionsRequest{LogicalBridgeOptions: &pa.LogicalBridgeOptions{Name: obj.Name, Spec: obj.Spec}})
+			return err
+		},
+		update: func(ctx context.Context, obj *pa.LogicalBridgeOptions, paths []string) error {
+			_, err := s.bridge.UpdateLogicalBridgeOptions(ctx, &pa.UpdateLogicalBridgeOptionsRequest{LogicalBridgeOptions: &pa.LogicalBridgeOptions{Name: obj.Name, Spec: obj.Spec}, UpdateMask: &fieldmaskpb.FieldMask{Paths: paths}})
+			return err
+		},
+		remove: func(ctx context.Context, name string) error {
+			_, err := s.bridge.UpdateLogicalBridgeOptions(ctx, &pa.UpdateLogicalBridgeOptionsRequest{LogicalBridgeOptions: &pa.LogicalBridgeOptions{Name: name, Spec: &pa.LogicalBridgeOptionsSpec{}}, UpdateMask: resetMask})
+			return err
+		},
+	}
+}
+
+func (s *Server) portOptions() collection[*pa.BridgePortOptions] {
+	return collection[*pa.BridgePortOptions]{
+		validate: func(obj *pa.BridgePortOptions) error {
+			return s.port.ValidateUpdateBridgePortOptionsRequest(&pa.UpdateBridgePortOptionsRequest{BridgePortOptions: &pa.BridgePortOptions{Name: obj.Name, Spec: obj.Spec}})
+		},
+		create: func(ctx context.Context, obj *pa.BridgePortOptions) error {
+			_, err := s.port.UpdateBridgePortOptions(ctx, &pa.UpdateBridgePortOptionsRequest{BridgePortOptions: &pa.BridgePortOptions{Name: obj.Name, Spec: obj.Spec}})
+			return err
+		},
+		update: func(ctx context.Context, obj *pa.BridgePortOptions, paths []string) error {
+			_, err := s.port.UpdateBridgePortOptions(ctx, &pa.UpdateBridgePortOptionsRequest{BridgePortOptions: &pa.BridgePortOptions{Name: obj.Name, Spec: obj.Spec}, UpdateMask: &fieldmaskpb.FieldMask{Paths: paths}})
+			return err
+		},
+		remove: func(ctx context.Context, name string) error {
+			_, err := s.port.UpdateBridgePortOptions(ctx, &pa.UpdateBridgePortOptionsRequest{BridgePortOptions: &pa.BridgePortOptions{Name: name, Spec: &pa.BridgePortOptionsSpec{}}, UpdateMask: resetMask})
+			return err
+		},
+	}
+}
+
+// resetMask sets all options back to their defaults
+var resetMask = &fieldmaskpb.FieldMask{Paths: []string{"spec"}}
+
+// createVrfRequest asks for the VRF of the snapshot under the id taken from its name
+func createVrfRequest(obj *pb.Vrf) *pb.CreateVrfRequest {
+	return &pb.CreateVrfRequest{VrfId: path.Base(obj.Name), Vrf: &pb.Vrf{Name: obj.Name, Spec: obj.Spec}}
+}
+
+// createLogicalBridgeRequest asks for the LogicalBridge of the snapshot under the id taken from its name
+func createLogicalBridgeRequest(obj *pb.LogicalBridge) *pb.CreateLogicalBridgeRequest {
+	return &pb.CreateLogicalBridgeRequest{LogicalBridgeId: path.Base(obj.Name), LogicalBridge: &pb.LogicalBridge{Name: obj.Name, Spec: obj.Spec}}
+}
+
+// createBridgePortRequest asks for the BridgePort of the snapshot under the id taken from its name
+func createBridgePortRequest(obj *pb.BridgePort) *pb.CreateBridgePortRequest {
+	return &pb.CreateBridgePortRequest{BridgePortId: path.Base(obj.Name), BridgePort: &pb.BridgePort{Name: obj.Name, Spec: obj.Spec}}
+}
+
+// createSviRequest asks for the SVI of the snapshot under the id taken from its name
+func createSviRequest(obj *pb.Svi) *pb.CreateSviRequest {
+	return &pb.CreateSviRequest{SviId: path.Base(obj.Name), Svi: &pb.Svi{Name: obj.Name, Spec: obj.Spec}}
+}
+
+// createStaticRouteRequest asks for the static route of the snapshot under the id taken from its name
+func createStaticRouteRequest(obj *pa.StaticRoute) *pa.CreateStaticRouteRequest {
+	return &pa.CreateStaticRouteRequest{StaticRouteId: path.Base(obj.Name), StaticRoute: &pa.StaticRoute{Name: obj.Name, Spec: obj.Spec}}
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package admin implements operations spanning all EVPN resources
+package admin
+
+import (
+	"log"
+
+	"github.com/philippgille/gokv"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+)
+
+// KeyedStore is a gokv.Store that is able to enumerate its keys
+type KeyedStore interface {
+	gokv.Store
+	Keys() []string
+}
+
+// VrfServer is the VRF service with its options, which also checks
+// the requests of imports
+type VrfServer interface {
+	pb.VrfServiceServer
+	pa.VrfOptionsServiceServer
+	ValidateCreateVrfRequest(in *pb.CreateVrfRequest) error
+	ValidateUpdateVrfOptionsRequest(in *pa.UpdateVrfOptionsRequest) error
+}
+
+// LogicalBridgeServer is the LogicalBridge service with its options, which
+// also checks the requests of imports
+type LogicalBridgeServer interface {
+	pb.LogicalBridgeServiceServer
+	pa.LogicalBridgeOptionsServiceServer
+	ValidateCreateLogicalBridgeRequest(in *pb.CreateLogicalBridgeRequest) error
+	ValidateUpdateLogicalBridgeOptionsRequest(in *pa.UpdateLogicalBridgeOptionsRequest) error
+}
+
+// BridgePortServer is the BridgePort service with its options, which also
+// checks the requests of imports
+type BridgePortServer interface {
+	pb.BridgePortServiceServer
+	pa.BridgePortOptionsServiceServer
+	ValidateCreateBridgePortRequest(in *pb.CreateBridgePortRequest) error
+	ValidateUpdateBridgePortOptionsRequest(in *pa.UpdateBridgePortOptionsRequest) error
+}
+
+// SviServer is the SVI service, which also checks create requests of imports
+type SviServer interface {
+	pb.SviServiceServer
+	ValidateCreateSviRequest(in *pb.CreateSviRequest) error
+}
+
+// StaticRouteServer is the static route service, which also checks create
+// requests of imports
+type StaticRouteServer interface {
+	pa.StaticRouteServiceServer
+	ValidateCreateStaticRouteRequest(in *pa.CreateStaticRouteRequest) error
+}
+
+// Server represents the Server object
+type Server struct {
+	pa.UnimplementedAdminServiceServer
+	store  KeyedStore
+	vrf    VrfServer
+	bridge LogicalBridgeServer
+	port   BridgePortServer
+	svi    SviServer
+	route  StaticRouteServer
+}
+
+// NewServer creates initialized instance of Admin server,
+// objects are imported through the given EVPN servers
+func NewServer(store KeyedStore, vrf VrfServer, bridge LogicalBridgeServer,
+	port BridgePortServer, svi SviServer, route StaticRouteServer) *Server {
+	if store == nil {
+		log.Panic("nil for Store is not allowed")
+	}
+	if vrf == nil || bridge == nil || port == nil || svi == nil || route == nil {
+		log.Panic("nil for EVPN server is not allowed")
+	}
+	return &Server{
+		store:  store,
+		vrf:    vrf,
+		bridge: bridge,
+		port:   port,
+		svi:    svi,
+		route:  route,
+	}
+}
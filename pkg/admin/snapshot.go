@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package admin implements operations spanning all EVPN resources
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+)
+
+// snapshot document formats
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+)
+
+// MarshalSnapshot encodes the snapshot as JSON or YAML document,
+// field names are the same in both formats
+func MarshalSnapshot(snapshot *pa.ConfigSnapshot, format string) ([]byte, error) {
+	data, err := protojson.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	switch format {
+	case FormatJSON:
+		// indent with encoding/json, protojson output is deliberately unstable
+		var out bytes.Buffer
+		if err := json.Indent(&out, data, "", "  "); err != nil {
+			return nil, err
+		}
+		out.WriteByte('\n')
+		return out.Bytes(), nil
+	case FormatYAML:
+		// JSON is valid YAML, decode it into nodes to keep the field order
+		var node yaml.Node
+		if err := yaml.Unmarshal(data, &node); err != nil {
+			return nil, err
+		}
+		resetStyle(&node)
+		return yaml.Marshal(&node)
+	default:
+		return nil, fmt.Errorf("unsupported snapshot format %q", format)
+	}
+}
+
+// UnmarshalSnapshot decodes JSON or YAML document into a snapshot
+func UnmarshalSnapshot(data []byte, format string) (*pa.ConfigSnapshot, error) {
+	switch format {
+	case FormatJSON:
+	case FormatYAML:
+		var doc interface{}
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+		var err error
+		if data, err = json.Marshal(doc); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported snapshot format %q", format)
+	}
+	snapshot := new(pa.ConfigSnapshot)
+	if err := protojson.Unmarshal(data, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// resetStyle switches JSON flow style nodes to YAML block style
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, n := range node.Content {
+		resetStyle(n)
+	}
+}
@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package admin implements operations spanning all EVPN resources
+package admin
+
+import (
+	"fmt"
+	"strings"
+
+	"go.einride.tech/aip/resourcename"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+)
+
+func (s *Server) validateImportConfigRequest(in *pa.ImportConfigRequest) error {
+	if in.GetSnapshot() == nil {
+		return status.Error(codes.InvalidArgument, "missing snapshot")
+	}
+	return s.validateSnapshot(in.Snapshot, true)
+}
+
+func (s *Server) validateApplyConfigRequest(in *pa.ApplyConfigRequest) error {
+	if in.GetManifest() == nil {
+		return status.Error(codes.InvalidArgument, "missing manifest")
+	}
+	// objects outside of the manifest are deleted on prune, so they cannot be referenced
+	return s.validateSnapshot(in.Manifest, !in.Prune)
+}
+
+// validateSnapshot checks version, names and references of the snapshot,
+// references are resolved in the snapshot and, if allowed, in the store
+func (s *Server) validateSnapshot(snapshot *pa.ConfigSnapshot, resolveInStore bool) error {
+	if snapshot.Version == 0 || snapshot.Version > SnapshotVersion {
+		return status.Errorf(codes.InvalidArgument, "unsupported snapshot version %d, expected %d", snapshot.Version, SnapshotVersion)
+	}
+	// every object must be well formed and appear only once, the EVPN
+	// servers check the objects like they check their create requests
+	names := make(map[string]bool)
+	check := func(name string, prefix string, validate func() error) error {
+		if err := validateName(name, prefix); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid object %q: %v", name, err)
+		}
+		if err := validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid object %q: %v", name, status.Convert(err).Message())
+		}
+		if names[name] {
+			return status.Errorf(codes.InvalidArgument, "duplicate object %q", name)
+		}
+		names[name] = true
+		return nil
+	}
+	vrfs, routes, bridges, ports, svis := s.vrfs(), s.routes(), s.bridges(), s.ports(), s.svis()
+	vrfOptions, bridgeOptions, portOptions := s.vrfOptions(), s.bridgeOptions(), s.portOptions()
+	for _, obj := range snapshot.VrfOptions {
+		obj := obj
+		if err := check(obj.GetName(), vrfPrefix, func() error { return vrfOptions.validate(obj) }); err != nil {
+			return err
+		}
+	}
+	for _, obj := range snapshot.LogicalBridgeOptions {
+		obj := obj
+		if err := check(obj.GetName(), bridgePrefix, func() error { return bridgeOptions.validate(obj) }); err != nil {
+			return err
+		}
+	}
+	for _, obj := range snapshot.BridgePortOptions {
+		obj := obj
+		if err := check(obj.GetName(), portPrefix, func() error { return portOptions.validate(obj) }); err != nil {
+			return err
+		}
+	}
+	for _, obj := range snapshot.Vrfs {
+		obj := obj
+		if err := check(obj.GetName(), vrfPrefix, func() error { return vrfs.validate(obj) }); err != nil {
+			return err
+		}
+	}
+	for _, obj := range snapshot.StaticRoutes {
+		obj := obj
+		if err := check(obj.GetName(), routePrefix, func() error { return routes.validate(obj) }); err != nil {
+			return err
+		}
+	}
+	for _, obj := range snapshot.LogicalBridges {
+		obj := obj
+		if err := check(obj.GetName(), bridgePrefix, func() error { return bridges.validate(obj) }); err != nil {
+			return err
+		}
+	}
+	for _, obj := range snapshot.BridgePorts {
+		obj := obj
+		if err := check(obj.GetName(), portPrefix, func() error { return ports.validate(obj) }); err != nil {
+			return err
+		}
+	}
+	for _, obj := range snapshot.Svis {
+		obj := obj
+		if err := check(obj.GetName(), sviPrefix, func() error { return svis.validate(obj) }); err != nil {
+			return err
+		}
+	}
+	// referenced objects must be part of the snapshot or already exist
+	for _, obj := range snapshot.StaticRoutes {
+		if err := s.validateReference(obj.Name, obj.Spec.Vrf, vrfPrefix, new(pb.Vrf), names, resolveInStore); err != nil {
+			return err
+		}
+	}
+	for _, obj := range snapshot.BridgePorts {
+		for _, bridge := range obj.Spec.LogicalBridges {
+			if err := s.validateReference(obj.Name, bridge, bridgePrefix, new(pb.LogicalBridge), names, resolveInStore); err != nil {
+				return err
+			}
+		}
+	}
+	for _, obj := range snapshot.Svis {
+		if err := s.validateReference(obj.Name, obj.Spec.Vrf, vrfPrefix, new(pb.Vrf), names, resolveInStore); err != nil {
+			return err
+		}
+		if err := s.validateReference(obj.Name, obj.Spec.LogicalBridge, bridgePrefix, new(pb.LogicalBridge), names, resolveInStore); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateName checks the name of a snapshot object
+func validateName(name string, prefix string) error {
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(name); err != nil {
+		return err
+	}
+	if !strings.HasPrefix(name, prefix) {
+		return fmt.Errorf("name has to start with %v", prefix)
+	}
+	return nil
+}
+
+func (s *Server) validateReference(owner string, name string, prefix string, obj proto.Message, names map[string]bool, resolveInStore bool) error {
+	if !strings.HasPrefix(name, prefix) {
+		return status.Errorf(codes.InvalidArgument, "object %q references %q, which has to start with %v", owner, name, prefix)
+	}
+	if names[name] {
+		return nil
+	}
+	if !resolveInStore {
+		return status.Errorf(codes.NotFound, "object %q references %q, which is not in the manifest", owner, name)
+	}
+	ok, err := s.store.Get(name, obj)
+	if err != nil {
+		fmt.Printf("Failed to interact with store: %v", err)
+		return err
+	}
+	if !ok {
+		return status.Errorf(codes.NotFound, "object %q references %q, which is neither in the snapshot nor in the store", owner, name)
+	}
+	return nil
+}
@@ -0,0 +1,303 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package audit keeps an append-only trail of the configuration changes
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/philippgille/gokv"
+	"github.com/stretchr/testify/mock"
+	"github.com/vishvananda/netlink"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+	"github.com/opiproject/opi-evpn-bridge/pkg/store"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils/mocks"
+)
+
+var (
+	testVrfName = "//network.opiproject.org/vrfs/blue"
+	testVrf     = pb.Vrf{
+		Name: testVrfName,
+		Spec: &pb.VrfSpec{Vni: proto.Uint32(1000)},
+	}
+)
+
+func newTestStore(t *testing.T) gokv.Store {
+	kvStore, err := store.NewStore(config.DatabaseConfig{Type: config.MemoryStore})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return kvStore
+}
+
+func Test_UnaryServerInterceptor(t *testing.T) {
+	modified := proto.Clone(&testVrf).(*pb.Vrf)
+	modified.Spec.Vni = proto.Uint32(2000)
+	tests := map[string]struct {
+		method   string
+		in       proto.Message
+		dryRun   bool
+		exist    bool
+		handler  func(ctx context.Context, s gokv.Store, r *utils.Recorder) (interface{}, error)
+		recorded bool
+		name     string
+		before   proto.Message
+		after    proto.Message
+		errCode  codes.Code
+		netlink  []string
+	}{
+		"create": {
+			method: "/opi_api.network.evpn_gw.v1alpha1.VrfService/CreateVrf",
+			in:     &pb.CreateVrfRequest{VrfId: "blue", Vrf: &pb.Vrf{Spec: testVrf.Spec}},
+			handler: func(ctx context.Context, s gokv.Store, r *utils.Recorder) (interface{}, error) {
+				_ = r.LinkSetUp(ctx, &netlink.Vrf{LinkAttrs: netlink.LinkAttrs{Name: "blue"}})
+				return &testVrf, s.Set(testVrfName, &testVrf)
+			},
+			recorded: true,
+			name:     testVrfName,
+			after:    &testVrf,
+			errCode:  codes.OK,
+			netlink:  []string{"ip link set blue up"},
+		},
+		"update": {
+			method: "/opi_api.network.evpn_gw.v1alpha1.VrfService/UpdateVrf",
+			in:     &pb.UpdateVrfRequest{Vrf: modified},
+			exist:  true,
+			handler: func(_ context.Context, s gokv.Store, _ *utils.Recorder) (interface{}, error) {
+				return modified, s.Set(testVrfName, modified)
+			},
+			recorded: true,
+			name:     testVrfName,
+			before:   &testVrf,
+			after:    modified,
+			errCode:  codes.OK,
+		},
+		"failed delete": {
+			method: "/opi_api.network.evpn_gw.v1alpha1.VrfService/DeleteVrf",
+			in:     &pb.DeleteVrfRequest{Name: testVrfName},
+			exist:  true,
+			handler: func(ctx context.Context, _ gokv.Store, r *utils.Recorder) (interface{}, error) {
+				_ = r.LinkSetUp(ctx, &netlink.Vrf{LinkAttrs: netlink.LinkAttrs{Name: "blue"}})
+				_ = r.LinkDel(ctx, &netlink.Vrf{LinkAttrs: netlink.LinkAttrs{Name: "blue"}})
+				return nil, status.Error(codes.Unknown, "vrf is busy")
+			},
+			recorded: true,
+			name:     testVrfName,
+			before:   &testVrf,
+			after:    &testVrf,
+			errCode:  codes.Unknown,
+			netlink:  []string{"ip link set blue up"},
+		},
+		"dry run": {
+			method: "/opi_api.network.evpn_gw.v1alpha1.VrfService/DeleteVrf",
+			in:     &pb.DeleteVrfRequest{Name: testVrfName},
+			dryRun: true,
+			handler: func(context.Context, gokv.Store, *utils.Recorder) (interface{}, error) {
+				return &emptypb.Empty{}, nil
+			},
+		},
+		"get": {
+			method: "/opi_api.network.evpn_gw.v1alpha1.VrfService/GetVrf",
+			in:     &pb.GetVrfRequest{Name: testVrfName},
+			exist:  true,
+			handler: func(context.Context, gokv.Store, *utils.Recorder) (interface{}, error) {
+				return &testVrf, nil
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := newTestStore(t)
+			if tt.exist {
+				if err := s.Set(testVrfName, &testVrf); err != nil {
+					t.Fatal(err)
+				}
+			}
+			l := NewLog(s, 10)
+			mockNetlink := mocks.NewNetlink(t)
+			mockNetlink.EXPECT().LinkSetUp(mock.Anything, mock.Anything).Return(nil).Maybe()
+			mockNetlink.EXPECT().LinkDel(mock.Anything, mock.Anything).Return(errors.New("busy")).Maybe()
+			recorder := utils.NewRecorder(mockNetlink, mocks.NewFrr(t))
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(utils.UserHeader, "alice"))
+			if tt.dryRun {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(utils.DryRunHeader, "true"))
+			}
+			handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+				return tt.handler(ctx, s, recorder)
+			}
+			_, _ = l.UnaryServerInterceptor()(ctx, tt.in, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			entries, err := l.entries()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tt.recorded {
+				if len(entries) != 0 {
+					t.Fatal("Expect no entry, received", entries)
+				}
+				return
+			}
+			if len(entries) != 1 {
+				t.Fatal("Expect one entry, received", entries)
+			}
+			entry := entries[0]
+			if entry.Sequence != 1 || entry.Method != tt.method || entry.Name != tt.name || entry.User != "alice" {
+				t.Error("Unexpected entry", entry)
+			}
+			if codes.Code(entry.Code) != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", codes.Code(entry.Code))
+			}
+			checkAny(t, "before", entry.Before.GetValue(), tt.before)
+			checkAny(t, "after", entry.After.GetValue(), tt.after)
+			if fmt.Sprint(entry.NetlinkOperations) != fmt.Sprint(tt.netlink) {
+				t.Error("Expect", tt.netlink, "received", entry.NetlinkOperations)
+			}
+		})
+	}
+}
+
+func checkAny(t *testing.T, field string, value []byte, expected proto.Message) {
+	if expected == nil {
+		if value != nil {
+			t.Error("Expect no", field, "received", value)
+		}
+		return
+	}
+	obj := new(pb.Vrf)
+	if err := proto.Unmarshal(value, obj); err != nil || !proto.Equal(obj, expected) {
+		t.Error("Expect", field, expected, "received", obj, err)
+	}
+}
+
+func Test_NewLog(t *testing.T) {
+	s := newTestStore(t)
+	l := NewLog(s, 2)
+	for i := 0; i < 3; i++ {
+		l.append(&pa.AuditEntry{Method: "/Create"})
+	}
+	// restart continues after the kept entries
+	l = NewLog(s, 2)
+	l.append(&pa.AuditEntry{Method: "/Update"})
+	entries, err := l.entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].Sequence != 3 || entries[1].Sequence != 4 {
+		t.Error("Expect entries 3 and 4, received", entries)
+	}
+	// the entries stay out of the key index of the resources
+	if keys := s.(*store.VersionedStore).Keys(); len(keys) != 0 {
+		t.Error("Expect no indexed keys, received", keys)
+	}
+	// another ring size skips the slots holding other entries
+	l = NewLog(s, 3)
+	l.append(&pa.AuditEntry{Method: "/Delete"})
+	entries, err = l.entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Sequence != 5 {
+		t.Error("Expect entry 5, received", entries)
+	}
+}
+
+func Test_ListAuditEntries(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		in        *pa.ListAuditEntriesRequest
+		sequences []uint64
+		errCode   codes.Code
+		token     bool
+	}{
+		"all": {
+			in:        &pa.ListAuditEntriesRequest{},
+			sequences: []uint64{1, 2, 3},
+			errCode:   codes.OK,
+		},
+		"by name": {
+			in:        &pa.ListAuditEntriesRequest{Name: testVrfName},
+			sequences: []uint64{1, 3},
+			errCode:   codes.OK,
+		},
+		"by subject": {
+			in:        &pa.ListAuditEntriesRequest{Caller: "CN=admin"},
+			sequences: []uint64{2},
+			errCode:   codes.OK,
+		},
+		"by user": {
+			in:        &pa.ListAuditEntriesRequest{Caller: "alice"},
+			sequences: []uint64{1, 3},
+			errCode:   codes.OK,
+		},
+		"by time": {
+			in: &pa.ListAuditEntriesRequest{
+				StartTime: timestamppb.New(start.Add(time.Minute)),
+				EndTime:   timestamppb.New(start.Add(2 * time.Minute)),
+			},
+			sequences: []uint64{2},
+			errCode:   codes.OK,
+		},
+		"pagination": {
+			in:        &pa.ListAuditEntriesRequest{PageSize: 2},
+			sequences: []uint64{1, 2},
+			errCode:   codes.OK,
+			token:     true,
+		},
+		"end before start": {
+			in: &pa.ListAuditEntriesRequest{
+				StartTime: timestamppb.New(start.Add(time.Minute)),
+				EndTime:   timestamppb.New(start),
+			},
+			errCode: codes.InvalidArgument,
+		},
+		"unknown page token": {
+			in:      &pa.ListAuditEntriesRequest{PageToken: "unknown"},
+			errCode: codes.NotFound,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			l := NewLog(newTestStore(t), 10)
+			l.append(&pa.AuditEntry{Name: testVrfName, User: "alice", Time: timestamppb.New(start)})
+			l.append(&pa.AuditEntry{Subject: "CN=admin", Time: timestamppb.New(start.Add(time.Minute))})
+			l.append(&pa.AuditEntry{Name: testVrfName, User: "alice", Time: timestamppb.New(start.Add(2 * time.Minute))})
+
+			response, err := NewServer(l).ListAuditEntries(context.Background(), tt.in)
+			if status.Code(err) != tt.errCode {
+				t.Fatal("error code: expected", tt.errCode, "received", err)
+			}
+			if err != nil {
+				return
+			}
+			var sequences []uint64
+			for _, entry := range response.Entries {
+				sequences = append(sequences, entry.Sequence)
+			}
+			if fmt.Sprint(sequences) != fmt.Sprint(tt.sequences) {
+				t.Error("Expect", tt.sequences, "received", sequences)
+			}
+			if (response.NextPageToken != "") != tt.token {
+				t.Error("Expect next page token", tt.token, "received", response.NextPageToken)
+			}
+		})
+	}
+}
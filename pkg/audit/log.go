@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package audit keeps an append-only trail of the configuration changes
+package audit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/philippgille/gokv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+	"github.com/opiproject/opi-evpn-bridge/pkg/watch"
+)
+
+// keyPrefix is prepended to the slots of the entries and to headKey. It is
+// outside the resource names, so the entries stay out of the key index.
+const keyPrefix = "opi-evpn-bridge/audit/"
+
+// headKey holds the sequence number of the last entry
+const headKey = keyPrefix + "head"
+
+// mutatingPrefixes are the method name prefixes of the calls changing the configuration
+var mutatingPrefixes = []string{"Create", "Update", "Delete", "Import", "Apply", "Batch"}
+
+// Log appends an entry for every mutating call to a ring of maxEntries slots
+// in the store, so every call writes a constant amount of data and the new
+// entries overwrite the oldest
+type Log struct {
+	store      gokv.Store
+	maxEntries int
+	// mu orders the entries, so sequence numbers and slots advance together
+	mu       sync.Mutex
+	sequence uint64
+}
+
+// NewLog creates Log continuing after the entries already in the store
+func NewLog(store gokv.Store, maxEntries int) *Log {
+	if store == nil {
+		log.Panic("nil for Store is not allowed")
+	}
+	if maxEntries < 1 {
+		log.Panicf("audit log has to keep at least one entry, not %d", maxEntries)
+	}
+	l := &Log{store: store, maxEntries: maxEntries}
+	head := new(wrapperspb.UInt64Value)
+	if _, err := store.Get(headKey, head); err != nil {
+		log.Printf("Failed to read last audit entry, starting a new trail: %v", err)
+	}
+	l.sequence = head.GetValue()
+	return l
+}
+
+// slotKey returns the key of the slot holding the entry with the sequence number
+func (l *Log) slotKey(sequence uint64) string {
+	return fmt.Sprintf("%s%d", keyPrefix, sequence%uint64(l.maxEntries))
+}
+
+// UnaryServerInterceptor records every mutating call, including failed ones,
+// since they might have changed the system before failing. Dry runs are not
+// recorded, since they change nothing.
+func (l *Log) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !isMutating(info.FullMethod) || utils.IsDryRun(ctx) {
+			return handler(ctx, req)
+		}
+		entry := &pa.AuditEntry{
+			Time:    timestamppb.Now(),
+			Subject: utils.PeerSubject(ctx),
+			User:    utils.ClaimedUser(ctx),
+			Peer:    utils.PeerAddress(ctx),
+			Method:  info.FullMethod,
+		}
+		if msg, ok := req.(proto.Message); ok {
+			entry.Request = toAny(msg)
+			// names of created resources are only known from the response
+			if !strings.Contains(info.FullMethod, "/Create") {
+				entry.Name = resourceName(msg)
+			}
+		}
+		entry.Before = l.load(entry.Name)
+
+		ctx, actions := utils.WithActions(ctx)
+		response, err := handler(ctx, req)
+
+		if msg, ok := response.(proto.Message); ok && entry.Name == "" {
+			entry.Name = resourceName(msg)
+		}
+		entry.After = l.load(entry.Name)
+		entry.Code = int32(status.Code(err))
+		if err != nil {
+			entry.Error = status.Convert(err).Message()
+		}
+		entry.NetlinkOperations = actions.NetlinkOperations()
+		entry.FrrCommands = actions.FrrCommands()
+		l.append(entry)
+		return response, err
+	}
+}
+
+// append stores the entry, failures are logged and never fail the call,
+// since the change itself already happened
+func (l *Log) append(entry *pa.AuditEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sequence++
+	entry.Sequence = l.sequence
+	if err := l.store.Set(l.slotKey(l.sequence), entry); err != nil {
+		log.Printf("Failed to store audit entry %d of %s: %v", entry.Sequence, entry.Method, err)
+		return
+	}
+	if err := l.store.Set(headKey, wrapperspb.UInt64(l.sequence)); err != nil {
+		log.Printf("Failed to store last audit entry %d: %v", l.sequence, err)
+	}
+}
+
+// entries returns the kept entries, oldest first. Slots written with another
+// maxEntries hold other entries and are skipped.
+func (l *Log) entries() ([]*pa.AuditEntry, error) {
+	l.mu.Lock()
+	last := l.sequence
+	l.mu.Unlock()
+	first := uint64(1)
+	if last > uint64(l.maxEntries) {
+		first = last - uint64(l.maxEntries) + 1
+	}
+	entries := make([]*pa.AuditEntry, 0, last-first+1)
+	for sequence := first; sequence <= last; sequence++ {
+		entry := new(pa.AuditEntry)
+		found, err := l.store.Get(l.slotKey(sequence), entry)
+		if err != nil {
+			return nil, err
+		}
+		if found && entry.Sequence == sequence {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+// load returns the stored resource, or nil when it does not exist
+func (l *Log) load(name string) *anypb.Any {
+	obj := watch.NewResource(name)
+	if obj == nil {
+		return nil
+	}
+	found, err := l.store.Get(name, obj)
+	if err != nil {
+		log.Printf("Failed to read %s for audit entry: %v", name, err)
+		return nil
+	}
+	if !found {
+		return nil
+	}
+	return toAny(obj)
+}
+
+func isMutating(fullMethod string) bool {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range mutatingPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceName returns the name field of the message, or of the first
+// resource it contains like in update requests
+func resourceName(msg proto.Message) string {
+	if named, ok := msg.(interface{ GetName() string }); ok {
+		return named.GetName()
+	}
+	name := ""
+	msg.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+			return true
+		}
+		if named, ok := v.Message().Interface().(interface{ GetName() string }); ok {
+			name = named.GetName()
+			return false
+		}
+		return true
+	})
+	return name
+}
+
+func toAny(msg proto.Message) *anypb.Any {
+	a, err := anypb.New(msg)
+	if err != nil {
+		log.Printf("Failed to wrap %T for audit entry: %v", msg, err)
+		return nil
+	}
+	return a
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package audit keeps an append-only trail of the configuration changes
+package audit
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/uuid"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+)
+
+// Server represents the Server object
+type Server struct {
+	pa.UnimplementedAuditServiceServer
+	Pagination map[string]int
+	log        *Log
+}
+
+// NewServer creates initialized instance of Audit server
+func NewServer(auditLog *Log) *Server {
+	if auditLog == nil {
+		log.Panic("nil for Log is not allowed")
+	}
+	return &Server{
+		Pagination: make(map[string]int),
+		log:        auditLog,
+	}
+}
+
+// ListAuditEntries lists the matching entries oldest first
+func (s *Server) ListAuditEntries(_ context.Context, in *pa.ListAuditEntriesRequest) (*pa.ListAuditEntriesResponse, error) {
+	// check input correctness
+	if err := validateListAuditEntriesRequest(in); err != nil {
+		return nil, err
+	}
+	// fetch pagination from the database, calculate size and offset
+	size, offset, perr := utils.ExtractPagination(in.PageSize, in.PageToken, s.Pagination)
+	if perr != nil {
+		return nil, perr
+	}
+	entries, err := s.log.entries()
+	if err != nil {
+		log.Printf("Failed to interact with store: %v", err)
+		return nil, err
+	}
+	matching := []*pa.AuditEntry{}
+	for _, entry := range entries {
+		if matches(in, entry) {
+			matching = append(matching, entry)
+		}
+	}
+	log.Printf("Limiting result len(%d) to [%d:%d]", len(matching), offset, size)
+	matching, hasMoreElements := utils.LimitPagination(matching, offset, size)
+	token := ""
+	if hasMoreElements {
+		token = uuid.New().String()
+		s.Pagination[token] = offset + size
+	}
+	return &pa.ListAuditEntriesResponse{Entries: matching, NextPageToken: token}, nil
+}
+
+func validateListAuditEntriesRequest(in *pa.ListAuditEntriesRequest) error {
+	if in.StartTime != nil {
+		if err := in.StartTime.CheckValid(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid start_time: %v", err)
+		}
+	}
+	if in.EndTime != nil {
+		if err := in.EndTime.CheckValid(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid end_time: %v", err)
+		}
+	}
+	if in.StartTime != nil && in.EndTime != nil && !in.StartTime.AsTime().Before(in.EndTime.AsTime()) {
+		msg := "start_time must be before end_time"
+		return status.Error(codes.InvalidArgument, msg)
+	}
+	return nil
+}
+
+func matches(in *pa.ListAuditEntriesRequest, entry *pa.AuditEntry) bool {
+	if in.Name != "" && entry.Name != in.Name {
+		return false
+	}
+	if in.Caller != "" && entry.Subject != in.Caller && entry.User != in.Caller {
+		return false
+	}
+	if in.StartTime != nil && entry.Time.AsTime().Before(in.StartTime.AsTime()) {
+		return false
+	}
+	if in.EndTime != nil && !entry.Time.AsTime().Before(in.EndTime.AsTime()) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package bridge is the main package of the application
+package bridge
+
+import (
+	"context"
+	"fmt"
+
+	"go.einride.tech/aip/resourceid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+)
+
+// BatchCreateLogicalBridges validates all requests, then creates the
+// LogicalBridges through a single netlink socket
+func (s *Server) BatchCreateLogicalBridges(ctx context.Context, in *pa.BatchCreateLogicalBridgesRequest) (*pa.BatchCreateLogicalBridgesResponse, error) {
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.BatchCreateLogicalBridges(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
+	created := make([]*pb.LogicalBridge, len(in.Requests))
+	steps := make([]utils.BatchStep, len(in.Requests))
+	names := make(map[string]bool)
+	vnis := make(map[uint32]bool)
+	for i, req := range in.Requests {
+		i, req := i, req
+		if req.GetLogicalBridgeId() != "" {
+			steps[i].Name = resourceIDToFullName(req.LogicalBridgeId)
+		}
+		steps[i].Invalid = s.ValidateCreateLogicalBridgeRequest(req)
+		if steps[i].Invalid != nil {
+			continue
+		}
+		// name system generated ids now, so every request has a name to report
+		if req.LogicalBridgeId == "" {
+			req.LogicalBridgeId = resourceid.NewSystemGenerated()
+		}
+		steps[i].Name = resourceIDToFullName(req.LogicalBridgeId)
+		steps[i].Invalid = utils.CheckBatchDuplicate(names, steps[i].Name)
+		if steps[i].Invalid == nil && req.LogicalBridge.Spec.Vni != nil {
+			if vnis[*req.LogicalBridge.Spec.Vni] {
+				steps[i].Invalid = status.Errorf(codes.InvalidArgument, "vni %d is used by more than one request of the batch", *req.LogicalBridge.Spec.Vni)
+			}
+			vnis[*req.LogicalBridge.Spec.Vni] = true
+		}
+		steps[i].Apply = func(ctx context.Context) (func(context.Context) error, error) {
+			obj, isNew, err := s.createLogicalBridge(ctx, req)
+			created[i] = obj
+			if err != nil || !isNew {
+				return nil, err
+			}
+			return func(ctx context.Context) error { return s.deleteLogicalBridge(ctx, obj) }, nil
+		}
+	}
+	// serialize writers, so other calls do not interleave with the batch
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	results, err := utils.RunBatch(ctx, steps, in.AllOrNothing)
+	if err != nil {
+		return nil, err
+	}
+	response := &pa.BatchCreateLogicalBridgesResponse{Results: results}
+	for _, obj := range created {
+		if obj != nil {
+			response.LogicalBridges = append(response.LogicalBridges, obj)
+		}
+	}
+	return response, nil
+}
+
+// BatchDeleteLogicalBridges validates all requests, then deletes the
+// LogicalBridges through a single netlink socket. Etags are not checked.
+func (s *Server) BatchDeleteLogicalBridges(ctx context.Context, in *pa.BatchDeleteLogicalBridgesRequest) (*pa.BatchDeleteLogicalBridgesResponse, error) {
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.BatchDeleteLogicalBridges(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
+	// serialize writers, so the validated objects stay as they are
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	steps := make([]utils.BatchStep, len(in.Requests))
+	names := make(map[string]bool)
+	for i, req := range in.Requests {
+		steps[i].Name = req.GetName()
+		steps[i].Invalid = s.validateDeleteLogicalBridgeRequest(req)
+		if steps[i].Invalid == nil {
+			steps[i].Invalid = utils.CheckBatchDuplicate(names, req.Name)
+		}
+		if steps[i].Invalid != nil {
+			continue
+		}
+		// fetch object from the database
+		obj := new(pb.LogicalBridge)
+		ok, err := s.store.Get(req.Name, obj)
+		switch {
+		case err != nil:
+			fmt.Printf("Failed to interact with store: %v", err)
+			steps[i].Invalid = err
+		case !ok && !req.AllowMissing:
+			steps[i].Invalid = status.Errorf(codes.NotFound, "unable to find key %s", req.Name)
+		case !ok:
+			steps[i].Apply = func(context.Context) (func(context.Context) error, error) { return nil, nil }
+		default:
+			steps[i].Apply = func(ctx context.Context) (func(context.Context) error, error) {
+				if err := s.deleteLogicalBridge(ctx, obj); err != nil {
+					return nil, err
+				}
+				return func(ctx context.Context) error { return s.restoreLogicalBridge(ctx, obj) }, nil
+			}
+		}
+	}
+	results, err := utils.RunBatch(ctx, steps, in.AllOrNothing)
+	if err != nil {
+		return nil, err
+	}
+	return &pa.BatchDeleteLogicalBridgesResponse{Results: results}, nil
+}
+
+// restoreLogicalBridge configures and stores a deleted LogicalBridge again
+func (s *Server) restoreLogicalBridge(ctx context.Context, obj *pb.LogicalBridge) error {
+	in := &pb.CreateLogicalBridgeRequest{LogicalBridge: utils.ProtoClone(obj)}
+	if err := s.netlinkCreateLogicalBridge(ctx, in); err != nil {
+		return err
+	}
+	if err := s.frrCreateLogicalBridge(ctx, obj); err != nil {
+		return err
+	}
+	s.ListHelper[obj.Name] = false
+	return s.store.Set(obj.Name, obj)
+}
@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package bridge is the main package of the application
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/vishvananda/netlink"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils/mocks"
+)
+
+// plainBridge is a LogicalBridge without VNI, which needs no netlink calls
+func plainBridge(vlanID uint32) *pb.LogicalBridge {
+	return &pb.LogicalBridge{Spec: &pb.LogicalBridgeSpec{VlanId: vlanID}}
+}
+
+func resultCodes(results []*pa.BatchResult) []codes.Code {
+	var out []codes.Code
+	for _, r := range results {
+		out = append(out, codes.Code(r.Code))
+	}
+	return out
+}
+
+func Test_BatchCreateLogicalBridges(t *testing.T) {
+	tests := map[string]struct {
+		in           []*pb.CreateLogicalBridgeRequest
+		allOrNothing bool
+		exist        bool
+		results      []codes.Code
+		errCode      codes.Code
+		stored       []string
+		on           func(mockNetlink *mocks.Netlink)
+	}{
+		"all created": {
+			in: []*pb.CreateLogicalBridgeRequest{
+				{LogicalBridgeId: "bridge-a", LogicalBridge: plainBridge(10)},
+				{LogicalBridgeId: "bridge-b", LogicalBridge: plainBridge(20)},
+			},
+			results: []codes.Code{codes.OK, codes.OK},
+			errCode: codes.OK,
+			stored:  []string{resourceIDToFullName("bridge-a"), resourceIDToFullName("bridge-b")},
+		},
+		"already existing kept": {
+			in: []*pb.CreateLogicalBridgeRequest{
+				{LogicalBridgeId: testLogicalBridgeID, LogicalBridge: &testLogicalBridge},
+				{LogicalBridgeId: "bridge-b", LogicalBridge: plainBridge(20)},
+			},
+			exist:   true,
+			results: []codes.Code{codes.OK, codes.OK},
+			errCode: codes.OK,
+			stored:  []string{testLogicalBridgeName, resourceIDToFullName("bridge-b")},
+		},
+		"invalid request reported": {
+			in: []*pb.CreateLogicalBridgeRequest{
+				{LogicalBridgeId: "bridge-a", LogicalBridge: plainBridge(10)},
+				{LogicalBridgeId: "bridge-b", LogicalBridge: plainBridge(4096)},
+			},
+			results: []codes.Code{codes.OK, codes.InvalidArgument},
+			errCode: codes.OK,
+			stored:  []string{resourceIDToFullName("bridge-a")},
+		},
+		"invalid request fails all or nothing": {
+			in: []*pb.CreateLogicalBridgeRequest{
+				{LogicalBridgeId: "bridge-a", LogicalBridge: plainBridge(10)},
+				{LogicalBridgeId: "bridge-b", LogicalBridge: plainBridge(4096)},
+			},
+			allOrNothing: true,
+			errCode:      codes.InvalidArgument,
+		},
+		"duplicate name": {
+			in: []*pb.CreateLogicalBridgeRequest{
+				{LogicalBridgeId: "bridge-a", LogicalBridge: plainBridge(10)},
+				{LogicalBridgeId: "bridge-a", LogicalBridge: plainBridge(20)},
+			},
+			results: []codes.Code{codes.OK, codes.InvalidArgument},
+			errCode: codes.OK,
+			stored:  []string{resourceIDToFullName("bridge-a")},
+		},
+		"duplicate vni": {
+			in: []*pb.CreateLogicalBridgeRequest{
+				{LogicalBridgeId: "bridge-a", LogicalBridge: &pb.LogicalBridge{Spec: &pb.LogicalBridgeSpec{VlanId: 10, Vni: proto.Uint32(11)}}},
+				{LogicalBridgeId: "bridge-b", LogicalBridge: &pb.LogicalBridge{Spec: &pb.LogicalBridgeSpec{VlanId: 20, Vni: proto.Uint32(11)}}},
+			},
+			allOrNothing: true,
+			errCode:      codes.InvalidArgument,
+		},
+		"failure undoes applied requests": {
+			in: []*pb.CreateLogicalBridgeRequest{
+				{LogicalBridgeId: "bridge-a", LogicalBridge: plainBridge(10)},
+				{LogicalBridgeId: testLogicalBridgeID, LogicalBridge: &testLogicalBridge},
+			},
+			allOrNothing: true,
+			errCode:      codes.NotFound,
+			on: func(mockNetlink *mocks.Netlink) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, tenantbridgeName).Return(nil, errors.New("Failed to call LinkByName")).Once()
+			},
+		},
+		"failure reported": {
+			in: []*pb.CreateLogicalBridgeRequest{
+				{LogicalBridgeId: testLogicalBridgeID, LogicalBridge: &testLogicalBridge},
+				{LogicalBridgeId: "bridge-a", LogicalBridge: plainBridge(10)},
+			},
+			results: []codes.Code{codes.NotFound, codes.OK},
+			errCode: codes.OK,
+			stored:  []string{resourceIDToFullName("bridge-a")},
+			on: func(mockNetlink *mocks.Netlink) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, tenantbridgeName).Return(nil, errors.New("Failed to call LinkByName")).Once()
+			},
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pa.NewLogicalBridgeBatchServiceClient(env.conn)
+
+			if tt.exist {
+				_ = env.opi.store.Set(testLogicalBridgeName, &testLogicalBridgeWithStatus)
+				env.opi.ListHelper[testLogicalBridgeName] = false
+			}
+			if tt.on != nil {
+				tt.on(env.mockNetlink)
+			}
+
+			request := &pa.BatchCreateLogicalBridgesRequest{Requests: tt.in, AllOrNothing: tt.allOrNothing}
+			response, err := client.BatchCreateLogicalBridges(ctx, request)
+			if status.Code(err) != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", err)
+			}
+			if got := resultCodes(response.GetResults()); fmt.Sprint(got) != fmt.Sprint(tt.results) {
+				t.Error("results: expected", tt.results, "received", got)
+			}
+			if len(response.GetLogicalBridges()) != len(tt.stored) {
+				t.Error("response: expected", len(tt.stored), "bridges, received", response.GetLogicalBridges())
+			}
+			if len(env.opi.ListHelper) != len(tt.stored) {
+				t.Error("stored: expected", tt.stored, "received", env.opi.ListHelper)
+			}
+			for _, name := range tt.stored {
+				if ok, _ := env.opi.store.Get(name, new(pb.LogicalBridge)); !ok {
+					t.Error("expected", name, "to be stored")
+				}
+			}
+		})
+	}
+}
+
+func Test_BatchDeleteLogicalBridges(t *testing.T) {
+	tests := map[string]struct {
+		in           []*pb.DeleteLogicalBridgeRequest
+		allOrNothing bool
+		results      []codes.Code
+		errCode      codes.Code
+		stored       []string
+		on           func(mockNetlink *mocks.Netlink)
+	}{
+		"all deleted": {
+			in: []*pb.DeleteLogicalBridgeRequest{
+				{Name: resourceIDToFullName("bridge-a")},
+				{Name: resourceIDToFullName("missing"), AllowMissing: true},
+			},
+			results: []codes.Code{codes.OK, codes.OK},
+			errCode: codes.OK,
+			stored:  []string{testLogicalBridgeName},
+		},
+		"missing reported": {
+			in: []*pb.DeleteLogicalBridgeRequest{
+				{Name: resourceIDToFullName("missing")},
+				{Name: resourceIDToFullName("bridge-a")},
+			},
+			results: []codes.Code{codes.NotFound, codes.OK},
+			errCode: codes.OK,
+			stored:  []string{testLogicalBridgeName},
+		},
+		"missing fails all or nothing": {
+			in: []*pb.DeleteLogicalBridgeRequest{
+				{Name: resourceIDToFullName("bridge-a")},
+				{Name: resourceIDToFullName("missing")},
+			},
+			allOrNothing: true,
+			errCode:      codes.NotFound,
+			stored:       []string{testLogicalBridgeName, resourceIDToFullName("bridge-a")},
+		},
+		"failure restores deleted": {
+			in: []*pb.DeleteLogicalBridgeRequest{
+				{Name: resourceIDToFullName("bridge-a")},
+				{Name: testLogicalBridgeName},
+			},
+			allOrNothing: true,
+			errCode:      codes.Unknown,
+			stored:       []string{testLogicalBridgeName, resourceIDToFullName("bridge-a")},
+			on: func(mockNetlink *mocks.Netlink) {
+				vxlan := &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: "vni11"}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni11").Return(vxlan, nil).Once()
+				mockNetlink.EXPECT().LinkSetDown(mock.Anything, vxlan).Return(errors.New("Failed to call LinkSetDown")).Once()
+			},
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pa.NewLogicalBridgeBatchServiceClient(env.conn)
+
+			plain := plainBridge(10)
+			plain.Name = resourceIDToFullName("bridge-a")
+			for _, obj := range []*pb.LogicalBridge{&testLogicalBridgeWithStatus, plain} {
+				_ = env.opi.store.Set(obj.Name, obj)
+				env.opi.ListHelper[obj.Name] = false
+			}
+			if tt.on != nil {
+				tt.on(env.mockNetlink)
+			}
+
+			request := &pa.BatchDeleteLogicalBridgesRequest{Requests: tt.in, AllOrNothing: tt.allOrNothing}
+			response, err := client.BatchDeleteLogicalBridges(ctx, request)
+			if status.Code(err) != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", err)
+			}
+			if got := resultCodes(response.GetResults()); fmt.Sprint(got) != fmt.Sprint(tt.results) {
+				t.Error("results: expected", tt.results, "received", got)
+			}
+			if len(env.opi.ListHelper) != len(tt.stored) {
+				t.Error("stored: expected", tt.stored, "received", env.opi.ListHelper)
+			}
+			for _, name := range tt.stored {
+				if ok, _ := env.opi.store.Get(name, new(pb.LogicalBridge)); !ok {
+					t.Error("expected", name, "to be stored")
+				}
+			}
+		})
+	}
+}
@@ -17,7 +17,9 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/vishvananda/netlink"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -25,11 +27,16 @@ import (
 
 	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
 	pc "github.com/opiproject/opi-api/network/opinetcommon/v1alpha1/gen/go"
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
 
 	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
 	"github.com/opiproject/opi-evpn-bridge/pkg/utils/mocks"
 )
 
+const (
+	tenantbridgeName = "br-tenant"
+)
+
 var (
 	testLogicalBridgeID   = "opi-bridge9"
 	testLogicalBridgeName = resourceIDToFullName(testLogicalBridgeID)
@@ -65,6 +72,7 @@ func Test_CreateLogicalBridge(t *testing.T) {
 		errCode codes.Code
 		errMsg  string
 		exist   bool
+		options *pa.LogicalBridgeOptions
 		on      func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string)
 	}{
 		"illegal resource_id": {
@@ -232,6 +240,54 @@ func Test_CreateLogicalBridge(t *testing.T) {
 			errCode: codes.OK,
 			errMsg:  "",
 			exist:   false,
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				myip := make(net.IP, 4)
+				binary.BigEndian.PutUint32(myip, 167772162)
+				vxlanName := fmt.Sprintf("vni%d", *testLogicalBridge.Spec.Vni)
+				vxlan := &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: vxlanName}, VxlanId: int(*testLogicalBridge.Spec.Vni), Port: 4789, Learning: false, SrcAddr: myip}
+				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: tenantbridgeName}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, tenantbridgeName).Return(bridge, nil).Once()
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, vxlan).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetMaster(mock.Anything, vxlan, bridge).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetUp(mock.Anything, vxlan).Return(nil).Once()
+				vid := uint16(testLogicalBridge.Spec.VlanId)
+				mockNetlink.EXPECT().BridgeVlanAdd(mock.Anything, vxlan, vid, true, true, false, false).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetBrNeighSuppress(mock.Anything, vxlan, true).Return(nil).Once()
+			},
+		},
+		"failed LinkSetBrNeighSuppress call": {
+			id:      testLogicalBridgeID,
+			in:      &testLogicalBridge,
+			out:     nil,
+			errCode: codes.Unknown,
+			errMsg:  "Failed to call LinkSetBrNeighSuppress",
+			exist:   false,
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				myip := make(net.IP, 4)
+				binary.BigEndian.PutUint32(myip, 167772162)
+				vxlanName := fmt.Sprintf("vni%d", *testLogicalBridge.Spec.Vni)
+				vxlan := &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: vxlanName}, VxlanId: int(*testLogicalBridge.Spec.Vni), Port: 4789, Learning: false, SrcAddr: myip}
+				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: tenantbridgeName}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, tenantbridgeName).Return(bridge, nil).Once()
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, vxlan).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetMaster(mock.Anything, vxlan, bridge).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetUp(mock.Anything, vxlan).Return(nil).Once()
+				vid := uint16(testLogicalBridge.Spec.VlanId)
+				mockNetlink.EXPECT().BridgeVlanAdd(mock.Anything, vxlan, vid, true, true, false, false).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetBrNeighSuppress(mock.Anything, vxlan, true).Return(errors.New(errMsg)).Once()
+			},
+		},
+		"neigh_suppress disabled by options": {
+			id:      testLogicalBridgeID,
+			in:      &testLogicalBridge,
+			out:     &testLogicalBridgeWithStatus,
+			errCode: codes.OK,
+			errMsg:  "",
+			exist:   false,
+			options: &pa.LogicalBridgeOptions{
+				Name: optionsName(testLogicalBridgeName),
+				Spec: &pa.LogicalBridgeOptionsSpec{NeighSuppress: proto.Bool(false)},
+			},
 			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
 				myip := make(net.IP, 4)
 				binary.BigEndian.PutUint32(myip, 167772162)
@@ -259,6 +315,9 @@ func Test_CreateLogicalBridge(t *testing.T) {
 			if tt.exist {
 				_ = env.opi.store.Set(testLogicalBridgeName, &testLogicalBridgeWithStatus)
 			}
+			if tt.options != nil {
+				_ = env.opi.store.Set(tt.options.Name, tt.options)
+			}
 			if tt.out != nil {
 				tt.out = utils.ProtoClone(tt.out)
 				tt.out.Name = testLogicalBridgeName
@@ -288,12 +347,24 @@ func Test_CreateLogicalBridge(t *testing.T) {
 }
 
 func Test_DeleteLogicalBridge(t *testing.T) {
+	successfulDelete := func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+		myip := make(net.IP, 4)
+		binary.BigEndian.PutUint32(myip, 167772162)
+		vxlanName := fmt.Sprintf("vni%d", *testLogicalBridge.Spec.Vni)
+		vxlan := &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: vxlanName}, VxlanId: int(*testLogicalBridge.Spec.Vni), Port: 4789, Learning: false, SrcAddr: myip}
+		mockNetlink.EXPECT().LinkByName(mock.Anything, vxlanName).Return(vxlan, nil).Once()
+		mockNetlink.EXPECT().LinkSetDown(mock.Anything, vxlan).Return(nil).Once()
+		vid := uint16(testLogicalBridge.Spec.VlanId)
+		mockNetlink.EXPECT().BridgeVlanDel(mock.Anything, vxlan, vid, true, true, false, false).Return(nil).Once()
+		mockNetlink.EXPECT().LinkDel(mock.Anything, vxlan).Return(nil).Once()
+	}
 	tests := map[string]struct {
 		in      string
 		out     *emptypb.Empty
 		errCode codes.Code
 		errMsg  string
 		missing bool
+		etag    string
 		on      func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string)
 	}{
 		"valid request with unknown key": {
@@ -387,17 +458,25 @@ func Test_DeleteLogicalBridge(t *testing.T) {
 			errCode: codes.OK,
 			errMsg:  "",
 			missing: false,
-			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
-				myip := make(net.IP, 4)
-				binary.BigEndian.PutUint32(myip, 167772162)
-				vxlanName := fmt.Sprintf("vni%d", *testLogicalBridge.Spec.Vni)
-				vxlan := &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: vxlanName}, VxlanId: int(*testLogicalBridge.Spec.Vni), Port: 4789, Learning: false, SrcAddr: myip}
-				mockNetlink.EXPECT().LinkByName(mock.Anything, vxlanName).Return(vxlan, nil).Once()
-				mockNetlink.EXPECT().LinkSetDown(mock.Anything, vxlan).Return(nil).Once()
-				vid := uint16(testLogicalBridge.Spec.VlanId)
-				mockNetlink.EXPECT().BridgeVlanDel(mock.Anything, vxlan, vid, true, true, false, false).Return(nil).Once()
-				mockNetlink.EXPECT().LinkDel(mock.Anything, vxlan).Return(nil).Once()
-			},
+			on:      successfulDelete,
+		},
+		"stale etag": {
+			in:      testLogicalBridgeID,
+			out:     nil,
+			errCode: codes.Aborted,
+			errMsg:  fmt.Sprintf("etag %v does not match the current etag %v of %v, re-read the resource and retry", "0123456789abcdef", utils.Etag(&testLogicalBridgeWithStatus), testLogicalBridgeName),
+			missing: false,
+			etag:    "0123456789abcdef",
+			on:      nil,
+		},
+		"current etag": {
+			in:      testLogicalBridgeID,
+			out:     &emptypb.Empty{},
+			errCode: codes.OK,
+			errMsg:  "",
+			missing: false,
+			etag:    utils.Etag(&testLogicalBridgeWithStatus),
+			on:      successfulDelete,
 		},
 	}
 
@@ -416,6 +495,10 @@ func Test_DeleteLogicalBridge(t *testing.T) {
 				tt.on(env.mockNetlink, env.mockFrr, tt.errMsg)
 			}
 
+			if tt.etag != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, utils.EtagHeader, tt.etag)
+			}
+
 			request := &pb.DeleteLogicalBridgeRequest{Name: fname1, AllowMissing: tt.missing}
 			response, err := client.DeleteLogicalBridge(ctx, request)
 
@@ -442,6 +525,12 @@ func Test_UpdateLogicalBridge(t *testing.T) {
 		Vni:    proto.Uint32(11),
 		VlanId: 22,
 	}
+	successfulUpdate := func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+		vxlanName := fmt.Sprintf("vni%d", *testLogicalBridge.Spec.Vni)
+		vxlan := &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: vxlanName}, VxlanId: int(*testLogicalBridge.Spec.Vni)}
+		mockNetlink.EXPECT().LinkByName(mock.Anything, vxlanName).Return(vxlan, nil).Once()
+		mockNetlink.EXPECT().LinkModify(mock.Anything, vxlan).Return(nil).Once()
+	}
 	tests := map[string]struct {
 		mask    *fieldmaskpb.FieldMask
 		in      *pb.LogicalBridge
@@ -450,6 +539,8 @@ func Test_UpdateLogicalBridge(t *testing.T) {
 		errMsg  string
 		start   bool
 		exist   bool
+		etag    string
+		on      func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string)
 	}{
 		"invalid fieldmask": {
 			mask: &fieldmaskpb.FieldMask{Paths: []string{"*", "author"}},
@@ -475,6 +566,46 @@ func Test_UpdateLogicalBridge(t *testing.T) {
 			start:   false,
 			exist:   true,
 		},
+		"stale etag": {
+			mask: nil,
+			in: &pb.LogicalBridge{
+				Name: testLogicalBridgeName,
+				Spec: spec,
+			},
+			out:     nil,
+			errCode: codes.Aborted,
+			errMsg:  fmt.Sprintf("etag %v does not match the current etag %v of %v, re-read the resource and retry", "0123456789abcdef", utils.Etag(&testLogicalBridgeWithStatus), testLogicalBridgeName),
+			start:   false,
+			exist:   true,
+			etag:    "0123456789abcdef",
+		},
+		"current etag": {
+			mask: nil,
+			in: &pb.LogicalBridge{
+				Name: testLogicalBridgeName,
+				Spec: spec,
+			},
+			out:     &pb.LogicalBridge{Spec: spec, Status: &pb.LogicalBridgeStatus{OperStatus: pb.LBOperStatus_LB_OPER_STATUS_UP}},
+			errCode: codes.OK,
+			errMsg:  "",
+			start:   false,
+			exist:   true,
+			etag:    utils.Etag(&testLogicalBridgeWithStatus),
+			on:      successfulUpdate,
+		},
+		"missing etag": {
+			mask: nil,
+			in: &pb.LogicalBridge{
+				Name: testLogicalBridgeName,
+				Spec: spec,
+			},
+			out:     &pb.LogicalBridge{Spec: spec, Status: &pb.LogicalBridgeStatus{OperStatus: pb.LBOperStatus_LB_OPER_STATUS_UP}},
+			errCode: codes.OK,
+			errMsg:  "",
+			start:   false,
+			exist:   true,
+			on:      successfulUpdate,
+		},
 	}
 
 	// run tests
@@ -493,8 +624,19 @@ func Test_UpdateLogicalBridge(t *testing.T) {
 				tt.out.Name = testLogicalBridgeName
 			}
 
+			if tt.on != nil {
+				tt.on(env.mockNetlink, env.mockFrr, tt.errMsg)
+			}
+			if tt.etag != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, utils.EtagHeader, tt.etag)
+			}
+
+			var header metadata.MD
 			request := &pb.UpdateLogicalBridgeRequest{LogicalBridge: tt.in, UpdateMask: tt.mask}
-			response, err := client.UpdateLogicalBridge(ctx, request)
+			response, err := client.UpdateLogicalBridge(ctx, request, grpc.Header(&header))
+			if etags := header.Get(utils.EtagHeader); tt.errCode == codes.OK && (len(etags) == 0 || etags[0] != utils.Etag(tt.out)) {
+				t.Error("etag: expected", utils.Etag(tt.out), "received", header.Get(utils.EtagHeader))
+			}
 			if !proto.Equal(tt.out, response) {
 				t.Error("response: expected", tt.out, "received", response)
 			}
@@ -18,6 +18,7 @@ import (
 	"google.golang.org/grpc/test/bufconn"
 
 	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
 	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
 	"github.com/opiproject/opi-evpn-bridge/pkg/utils/mocks"
 )
@@ -36,10 +37,6 @@ func resourceIDToFullName(resourceID string) string {
 }
 
 // TODO: move all of this to a common place
-const (
-	tenantbridgeName = "br-tenant"
-)
-
 type testEnv struct {
 	mockNetlink *mocks.Netlink
 	mockFrr     *mocks.Frr
@@ -76,6 +73,8 @@ func dialer(opi *Server) func(context.Context, string) (net.Conn, error) {
 	server := grpc.NewServer()
 
 	pb.RegisterLogicalBridgeServiceServer(server, opi)
+	pa.RegisterLogicalBridgeBatchServiceServer(server, opi)
+	pa.RegisterLogicalBridgeOptionsServiceServer(server, opi)
 
 	go func() {
 		if err := server.Serve(listener); err != nil {
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package bridge is the main package of the application
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+)
+
+// frrCreateLogicalBridge sets the route distinguisher and route targets of the
+// L2 VNI from the options of the LogicalBridge, FRR derives them when not set
+func (s *Server) frrCreateLogicalBridge(ctx context.Context, obj *pb.LogicalBridge) error {
+	if obj.Spec.Vni == nil {
+		return nil
+	}
+	options, err := s.loadOptions(optionsName(obj.Name))
+	if err != nil {
+		return err
+	}
+	return s.frrUpdateEvpn(ctx, obj, nil, options.Spec.GetEvpn())
+}
+
+// frrDeleteLogicalBridge removes the options of the L2 VNI, which FRR keeps otherwise
+func (s *Server) frrDeleteLogicalBridge(ctx context.Context, obj *pb.LogicalBridge) error {
+	if obj.Spec.Vni == nil {
+		return nil
+	}
+	options, err := s.loadOptions(optionsName(obj.Name))
+	if err != nil {
+		return err
+	}
+	if len(utils.EvpnCommands(nil, options.Spec.GetEvpn())) == 0 {
+		return nil
+	}
+	data, err := s.frr.FrrBgpCmd(ctx, fmt.Sprintf(
+		`configure terminal
+			router bgp %d
+			address-family l2vpn evpn
+				no vni %d
+				exit-address-family
+			exit`, config.GetConfig().Frr.LocalAS, *obj.Spec.Vni))
+	fmt.Printf("FrrBgpCmd: %v:%v", data, err)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// frrUpdateEvpn changes the route distinguisher and route targets of the L2 VNI of a LogicalBridge
+func (s *Server) frrUpdateEvpn(ctx context.Context, obj *pb.LogicalBridge, oldEvpn, evpn *pa.EvpnOptions) error {
+	commands := utils.EvpnCommands(oldEvpn, evpn)
+	if len(commands) == 0 {
+		return nil
+	}
+	var lines strings.Builder
+	for _, command := range commands {
+		fmt.Fprintf(&lines, "\n\t\t\t\t\t%s", command)
+	}
+	data, err := s.frr.FrrBgpCmd(ctx, fmt.Sprintf(
+		`configure terminal
+			router bgp %d
+			address-family l2vpn evpn
+				vni %d%s
+					exit-vni
+				exit-address-family
+			exit`, config.GetConfig().Frr.LocalAS, *obj.Spec.Vni, lines.String()))
+	fmt.Printf("FrrBgpCmd: %v:%v", data, err)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// frrReadEvpn reads the route distinguisher and route targets of the L2 VNI in effect
+func (s *Server) frrReadEvpn(ctx context.Context, vni uint32) (*pa.EvpnOptions, error) {
+	data, err := s.frr.FrrBgpCmd(ctx, utils.EvpnShowCommand(vni))
+	if err != nil {
+		return nil, err
+	}
+	return utils.EvpnOptionsOf(data)
+}
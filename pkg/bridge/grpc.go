@@ -27,9 +27,27 @@ import (
 // CreateLogicalBridge executes the creation of the LogicalBridge
 func (s *Server) CreateLogicalBridge(ctx context.Context, in *pb.CreateLogicalBridgeRequest) (*pb.LogicalBridge, error) {
 	// check input correctness
-	if err := s.validateCreateLogicalBridgeRequest(in); err != nil {
+	if err := s.ValidateCreateLogicalBridgeRequest(in); err != nil {
 		return nil, err
 	}
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.CreateLogicalBridge(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
+	response, _, err := s.createLogicalBridge(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	utils.SendEtag(ctx, response)
+	return response, nil
+}
+
+// createLogicalBridge configures and stores the LogicalBridge, unless it already
+// exists, and tells whether it was created
+func (s *Server) createLogicalBridge(ctx context.Context, in *pb.CreateLogicalBridgeRequest) (*pb.LogicalBridge, bool, error) {
 	// see https://google.aip.dev/133#user-specified-ids
 	resourceID := resourceid.NewSystemGenerated()
 	if in.LogicalBridgeId != "" {
@@ -42,15 +60,19 @@ func (s *Server) CreateLogicalBridge(ctx context.Context, in *pb.CreateLogicalBr
 	ok, err := s.store.Get(in.LogicalBridge.Name, obj)
 	if err != nil {
 		fmt.Printf("Failed to interact with store: %v", err)
-		return nil, err
+		return nil, false, err
 	}
 	if ok {
 		log.Printf("Already existing LogicalBridge with id %v", in.LogicalBridge.Name)
-		return obj, nil
+		return obj, false, nil
 	}
 	// configure netlink
 	if err := s.netlinkCreateLogicalBridge(ctx, in); err != nil {
-		return nil, err
+		return nil, false, err
+	}
+	// configure FRR
+	if err := s.frrCreateLogicalBridge(ctx, in.LogicalBridge); err != nil {
+		return nil, false, err
 	}
 	// translate object
 	response := utils.ProtoClone(in.LogicalBridge)
@@ -60,9 +82,9 @@ func (s *Server) CreateLogicalBridge(ctx context.Context, in *pb.CreateLogicalBr
 	s.ListHelper[in.LogicalBridge.Name] = false
 	err = s.store.Set(in.LogicalBridge.Name, response)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
-	return response, nil
+	return response, true, nil
 }
 
 // DeleteLogicalBridge deletes a LogicalBridge
@@ -71,6 +93,16 @@ func (s *Server) DeleteLogicalBridge(ctx context.Context, in *pb.DeleteLogicalBr
 	if err := s.validateDeleteLogicalBridgeRequest(in); err != nil {
 		return nil, err
 	}
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.DeleteLogicalBridge(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
+	// serialize writers, so the etag check and the write are atomic
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// fetch object from the database
 	obj := new(pb.LogicalBridge)
 	ok, err := s.store.Get(in.Name, obj)
@@ -85,25 +117,47 @@ func (s *Server) DeleteLogicalBridge(ctx context.Context, in *pb.DeleteLogicalBr
 		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
 		return nil, err
 	}
-	// configure netlink
-	if err := s.netlinkDeleteLogicalBridge(ctx, obj); err != nil {
+	// reject stale etag, see https://google.aip.dev/154
+	if err := utils.CheckEtag(ctx, obj); err != nil {
 		return nil, err
 	}
-	// remove from the Database
-	delete(s.ListHelper, obj.Name)
-	err = s.store.Delete(obj.Name)
-	if err != nil {
+	if err := s.deleteLogicalBridge(ctx, obj); err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
+// deleteLogicalBridge removes the stored LogicalBridge from netlink and the store
+func (s *Server) deleteLogicalBridge(ctx context.Context, obj *pb.LogicalBridge) error {
+	// configure netlink
+	if err := s.netlinkDeleteLogicalBridge(ctx, obj); err != nil {
+		return err
+	}
+	// delete from FRR
+	if err := s.frrDeleteLogicalBridge(ctx, obj); err != nil {
+		return err
+	}
+	// remove from the Database
+	delete(s.ListHelper, obj.Name)
+	return s.store.Delete(obj.Name)
+}
+
 // UpdateLogicalBridge updates a LogicalBridge
 func (s *Server) UpdateLogicalBridge(ctx context.Context, in *pb.UpdateLogicalBridgeRequest) (*pb.LogicalBridge, error) {
 	// check input correctness
 	if err := s.validateUpdateLogicalBridgeRequest(in); err != nil {
 		return nil, err
 	}
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.UpdateLogicalBridge(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
+	// serialize writers, so the etag check and the write are atomic
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// fetch object from the database
 	bridge := new(pb.LogicalBridge)
 	ok, err := s.store.Get(in.LogicalBridge.Name, bridge)
@@ -116,9 +170,13 @@ func (s *Server) UpdateLogicalBridge(ctx context.Context, in *pb.UpdateLogicalBr
 		err := status.Errorf(codes.NotFound, "unable to find key %s", in.LogicalBridge.Name)
 		return nil, err
 	}
+	// reject stale etag, see https://google.aip.dev/154
+	if err := utils.CheckEtag(ctx, bridge); err != nil {
+		return nil, err
+	}
 	// only if VNI is not empty
 	if bridge.Spec.Vni != nil {
-		vxlanName := fmt.Sprintf("vni%d", *bridge.Spec.Vni)
+		vxlanName := utils.L2VxlanName(*bridge.Spec.Vni)
 		iface, err := s.nLink.LinkByName(ctx, vxlanName)
 		if err != nil {
 			err := status.Errorf(codes.NotFound, "unable to find key %s", vxlanName)
@@ -137,6 +195,7 @@ func (s *Server) UpdateLogicalBridge(ctx context.Context, in *pb.UpdateLogicalBr
 	if err != nil {
 		return nil, err
 	}
+	utils.SendEtag(ctx, response)
 	return response, nil
 }
 
@@ -157,9 +216,10 @@ func (s *Server) GetLogicalBridge(ctx context.Context, in *pb.GetLogicalBridgeRe
 		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
 		return nil, err
 	}
+	utils.SendEtag(ctx, bridge)
 	// only if VNI is not empty
 	if bridge.Spec.Vni != nil {
-		vxlanName := fmt.Sprintf("vni%d", *bridge.Spec.Vni)
+		vxlanName := utils.L2VxlanName(*bridge.Spec.Vni)
 		_, err := s.nLink.LinkByName(ctx, vxlanName)
 		if err != nil {
 			err := status.Errorf(codes.NotFound, "unable to find key %s", vxlanName)
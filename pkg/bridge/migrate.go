@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package bridge is the main package of the application
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/vishvananda/netlink"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+)
+
+// MigrateVxlanMode moves the VNIs of the stored LogicalBridges named in names to
+// the devices of linux.vxlanmode, after the daemon was restarted with another mode.
+// It returns the number of migrated LogicalBridges.
+func (s *Server) MigrateVxlanMode(ctx context.Context, names []string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var bridges []*pb.LogicalBridge
+	for _, name := range names {
+		// skip other resources and the options singletons
+		if !strings.HasPrefix(name, "//network.opiproject.org/bridges/") || strings.HasSuffix(name, "/options") {
+			continue
+		}
+		bridge := new(pb.LogicalBridge)
+		ok, err := s.store.Get(name, bridge)
+		if err != nil {
+			fmt.Printf("Failed to interact with store: %v", err)
+			return 0, err
+		}
+		if ok && bridge.Spec.Vni != nil {
+			bridges = append(bridges, bridge)
+		}
+	}
+	if config.GetConfig().Linux.VxlanMode == config.SingleVxlan {
+		return s.migrateToSingleVxlan(ctx, bridges)
+	}
+	return s.migrateToPerVniVxlan(ctx, bridges)
+}
+
+// migrateToSingleVxlan replaces the VXLAN devices of the bridges by mappings on the shared device
+func (s *Server) migrateToSingleVxlan(ctx context.Context, bridges []*pb.LogicalBridge) (int, error) {
+	migrated := 0
+	for _, bridge := range bridges {
+		// only bridges which still have their own device
+		if _, err := s.nLink.LinkByName(ctx, fmt.Sprintf("vni%d", *bridge.Spec.Vni)); err != nil {
+			continue
+		}
+		log.Printf("Migrating %v to VXLAN device %v", bridge.Name, config.GetConfig().Linux.VxlanDevice)
+		if err := s.netlinkDeleteVxlan(ctx, bridge); err != nil {
+			return migrated, err
+		}
+		if err := s.netlinkAddVlanTunnel(ctx, bridge); err != nil {
+			return migrated, err
+		}
+		migrated++
+	}
+	return migrated, nil
+}
+
+// migrateToPerVniVxlan deletes the shared device with all its mappings
+// and creates a VXLAN device for each of the bridges
+func (s *Server) migrateToPerVniVxlan(ctx context.Context, bridges []*pb.LogicalBridge) (int, error) {
+	vxlanName := config.GetConfig().Linux.VxlanDevice
+	link, err := s.nLink.LinkByName(ctx, vxlanName)
+	if err != nil {
+		return 0, nil
+	}
+	// leave devices alone which were not created in the single VXLAN device mode
+	if vxlan, ok := link.(*netlink.Vxlan); !ok || !vxlan.FlowBased {
+		return 0, nil
+	}
+	log.Printf("Deleting Vxlan %v", link)
+	// bring link down
+	if err := s.nLink.LinkSetDown(ctx, link); err != nil {
+		fmt.Printf("Failed to up link: %v", err)
+		return 0, err
+	}
+	// use netlink to delete vxlan device
+	if err := s.nLink.LinkDel(ctx, link); err != nil {
+		fmt.Printf("Failed to delete link: %v", err)
+		return 0, err
+	}
+	migrated := 0
+	for _, bridge := range bridges {
+		vxlanName := fmt.Sprintf("vni%d", *bridge.Spec.Vni)
+		if _, err := s.nLink.LinkByName(ctx, vxlanName); err == nil {
+			continue
+		}
+		log.Printf("Migrating %v to VXLAN device %v", bridge.Name, vxlanName)
+		options, err := s.loadOptions(optionsName(bridge.Name))
+		if err != nil {
+			return migrated, err
+		}
+		if err := s.netlinkCreateVxlan(ctx, bridge, options); err != nil {
+			return migrated, err
+		}
+		migrated++
+	}
+	return migrated, nil
+}
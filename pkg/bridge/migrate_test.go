@@ -0,0 +1,248 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package bridge is the main package of the application
+package bridge
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/vishvananda/netlink"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils/mocks"
+)
+
+var (
+	testVtepIP      = net.IP{10, 0, 0, 2}
+	testSingleVxlan = &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: "vxlan0"}, Port: 4789, SrcAddr: testVtepIP, FlowBased: true}
+)
+
+// useVxlanMode switches the config to mode until the test ends
+func useVxlanMode(t *testing.T, mode string) {
+	cfg := *config.GetConfig()
+	t.Cleanup(func() { config.SetConfig(cfg) })
+	changed := cfg
+	changed.Linux.VxlanMode = mode
+	config.SetConfig(changed)
+}
+
+func Test_CreateLogicalBridgeSingleVxlan(t *testing.T) {
+	tests := map[string]struct {
+		out     *pb.LogicalBridge
+		errCode codes.Code
+		errMsg  string
+		on      func(mockNetlink *mocks.Netlink, errMsg string)
+	}{
+		"creates the shared device": {
+			out:     &testLogicalBridgeWithStatus,
+			errCode: codes.OK,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vxlan0").Return(nil, errors.New("Link not found")).Once()
+				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: tenantbridgeName}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, tenantbridgeName).Return(bridge, nil).Once()
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, testSingleVxlan).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetMaster(mock.Anything, testSingleVxlan, bridge).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetUp(mock.Anything, testSingleVxlan).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetBrVlanTunnel(mock.Anything, testSingleVxlan, true).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetBrNeighSuppress(mock.Anything, testSingleVxlan, true).Return(nil).Once()
+				vid := uint16(testLogicalBridge.Spec.VlanId)
+				mockNetlink.EXPECT().BridgeVlanAdd(mock.Anything, testSingleVxlan, vid, false, false, false, false).Return(nil).Once()
+				mockNetlink.EXPECT().BridgeVlanTunnelAdd(mock.Anything, testSingleVxlan, vid, *testLogicalBridge.Spec.Vni).Return(nil).Once()
+			},
+		},
+		"reuses the shared device": {
+			out:     &testLogicalBridgeWithStatus,
+			errCode: codes.OK,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vxlan0").Return(testSingleVxlan, nil).Once()
+				vid := uint16(testLogicalBridge.Spec.VlanId)
+				mockNetlink.EXPECT().BridgeVlanAdd(mock.Anything, testSingleVxlan, vid, false, false, false, false).Return(nil).Once()
+				mockNetlink.EXPECT().BridgeVlanTunnelAdd(mock.Anything, testSingleVxlan, vid, *testLogicalBridge.Spec.Vni).Return(nil).Once()
+			},
+		},
+		"different local address": {
+			errCode: codes.FailedPrecondition,
+			errMsg:  "vtep_ip_prefix 10.0.0.2 differs from local address 10.0.0.9 of vxlan0",
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				vxlan := &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: "vxlan0"}, SrcAddr: net.IP{10, 0, 0, 9}, FlowBased: true}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vxlan0").Return(vxlan, nil).Once()
+			},
+		},
+		"shared device deleted when it can not be set up": {
+			errCode: codes.Unknown,
+			errMsg:  "Failed to call LinkSetBrVlanTunnel",
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vxlan0").Return(nil, errors.New("Link not found")).Once()
+				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: tenantbridgeName}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, tenantbridgeName).Return(bridge, nil).Once()
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, testSingleVxlan).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetMaster(mock.Anything, testSingleVxlan, bridge).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetUp(mock.Anything, testSingleVxlan).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetBrVlanTunnel(mock.Anything, testSingleVxlan, true).Return(errors.New(errMsg)).Once()
+				mockNetlink.EXPECT().LinkDel(mock.Anything, testSingleVxlan).Return(nil).Once()
+			},
+		},
+		"failed BridgeVlanTunnelAdd call": {
+			errCode: codes.Unknown,
+			errMsg:  "Failed to call BridgeVlanTunnelAdd",
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vxlan0").Return(testSingleVxlan, nil).Once()
+				vid := uint16(testLogicalBridge.Spec.VlanId)
+				mockNetlink.EXPECT().BridgeVlanAdd(mock.Anything, testSingleVxlan, vid, false, false, false, false).Return(nil).Once()
+				mockNetlink.EXPECT().BridgeVlanTunnelAdd(mock.Anything, testSingleVxlan, vid, *testLogicalBridge.Spec.Vni).Return(errors.New(errMsg)).Once()
+			},
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			useVxlanMode(t, config.SingleVxlan)
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pb.NewLogicalBridgeServiceClient(env.conn)
+
+			if tt.out != nil {
+				tt.out = utils.ProtoClone(tt.out)
+				tt.out.Name = testLogicalBridgeName
+			}
+			tt.on(env.mockNetlink, tt.errMsg)
+
+			request := &pb.CreateLogicalBridgeRequest{LogicalBridge: &testLogicalBridge, LogicalBridgeId: testLogicalBridgeID}
+			response, err := client.CreateLogicalBridge(ctx, request)
+			if !proto.Equal(tt.out, response) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+		})
+	}
+}
+
+func Test_DeleteLogicalBridgeSingleVxlan(t *testing.T) {
+	useVxlanMode(t, config.SingleVxlan)
+	ctx := context.Background()
+	env := newTestEnv(ctx, t)
+	defer env.Close()
+	client := pb.NewLogicalBridgeServiceClient(env.conn)
+
+	_ = env.opi.store.Set(testLogicalBridgeName, &testLogicalBridgeWithStatus)
+	env.opi.ListHelper[testLogicalBridgeName] = false
+	vid := uint16(testLogicalBridge.Spec.VlanId)
+	env.mockNetlink.EXPECT().LinkByName(mock.Anything, "vxlan0").Return(testSingleVxlan, nil).Once()
+	env.mockNetlink.EXPECT().BridgeVlanTunnelDel(mock.Anything, testSingleVxlan, vid, *testLogicalBridge.Spec.Vni).Return(nil).Once()
+	env.mockNetlink.EXPECT().BridgeVlanDel(mock.Anything, testSingleVxlan, vid, false, false, false, false).Return(nil).Once()
+
+	request := &pb.DeleteLogicalBridgeRequest{Name: testLogicalBridgeName}
+	if _, err := client.DeleteLogicalBridge(ctx, request); err != nil {
+		t.Error("expected no error, received", err)
+	}
+}
+
+func Test_MigrateVxlanMode(t *testing.T) {
+	vid := uint16(testLogicalBridge.Spec.VlanId)
+	vxlan := &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: "vni11"}, VxlanId: 11, Port: 4789, SrcAddr: testVtepIP}
+	bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: tenantbridgeName}}
+	tests := map[string]struct {
+		mode     string
+		migrated int
+		errMsg   string
+		on       func(mockNetlink *mocks.Netlink, errMsg string)
+	}{
+		"per vni devices to single device": {
+			mode:     config.SingleVxlan,
+			migrated: 1,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni11").Return(vxlan, nil).Twice()
+				mockNetlink.EXPECT().LinkSetDown(mock.Anything, vxlan).Return(nil).Once()
+				mockNetlink.EXPECT().BridgeVlanDel(mock.Anything, vxlan, vid, true, true, false, false).Return(nil).Once()
+				mockNetlink.EXPECT().LinkDel(mock.Anything, vxlan).Return(nil).Once()
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vxlan0").Return(testSingleVxlan, nil).Once()
+				mockNetlink.EXPECT().BridgeVlanAdd(mock.Anything, testSingleVxlan, vid, false, false, false, false).Return(nil).Once()
+				mockNetlink.EXPECT().BridgeVlanTunnelAdd(mock.Anything, testSingleVxlan, vid, uint32(11)).Return(nil).Once()
+			},
+		},
+		"already single device": {
+			mode: config.SingleVxlan,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni11").Return(nil, errors.New("Link not found")).Once()
+			},
+		},
+		"single device to per vni devices": {
+			mode:     config.PerVniVxlan,
+			migrated: 1,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vxlan0").Return(testSingleVxlan, nil).Once()
+				mockNetlink.EXPECT().LinkSetDown(mock.Anything, testSingleVxlan).Return(nil).Once()
+				mockNetlink.EXPECT().LinkDel(mock.Anything, testSingleVxlan).Return(nil).Once()
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni11").Return(nil, errors.New("Link not found")).Once()
+				mockNetlink.EXPECT().LinkByName(mock.Anything, tenantbridgeName).Return(bridge, nil).Once()
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, vxlan).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetMaster(mock.Anything, vxlan, bridge).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetUp(mock.Anything, vxlan).Return(nil).Once()
+				mockNetlink.EXPECT().BridgeVlanAdd(mock.Anything, vxlan, vid, true, true, false, false).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetBrNeighSuppress(mock.Anything, vxlan, true).Return(nil).Once()
+			},
+		},
+		"foreign device is kept": {
+			mode: config.PerVniVxlan,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				foreign := &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: "vxlan0"}, VxlanId: 42}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vxlan0").Return(foreign, nil).Once()
+			},
+		},
+		"failed LinkDel call": {
+			mode:   config.PerVniVxlan,
+			errMsg: "Failed to call LinkDel",
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vxlan0").Return(testSingleVxlan, nil).Once()
+				mockNetlink.EXPECT().LinkSetDown(mock.Anything, testSingleVxlan).Return(nil).Once()
+				mockNetlink.EXPECT().LinkDel(mock.Anything, testSingleVxlan).Return(errors.New(errMsg)).Once()
+			},
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			useVxlanMode(t, tt.mode)
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+
+			_ = env.opi.store.Set(testLogicalBridgeName, &testLogicalBridgeWithStatus)
+			tt.on(env.mockNetlink, tt.errMsg)
+
+			names := []string{testLogicalBridgeName, optionsName(testLogicalBridgeName), "//network.opiproject.org/vrfs/blue"}
+			migrated, err := env.opi.MigrateVxlanMode(ctx, names)
+			if migrated != tt.migrated {
+				t.Error("migrated: expected", tt.migrated, "received", migrated)
+			}
+			if (err == nil && tt.errMsg != "") || (err != nil && err.Error() != tt.errMsg) {
+				t.Error("error: expected", tt.errMsg, "received", err)
+			}
+		})
+	}
+}
@@ -16,76 +16,220 @@ import (
 
 	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
 
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 func (s *Server) netlinkCreateLogicalBridge(ctx context.Context, in *pb.CreateLogicalBridgeRequest) error {
 	// create vxlan only if VNI is not empty
-	if in.LogicalBridge.Spec.Vni != nil {
-		// use netlink to find br-tenant
-		bridge, err := s.nLink.LinkByName(ctx, tenantbridgeName)
-		if err != nil {
-			err := status.Errorf(codes.NotFound, "unable to find key %s", tenantbridgeName)
-			return err
-		}
-		// Example: ip link add vxlan-<LB-vlan-id> type vxlan id <LB-vni> local <vtep-ip> dstport 4789 nolearning proxy
-		myip := make(net.IP, 4)
-		binary.BigEndian.PutUint32(myip, in.LogicalBridge.Spec.VtepIpPrefix.Addr.GetV4Addr())
-		vxlanName := fmt.Sprintf("vni%d", *in.LogicalBridge.Spec.Vni)
-		vxlan := &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: vxlanName}, VxlanId: int(*in.LogicalBridge.Spec.Vni), Port: 4789, Learning: false, SrcAddr: myip}
-		log.Printf("Creating Vxlan %v", vxlan)
-		// TODO: take Port from proto instead of hard-coded
-		if err := s.nLink.LinkAdd(ctx, vxlan); err != nil {
-			fmt.Printf("Failed to create Vxlan link: %v", err)
-			return err
-		}
-		// Example: ip link set vxlan-<LB-vlan-id> master br-tenant addrgenmode none
-		if err := s.nLink.LinkSetMaster(ctx, vxlan, bridge); err != nil {
-			fmt.Printf("Failed to add Vxlan to bridge: %v", err)
-			return err
-		}
-		// Example: ip link set vxlan-<LB-vlan-id> up
-		if err := s.nLink.LinkSetUp(ctx, vxlan); err != nil {
-			fmt.Printf("Failed to up Vxlan link: %v", err)
-			return err
-		}
-		// Example: bridge vlan add dev vxlan-<LB-vlan-id> vid <LB-vlan-id> pvid untagged
-		if err := s.nLink.BridgeVlanAdd(ctx, vxlan, uint16(in.LogicalBridge.Spec.VlanId), true, true, false, false); err != nil {
-			fmt.Printf("Failed to add vlan to bridge: %v", err)
+	if in.LogicalBridge.Spec.Vni == nil {
+		return nil
+	}
+	// map the VLAN to the VNI on the shared device instead of creating one
+	if config.GetConfig().Linux.VxlanMode == config.SingleVxlan {
+		return s.netlinkAddVlanTunnel(ctx, in.LogicalBridge)
+	}
+	options, err := s.loadOptions(optionsName(in.LogicalBridge.Name))
+	if err != nil {
+		return err
+	}
+	return s.netlinkCreateVxlan(ctx, in.LogicalBridge, options)
+}
+
+// netlinkCreateVxlan creates the VXLAN device of the LogicalBridge and adds it to br-tenant
+func (s *Server) netlinkCreateVxlan(ctx context.Context, obj *pb.LogicalBridge, options *pa.LogicalBridgeOptions) error {
+	// use netlink to find br-tenant
+	tenantBridge := config.GetConfig().Linux.TenantBridge
+	bridge, err := s.nLink.LinkByName(ctx, tenantBridge)
+	if err != nil {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", tenantBridge)
+		return err
+	}
+	// Example: ip link add vxlan-<LB-vlan-id> type vxlan id <LB-vni> local <vtep-ip> dstport 4789 nolearning proxy
+	myip := make(net.IP, 4)
+	binary.BigEndian.PutUint32(myip, obj.Spec.VtepIpPrefix.GetAddr().GetV4Addr())
+	vxlanName := fmt.Sprintf("vni%d", *obj.Spec.Vni)
+	vxlan := utils.NewVxlan(vxlanName, *obj.Spec.Vni, myip, options.Spec.GetVxlan())
+	log.Printf("Creating Vxlan %v", vxlan)
+	if err := s.nLink.LinkAdd(ctx, vxlan); err != nil {
+		fmt.Printf("Failed to create Vxlan link: %v", err)
+		return err
+	}
+	// Example: ip link set vxlan-<LB-vlan-id> master br-tenant addrgenmode none
+	if err := s.nLink.LinkSetMaster(ctx, vxlan, bridge); err != nil {
+		fmt.Printf("Failed to add Vxlan to bridge: %v", err)
+		return err
+	}
+	// Example: ip link set vxlan-<LB-vlan-id> up
+	if err := s.nLink.LinkSetUp(ctx, vxlan); err != nil {
+		fmt.Printf("Failed to up Vxlan link: %v", err)
+		return err
+	}
+	// Example: bridge vlan add dev vxlan-<LB-vlan-id> vid <LB-vlan-id> pvid untagged
+	if err := s.nLink.BridgeVlanAdd(ctx, vxlan, uint16(obj.Spec.VlanId), true, true, false, false); err != nil {
+		fmt.Printf("Failed to add vlan to bridge: %v", err)
+		return err
+	}
+	// Example: bridge link set dev vxlan-<LB-vlan-id> neigh_suppress on
+	if neighSuppress(options) {
+		if err := s.nLink.LinkSetBrNeighSuppress(ctx, vxlan, true); err != nil {
+			fmt.Printf("Failed to set neigh_suppress: %v", err)
 			return err
 		}
-		// TODO: bridge link set dev vxlan-<LB-vlan-id> neigh_suppress on
 	}
 	return nil
 }
 
 func (s *Server) netlinkDeleteLogicalBridge(ctx context.Context, obj *pb.LogicalBridge) error {
 	// only if VNI is not empty
-	if obj.Spec.Vni != nil {
-		// use netlink to find vxlan device
-		vxlanName := fmt.Sprintf("vni%d", *obj.Spec.Vni)
-		vxlan, err := s.nLink.LinkByName(ctx, vxlanName)
-		if err != nil {
-			err := status.Errorf(codes.NotFound, "unable to find key %s", vxlanName)
-			return err
-		}
-		log.Printf("Deleting Vxlan %v", vxlan)
-		// bring link down
-		if err := s.nLink.LinkSetDown(ctx, vxlan); err != nil {
-			fmt.Printf("Failed to up link: %v", err)
-			return err
-		}
-		// delete bridge vlan
-		if err := s.nLink.BridgeVlanDel(ctx, vxlan, uint16(obj.Spec.VlanId), true, true, false, false); err != nil {
-			fmt.Printf("Failed to delete vlan to bridge: %v", err)
-			return err
-		}
-		// use netlink to delete vxlan device
-		if err := s.nLink.LinkDel(ctx, vxlan); err != nil {
-			fmt.Printf("Failed to delete link: %v", err)
-			return err
+	if obj.Spec.Vni == nil {
+		return nil
+	}
+	if config.GetConfig().Linux.VxlanMode == config.SingleVxlan {
+		return s.netlinkDelVlanTunnel(ctx, obj)
+	}
+	return s.netlinkDeleteVxlan(ctx, obj)
+}
+
+// netlinkDeleteVxlan deletes the VXLAN device of the LogicalBridge
+func (s *Server) netlinkDeleteVxlan(ctx context.Context, obj *pb.LogicalBridge) error {
+	// use netlink to find vxlan device
+	vxlanName := fmt.Sprintf("vni%d", *obj.Spec.Vni)
+	vxlan, err := s.nLink.LinkByName(ctx, vxlanName)
+	if err != nil {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", vxlanName)
+		return err
+	}
+	log.Printf("Deleting Vxlan %v", vxlan)
+	// bring link down
+	if err := s.nLink.LinkSetDown(ctx, vxlan); err != nil {
+		fmt.Printf("Failed to up link: %v", err)
+		return err
+	}
+	// delete bridge vlan
+	if err := s.nLink.BridgeVlanDel(ctx, vxlan, uint16(obj.Spec.VlanId), true, true, false, false); err != nil {
+		fmt.Printf("Failed to delete vlan to bridge: %v", err)
+		return err
+	}
+	// use netlink to delete vxlan device
+	if err := s.nLink.LinkDel(ctx, vxlan); err != nil {
+		fmt.Printf("Failed to delete link: %v", err)
+		return err
+	}
+	return nil
+}
+
+// netlinkSingleVxlan returns the VXLAN device shared by all LogicalBridges in the
+// single VXLAN device mode, it is created on br-tenant by the first LogicalBridge
+func (s *Server) netlinkSingleVxlan(ctx context.Context, local net.IP) (netlink.Link, error) {
+	vxlanName := config.GetConfig().Linux.VxlanDevice
+	if link, err := s.nLink.LinkByName(ctx, vxlanName); err == nil {
+		// the device has a single local address for all VNIs
+		if vxlan, ok := link.(*netlink.Vxlan); ok && vxlan.SrcAddr != nil && !vxlan.SrcAddr.Equal(local) {
+			err := status.Errorf(codes.FailedPrecondition, "vtep_ip_prefix %v differs from local address %v of %s", local, vxlan.SrcAddr, vxlanName)
+			return nil, err
 		}
+		return link, nil
+	}
+	// use netlink to find br-tenant
+	tenantBridge := config.GetConfig().Linux.TenantBridge
+	bridge, err := s.nLink.LinkByName(ctx, tenantBridge)
+	if err != nil {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", tenantBridge)
+		return nil, err
+	}
+	// Example: ip link add vxlan0 type vxlan local <vtep-ip> dstport 4789 nolearning external
+	vxlan := utils.NewVxlan(vxlanName, 0, local, nil)
+	vxlan.FlowBased = true
+	log.Printf("Creating Vxlan %v", vxlan)
+	if err := s.nLink.LinkAdd(ctx, vxlan); err != nil {
+		fmt.Printf("Failed to create Vxlan link: %v", err)
+		return nil, err
+	}
+	// a half configured device would be reused by the next call, so delete it on failure
+	if err := s.netlinkSetupSingleVxlan(ctx, vxlan, bridge); err != nil {
+		s.netlinkUndoSingleVxlan(ctx, vxlan)
+		return nil, err
+	}
+	return vxlan, nil
+}
+
+// netlinkSetupSingleVxlan adds the shared VXLAN device to br-tenant and
+// enables the mapping of VLANs to VNIs on it
+func (s *Server) netlinkSetupSingleVxlan(ctx context.Context, vxlan, bridge netlink.Link) error {
+	// Example: ip link set vxlan0 master br-tenant addrgenmode none
+	if err := s.nLink.LinkSetMaster(ctx, vxlan, bridge); err != nil {
+		fmt.Printf("Failed to add Vxlan to bridge: %v", err)
+		return err
+	}
+	// Example: ip link set vxlan0 up
+	if err := s.nLink.LinkSetUp(ctx, vxlan); err != nil {
+		fmt.Printf("Failed to up Vxlan link: %v", err)
+		return err
+	}
+	// Example: bridge link set dev vxlan0 vlan_tunnel on
+	if err := s.nLink.LinkSetBrVlanTunnel(ctx, vxlan, true); err != nil {
+		fmt.Printf("Failed to set vlan_tunnel: %v", err)
+		return err
+	}
+	// Example: bridge link set dev vxlan0 neigh_suppress on
+	if err := s.nLink.LinkSetBrNeighSuppress(ctx, vxlan, true); err != nil {
+		fmt.Printf("Failed to set neigh_suppress: %v", err)
+		return err
+	}
+	return nil
+}
+
+// netlinkUndoSingleVxlan deletes the shared VXLAN device, which could not be set up
+func (s *Server) netlinkUndoSingleVxlan(ctx context.Context, vxlan netlink.Link) {
+	// Example: ip link del vxlan0
+	if err := s.nLink.LinkDel(ctx, vxlan); err != nil {
+		log.Printf("Failed to delete Vxlan link %v: %v", vxlan.Attrs().Name, err)
+	}
+}
+
+// netlinkAddVlanTunnel maps the VLAN of the LogicalBridge to its VNI on the shared VXLAN device
+func (s *Server) netlinkAddVlanTunnel(ctx context.Context, obj *pb.LogicalBridge) error {
+	myip := make(net.IP, 4)
+	binary.BigEndian.PutUint32(myip, obj.Spec.VtepIpPrefix.GetAddr().GetV4Addr())
+	vxlan, err := s.netlinkSingleVxlan(ctx, myip)
+	if err != nil {
+		return err
+	}
+	// Example: bridge vlan add dev vxlan0 vid <LB-vlan-id>
+	if err := s.nLink.BridgeVlanAdd(ctx, vxlan, uint16(obj.Spec.VlanId), false, false, false, false); err != nil {
+		fmt.Printf("Failed to add vlan to bridge: %v", err)
+		return err
+	}
+	// Example: bridge vlan add dev vxlan0 vid <LB-vlan-id> tunnel_info id <LB-vni>
+	if err := s.nLink.BridgeVlanTunnelAdd(ctx, vxlan, uint16(obj.Spec.VlanId), *obj.Spec.Vni); err != nil {
+		fmt.Printf("Failed to map vlan to vni: %v", err)
+		return err
+	}
+	return nil
+}
+
+// netlinkDelVlanTunnel removes the mapping of the VLAN of the LogicalBridge from the shared VXLAN device
+func (s *Server) netlinkDelVlanTunnel(ctx context.Context, obj *pb.LogicalBridge) error {
+	vxlanName := config.GetConfig().Linux.VxlanDevice
+	vxlan, err := s.nLink.LinkByName(ctx, vxlanName)
+	if err != nil {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", vxlanName)
+		return err
+	}
+	// Example: bridge vlan del dev vxlan0 vid <LB-vlan-id> tunnel_info id <LB-vni>
+	if err := s.nLink.BridgeVlanTunnelDel(ctx, vxlan, uint16(obj.Spec.VlanId), *obj.Spec.Vni); err != nil {
+		fmt.Printf("Failed to unmap vlan from vni: %v", err)
+		return err
+	}
+	// Example: bridge vlan del dev vxlan0 vid <LB-vlan-id>
+	if err := s.nLink.BridgeVlanDel(ctx, vxlan, uint16(obj.Spec.VlanId), false, false, false, false); err != nil {
+		fmt.Printf("Failed to delete vlan to bridge: %v", err)
+		return err
 	}
 	return nil
 }
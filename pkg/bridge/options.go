@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package bridge is the main package of the application
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"go.einride.tech/aip/fieldmask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+)
+
+// optionsPattern is the name of the options singleton below a LogicalBridge
+const optionsPattern = "//network.opiproject.org/bridges/{bridge}/options"
+
+// GetLogicalBridgeOptions gets the options of a LogicalBridge
+func (s *Server) GetLogicalBridgeOptions(ctx context.Context, in *pa.GetLogicalBridgeOptionsRequest) (*pa.LogicalBridgeOptions, error) {
+	// check input correctness
+	if err := validateOptionsName(in.GetName()); err != nil {
+		return nil, err
+	}
+	options, err := s.loadOptions(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	utils.SendEtag(ctx, options)
+	if err := s.readOptionsStatus(ctx, options); err != nil {
+		return nil, err
+	}
+	return options, nil
+}
+
+// UpdateLogicalBridgeOptions sets the options of a LogicalBridge and applies
+// them to its devices, if the LogicalBridge exists
+func (s *Server) UpdateLogicalBridgeOptions(ctx context.Context, in *pa.UpdateLogicalBridgeOptionsRequest) (*pa.LogicalBridgeOptions, error) {
+	// check input correctness
+	if err := s.ValidateUpdateLogicalBridgeOptionsRequest(in); err != nil {
+		return nil, err
+	}
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.UpdateLogicalBridgeOptions(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
+	// serialize writers, so the etag check and the write are atomic
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	options, err := s.loadOptions(in.LogicalBridgeOptions.Name)
+	if err != nil {
+		return nil, err
+	}
+	// reject stale etag, see https://google.aip.dev/154
+	if err := utils.CheckEtag(ctx, options); err != nil {
+		return nil, err
+	}
+	// status is output only
+	in.LogicalBridgeOptions.Status = nil
+	// copy the old options, the mask can update fields of them in place
+	old := utils.ProtoClone(options)
+	oldEvpn := proto.Clone(options.Spec.GetEvpn()).(*pa.EvpnOptions)
+	fieldmask.Update(in.UpdateMask, options, in.LogicalBridgeOptions)
+	// validate the merged options, the request may only set some fields of a range
+	if err := utils.ValidateVxlanOptions(options.Spec.GetVxlan()); err != nil {
+		return nil, err
+	}
+	if err := utils.ValidateEvpnOptions(options.Spec.GetEvpn()); err != nil {
+		return nil, err
+	}
+	single := config.GetConfig().Linux.VxlanMode == config.SingleVxlan
+	if single && (options.Spec.Vxlan != nil || !neighSuppress(options)) {
+		err := status.Errorf(codes.FailedPrecondition, "vxlan and neigh_suppress options need linux.vxlanmode %v", config.PerVniVxlan)
+		return nil, err
+	}
+	bridge, err := s.optionsBridge(options.Name)
+	if err != nil {
+		return nil, err
+	}
+	// configure netlink, unless the options only apply on creation
+	if bridge != nil && bridge.Spec.Vni != nil && !single {
+		if err := s.netlinkUpdateOptions(ctx, bridge, old, options); err != nil {
+			return nil, err
+		}
+	}
+	// configure FRR, in both VXLAN device modes
+	if bridge != nil && bridge.Spec.Vni != nil {
+		if err := s.frrUpdateEvpn(ctx, bridge, oldEvpn, options.Spec.GetEvpn()); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.store.Set(options.Name, options); err != nil {
+		return nil, err
+	}
+	utils.SendEtag(ctx, options)
+	if err := s.readOptionsStatus(ctx, options); err != nil {
+		return nil, err
+	}
+	return options, nil
+}
+
+// optionsName returns the name of the options of a LogicalBridge
+func optionsName(bridgeName string) string {
+	return bridgeName + "/options"
+}
+
+// netlinkUpdateOptions applies changed options to the devices of an existing LogicalBridge
+func (s *Server) netlinkUpdateOptions(ctx context.Context, bridge *pb.LogicalBridge, old, options *pa.LogicalBridgeOptions) error {
+	// VXLAN parameters are fixed when the device is created, so replace it
+	if !proto.Equal(old.Spec.GetVxlan(), options.Spec.GetVxlan()) {
+		if err := s.netlinkDeleteVxlan(ctx, bridge); err != nil {
+			return err
+		}
+		if err := s.netlinkCreateVxlan(ctx, bridge, options); err != nil {
+			s.netlinkRestoreVxlan(ctx, bridge, old)
+			return err
+		}
+		return nil
+	}
+	vxlanName := fmt.Sprintf("vni%d", *bridge.Spec.Vni)
+	vxlan, err := s.nLink.LinkByName(ctx, vxlanName)
+	if err != nil {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", vxlanName)
+		return err
+	}
+	// Example: bridge link set dev vxlan-<LB-vlan-id> neigh_suppress on
+	if err := s.nLink.LinkSetBrNeighSuppress(ctx, vxlan, neighSuppress(options)); err != nil {
+		fmt.Printf("Failed to set neigh_suppress: %v", err)
+		return err
+	}
+	return nil
+}
+
+// netlinkRestoreVxlan deletes what was created of the replacing VXLAN device
+// and creates the device with the old options again
+func (s *Server) netlinkRestoreVxlan(ctx context.Context, bridge *pb.LogicalBridge, old *pa.LogicalBridgeOptions) {
+	vxlanName := fmt.Sprintf("vni%d", *bridge.Spec.Vni)
+	// Example: ip link del vni10
+	if vxlan, err := s.nLink.LinkByName(ctx, vxlanName); err == nil {
+		if err := s.nLink.LinkDel(ctx, vxlan); err != nil {
+			log.Printf("Failed to delete Vxlan link %v: %v", vxlanName, err)
+			return
+		}
+	}
+	if err := s.netlinkCreateVxlan(ctx, bridge, old); err != nil {
+		log.Printf("Failed to restore Vxlan link %v: %v", vxlanName, err)
+	}
+}
+
+// loadOptions returns the stored options, or the defaults when they were never set
+func (s *Server) loadOptions(name string) (*pa.LogicalBridgeOptions, error) {
+	options := new(pa.LogicalBridgeOptions)
+	ok, err := s.store.Get(name, options)
+	if err != nil {
+		fmt.Printf("Failed to interact with store: %v", err)
+		return nil, err
+	}
+	if !ok {
+		options = &pa.LogicalBridgeOptions{Name: name}
+	}
+	if options.Spec == nil {
+		options.Spec = &pa.LogicalBridgeOptionsSpec{}
+	}
+	return options, nil
+}
+
+// optionsBridge returns the LogicalBridge the options belong to, or nil when it does not exist
+func (s *Server) optionsBridge(name string) (*pb.LogicalBridge, error) {
+	bridge := new(pb.LogicalBridge)
+	ok, err := s.store.Get(strings.TrimSuffix(name, "/options"), bridge)
+	if err != nil {
+		fmt.Printf("Failed to interact with store: %v", err)
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return bridge, nil
+}
+
+// readOptionsStatus reads the options in effect from the devices of the LogicalBridge
+func (s *Server) readOptionsStatus(ctx context.Context, options *pa.LogicalBridgeOptions) error {
+	options.Status = &pa.LogicalBridgeOptionsStatus{}
+	bridge, err := s.optionsBridge(options.Name)
+	if err != nil || bridge == nil || bridge.Spec.Vni == nil {
+		return err
+	}
+	vxlanName := utils.L2VxlanName(*bridge.Spec.Vni)
+	vxlan, err := s.nLink.LinkByName(ctx, vxlanName)
+	if err != nil {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", vxlanName)
+		return err
+	}
+	options.Status.Vxlan = utils.VxlanOptionsOf(vxlan)
+	evpn, err := s.frrReadEvpn(ctx, *bridge.Spec.Vni)
+	if err != nil {
+		log.Printf("Failed to read EVPN options of %v: %v", vxlanName, err)
+	}
+	options.Status.Evpn = evpn
+	flags, err := s.nLink.LinkGetBrPortFlags(ctx, vxlan)
+	if err != nil {
+		log.Printf("Failed to read bridge port flags of %v: %v", vxlanName, err)
+		return nil
+	}
+	options.Status.NeighSuppress = flags.NeighSuppress
+	return nil
+}
+
+// neighSuppress tells whether ARP and ND suppression is wanted, it is on by default
+func neighSuppress(options *pa.LogicalBridgeOptions) bool {
+	return options.GetSpec().NeighSuppress == nil || options.GetSpec().GetNeighSuppress()
+}
@@ -0,0 +1,406 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package bridge is the main package of the application
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/vishvananda/netlink"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils/mocks"
+)
+
+var (
+	testOptionsName = optionsName(testLogicalBridgeName)
+	testVxlan       = &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: "vni11"}, VxlanId: 11, Port: 4789}
+	testVxlanStatus = &pa.VxlanOptions{DstPort: proto.Uint32(4789)}
+	testEvpnShow    = `show bgp l2vpn evpn vni 11 json
+{
+  "vni":11,
+  "type":"L2",
+  "inKernel":"True",
+  "rd":"10.0.0.2:2",
+  "originatorIp":"10.0.0.2",
+  "importRts":["65000:11"],
+  "exportRts":["65000:11"]
+}
+opi-evpn-bridge#`
+	testEvpnStatus = &pa.EvpnOptions{Rd: "10.0.0.2:2", ImportRts: []string{"65000:11"}, ExportRts: []string{"65000:11"}}
+)
+
+func Test_UpdateLogicalBridgeOptions(t *testing.T) {
+	tests := map[string]struct {
+		mask    *fieldmaskpb.FieldMask
+		in      *pa.LogicalBridgeOptions
+		stored  *pa.LogicalBridgeOptions
+		out     *pa.LogicalBridgeOptions
+		errCode codes.Code
+		errMsg  string
+		exist   bool
+		on      func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string)
+	}{
+		"invalid name": {
+			in:      &pa.LogicalBridgeOptions{Name: testLogicalBridgeName, Spec: &pa.LogicalBridgeOptionsSpec{}},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("name %s does not match %s", testLogicalBridgeName, optionsPattern),
+		},
+		"missing spec": {
+			in:      &pa.LogicalBridgeOptions{Name: testOptionsName},
+			errCode: codes.InvalidArgument,
+			errMsg:  "missing required field: logical_bridge_options.spec",
+		},
+		"status can not be updated": {
+			mask:    &fieldmaskpb.FieldMask{Paths: []string{"status"}},
+			in:      &pa.LogicalBridgeOptions{Name: testOptionsName, Spec: &pa.LogicalBridgeOptionsSpec{}},
+			errCode: codes.InvalidArgument,
+			errMsg:  "field status can not be updated",
+		},
+		"stored until the bridge is created": {
+			in: &pa.LogicalBridgeOptions{Name: testOptionsName, Spec: &pa.LogicalBridgeOptionsSpec{NeighSuppress: proto.Bool(false)}},
+			out: &pa.LogicalBridgeOptions{
+				Name:   testOptionsName,
+				Spec:   &pa.LogicalBridgeOptionsSpec{NeighSuppress: proto.Bool(false)},
+				Status: &pa.LogicalBridgeOptionsStatus{},
+			},
+			errCode: codes.OK,
+		},
+		"applied to the bridge": {
+			in: &pa.LogicalBridgeOptions{Name: testOptionsName, Spec: &pa.LogicalBridgeOptionsSpec{NeighSuppress: proto.Bool(false)}},
+			out: &pa.LogicalBridgeOptions{
+				Name:   testOptionsName,
+				Spec:   &pa.LogicalBridgeOptionsSpec{NeighSuppress: proto.Bool(false)},
+				Status: &pa.LogicalBridgeOptionsStatus{NeighSuppress: false, Vxlan: testVxlanStatus, Evpn: testEvpnStatus},
+			},
+			errCode: codes.OK,
+			exist:   true,
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni11").Return(testVxlan, nil).Twice()
+				mockNetlink.EXPECT().LinkSetBrNeighSuppress(mock.Anything, testVxlan, false).Return(nil).Once()
+				mockNetlink.EXPECT().LinkGetBrPortFlags(mock.Anything, testVxlan).Return(&utils.BridgePortFlags{}, nil).Once()
+				mockFrr.EXPECT().FrrBgpCmd(mock.Anything, utils.EvpnShowCommand(11)).Return(testEvpnShow, nil).Once()
+			},
+		},
+		"mask resets to default": {
+			mask:   &fieldmaskpb.FieldMask{Paths: []string{"spec.neigh_suppress"}},
+			in:     &pa.LogicalBridgeOptions{Name: testOptionsName, Spec: &pa.LogicalBridgeOptionsSpec{}},
+			stored: &pa.LogicalBridgeOptions{Name: testOptionsName, Spec: &pa.LogicalBridgeOptionsSpec{NeighSuppress: proto.Bool(false)}},
+			out: &pa.LogicalBridgeOptions{
+				Name:   testOptionsName,
+				Spec:   &pa.LogicalBridgeOptionsSpec{},
+				Status: &pa.LogicalBridgeOptionsStatus{NeighSuppress: true, Vxlan: testVxlanStatus, Evpn: testEvpnStatus},
+			},
+			errCode: codes.OK,
+			exist:   true,
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni11").Return(testVxlan, nil).Twice()
+				mockNetlink.EXPECT().LinkSetBrNeighSuppress(mock.Anything, testVxlan, true).Return(nil).Once()
+				mockNetlink.EXPECT().LinkGetBrPortFlags(mock.Anything, testVxlan).Return(&utils.BridgePortFlags{NeighSuppress: true}, nil).Once()
+				mockFrr.EXPECT().FrrBgpCmd(mock.Anything, utils.EvpnShowCommand(11)).Return(testEvpnShow, nil).Once()
+			},
+		},
+		"vxlan options replace the device": {
+			mask: &fieldmaskpb.FieldMask{Paths: []string{"spec.vxlan"}},
+			in: &pa.LogicalBridgeOptions{Name: testOptionsName, Spec: &pa.LogicalBridgeOptionsSpec{
+				Vxlan: &pa.VxlanOptions{DstPort: proto.Uint32(4790), Ttl: 64, TosInherit: true},
+			}},
+			out: &pa.LogicalBridgeOptions{
+				Name: testOptionsName,
+				Spec: &pa.LogicalBridgeOptionsSpec{
+					Vxlan: &pa.VxlanOptions{DstPort: proto.Uint32(4790), Ttl: 64, TosInherit: true},
+				},
+				Status: &pa.LogicalBridgeOptionsStatus{
+					NeighSuppress: true,
+					Vxlan:         &pa.VxlanOptions{DstPort: proto.Uint32(4790), Ttl: 64, TosInherit: true},
+					Evpn:          testEvpnStatus,
+				},
+			},
+			errCode: codes.OK,
+			exist:   true,
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				// delete the old device
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni11").Return(testVxlan, nil).Once()
+				mockNetlink.EXPECT().LinkSetDown(mock.Anything, testVxlan).Return(nil).Once()
+				vid := uint16(testLogicalBridge.Spec.VlanId)
+				mockNetlink.EXPECT().BridgeVlanDel(mock.Anything, testVxlan, vid, true, true, false, false).Return(nil).Once()
+				mockNetlink.EXPECT().LinkDel(mock.Anything, testVxlan).Return(nil).Once()
+				// create the new one
+				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: tenantbridgeName}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, tenantbridgeName).Return(bridge, nil).Once()
+				vxlan := &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: "vni11"}, VxlanId: 11, Port: 4790, TTL: 64, TOS: 1, SrcAddr: net.IP{10, 0, 0, 2}}
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, vxlan).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetMaster(mock.Anything, vxlan, bridge).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetUp(mock.Anything, vxlan).Return(nil).Once()
+				mockNetlink.EXPECT().BridgeVlanAdd(mock.Anything, vxlan, vid, true, true, false, false).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetBrNeighSuppress(mock.Anything, vxlan, true).Return(nil).Once()
+				// read the status
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni11").Return(vxlan, nil).Once()
+				mockNetlink.EXPECT().LinkGetBrPortFlags(mock.Anything, vxlan).Return(&utils.BridgePortFlags{NeighSuppress: true}, nil).Once()
+				mockFrr.EXPECT().FrrBgpCmd(mock.Anything, utils.EvpnShowCommand(11)).Return(testEvpnShow, nil).Once()
+			},
+		},
+		"old device restored when the new one fails": {
+			mask: &fieldmaskpb.FieldMask{Paths: []string{"spec.vxlan"}},
+			in: &pa.LogicalBridgeOptions{Name: testOptionsName, Spec: &pa.LogicalBridgeOptionsSpec{
+				Vxlan: &pa.VxlanOptions{DstPort: proto.Uint32(4790)},
+			}},
+			errCode: codes.Unknown,
+			errMsg:  "Failed to call LinkSetUp",
+			exist:   true,
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				// delete the old device
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni11").Return(testVxlan, nil).Once()
+				mockNetlink.EXPECT().LinkSetDown(mock.Anything, testVxlan).Return(nil).Once()
+				vid := uint16(testLogicalBridge.Spec.VlanId)
+				mockNetlink.EXPECT().BridgeVlanDel(mock.Anything, testVxlan, vid, true, true, false, false).Return(nil).Once()
+				mockNetlink.EXPECT().LinkDel(mock.Anything, testVxlan).Return(nil).Once()
+				// the new one fails
+				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: tenantbridgeName}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, tenantbridgeName).Return(bridge, nil).Twice()
+				vxlan := &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: "vni11"}, VxlanId: 11, Port: 4790, SrcAddr: net.IP{10, 0, 0, 2}}
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, vxlan).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetMaster(mock.Anything, vxlan, bridge).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetUp(mock.Anything, vxlan).Return(errors.New(errMsg)).Once()
+				// and is replaced by the old one
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni11").Return(vxlan, nil).Once()
+				mockNetlink.EXPECT().LinkDel(mock.Anything, vxlan).Return(nil).Once()
+				old := &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: "vni11"}, VxlanId: 11, Port: 4789, SrcAddr: net.IP{10, 0, 0, 2}}
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, old).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetMaster(mock.Anything, old, bridge).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetUp(mock.Anything, old).Return(nil).Once()
+				mockNetlink.EXPECT().BridgeVlanAdd(mock.Anything, old, vid, true, true, false, false).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetBrNeighSuppress(mock.Anything, old, true).Return(nil).Once()
+			},
+		},
+		"invalid vxlan options": {
+			in: &pa.LogicalBridgeOptions{Name: testOptionsName, Spec: &pa.LogicalBridgeOptionsSpec{
+				Vxlan: &pa.VxlanOptions{SrcPortLow: 50000},
+			}},
+			errCode: codes.InvalidArgument,
+			errMsg:  "vxlan.src_port_low (50000) and vxlan.src_port_high (0) have to be a range within 1 and 65535",
+		},
+		"invalid evpn options": {
+			in: &pa.LogicalBridgeOptions{Name: testOptionsName, Spec: &pa.LogicalBridgeOptionsSpec{
+				Evpn: &pa.EvpnOptions{ExportRts: []string{"65000:11", "65000:11"}},
+			}},
+			errCode: codes.InvalidArgument,
+			errMsg:  "evpn.export_rts value (65000:11) is set more than once",
+		},
+		"evpn options change the vni": {
+			mask: &fieldmaskpb.FieldMask{Paths: []string{"spec.evpn"}},
+			in: &pa.LogicalBridgeOptions{Name: testOptionsName, Spec: &pa.LogicalBridgeOptionsSpec{
+				Evpn: &pa.EvpnOptions{Rd: "10.0.0.2:11", ImportRts: []string{"65000:11"}},
+			}},
+			stored: &pa.LogicalBridgeOptions{Name: testOptionsName, Spec: &pa.LogicalBridgeOptionsSpec{
+				Evpn: &pa.EvpnOptions{Rd: "10.0.0.2:10", ExportRts: []string{"65000:10"}},
+			}},
+			out: &pa.LogicalBridgeOptions{
+				Name: testOptionsName,
+				Spec: &pa.LogicalBridgeOptionsSpec{
+					Evpn: &pa.EvpnOptions{Rd: "10.0.0.2:11", ImportRts: []string{"65000:11"}},
+				},
+				Status: &pa.LogicalBridgeOptionsStatus{NeighSuppress: true, Vxlan: testVxlanStatus, Evpn: testEvpnStatus},
+			},
+			errCode: codes.OK,
+			exist:   true,
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni11").Return(testVxlan, nil).Twice()
+				mockNetlink.EXPECT().LinkSetBrNeighSuppress(mock.Anything, testVxlan, true).Return(nil).Once()
+				command := mock.MatchedBy(func(command string) bool {
+					return strings.Contains(command, "vni 11\n\t\t\t\t\tno rd 10.0.0.2:10\n\t\t\t\t\trd 10.0.0.2:11\n\t\t\t\t\troute-target import 65000:11\n\t\t\t\t\tno route-target export 65000:10\n")
+				})
+				mockFrr.EXPECT().FrrBgpCmd(mock.Anything, command).Return("", nil).Once()
+				mockNetlink.EXPECT().LinkGetBrPortFlags(mock.Anything, testVxlan).Return(&utils.BridgePortFlags{NeighSuppress: true}, nil).Once()
+				mockFrr.EXPECT().FrrBgpCmd(mock.Anything, utils.EvpnShowCommand(11)).Return(testEvpnShow, nil).Once()
+			},
+		},
+		"mask changes a field of the evpn options": {
+			mask: &fieldmaskpb.FieldMask{Paths: []string{"spec.evpn.rd"}},
+			in: &pa.LogicalBridgeOptions{Name: testOptionsName, Spec: &pa.LogicalBridgeOptionsSpec{
+				Evpn: &pa.EvpnOptions{Rd: "10.0.0.2:11"},
+			}},
+			stored: &pa.LogicalBridgeOptions{Name: testOptionsName, Spec: &pa.LogicalBridgeOptionsSpec{
+				Evpn: &pa.EvpnOptions{Rd: "10.0.0.2:10", ExportRts: []string{"65000:10"}},
+			}},
+			out: &pa.LogicalBridgeOptions{
+				Name: testOptionsName,
+				Spec: &pa.LogicalBridgeOptionsSpec{
+					Evpn: &pa.EvpnOptions{Rd: "10.0.0.2:11", ExportRts: []string{"65000:10"}},
+				},
+				Status: &pa.LogicalBridgeOptionsStatus{NeighSuppress: true, Vxlan: testVxlanStatus, Evpn: testEvpnStatus},
+			},
+			errCode: codes.OK,
+			exist:   true,
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni11").Return(testVxlan, nil).Twice()
+				mockNetlink.EXPECT().LinkSetBrNeighSuppress(mock.Anything, testVxlan, true).Return(nil).Once()
+				command := mock.MatchedBy(func(command string) bool {
+					return strings.Contains(command, "vni 11\n\t\t\t\t\tno rd 10.0.0.2:10\n\t\t\t\t\trd 10.0.0.2:11\n\t\t\t\t\texit-vni")
+				})
+				mockFrr.EXPECT().FrrBgpCmd(mock.Anything, command).Return("", nil).Once()
+				mockNetlink.EXPECT().LinkGetBrPortFlags(mock.Anything, testVxlan).Return(&utils.BridgePortFlags{NeighSuppress: true}, nil).Once()
+				mockFrr.EXPECT().FrrBgpCmd(mock.Anything, utils.EvpnShowCommand(11)).Return(testEvpnShow, nil).Once()
+			},
+		},
+		"failed FrrBgpCmd call": {
+			in: &pa.LogicalBridgeOptions{Name: testOptionsName, Spec: &pa.LogicalBridgeOptionsSpec{
+				Evpn: &pa.EvpnOptions{Rd: "65000:11"},
+			}},
+			errCode: codes.Unknown,
+			errMsg:  "Failed to run FrrBgpCmd",
+			exist:   true,
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni11").Return(testVxlan, nil).Once()
+				mockNetlink.EXPECT().LinkSetBrNeighSuppress(mock.Anything, testVxlan, true).Return(nil).Once()
+				mockFrr.EXPECT().FrrBgpCmd(mock.Anything, mock.Anything).Return("", errors.New(errMsg)).Once()
+			},
+		},
+		"failed LinkSetBrNeighSuppress call": {
+			in:      &pa.LogicalBridgeOptions{Name: testOptionsName, Spec: &pa.LogicalBridgeOptionsSpec{NeighSuppress: proto.Bool(false)}},
+			errCode: codes.Unknown,
+			errMsg:  "Failed to call LinkSetBrNeighSuppress",
+			exist:   true,
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni11").Return(testVxlan, nil).Once()
+				mockNetlink.EXPECT().LinkSetBrNeighSuppress(mock.Anything, testVxlan, false).Return(errors.New(errMsg)).Once()
+			},
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pa.NewLogicalBridgeOptionsServiceClient(env.conn)
+
+			if tt.exist {
+				_ = env.opi.store.Set(testLogicalBridgeName, &testLogicalBridgeWithStatus)
+			}
+			if tt.stored != nil {
+				_ = env.opi.store.Set(tt.stored.Name, tt.stored)
+			}
+			if tt.on != nil {
+				tt.on(env.mockNetlink, env.mockFrr, tt.errMsg)
+			}
+
+			request := &pa.UpdateLogicalBridgeOptionsRequest{LogicalBridgeOptions: tt.in, UpdateMask: tt.mask}
+			response, err := client.UpdateLogicalBridgeOptions(ctx, request)
+			if !proto.Equal(tt.out, response) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+		})
+	}
+}
+
+func Test_GetLogicalBridgeOptions(t *testing.T) {
+	tests := map[string]struct {
+		in      string
+		out     *pa.LogicalBridgeOptions
+		errCode codes.Code
+		errMsg  string
+		on      func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string)
+	}{
+		"defaults": {
+			in: testOptionsName,
+			out: &pa.LogicalBridgeOptions{
+				Name:   testOptionsName,
+				Spec:   &pa.LogicalBridgeOptionsSpec{},
+				Status: &pa.LogicalBridgeOptionsStatus{NeighSuppress: true, Vxlan: testVxlanStatus, Evpn: testEvpnStatus},
+			},
+			errCode: codes.OK,
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni11").Return(testVxlan, nil).Once()
+				mockNetlink.EXPECT().LinkGetBrPortFlags(mock.Anything, testVxlan).Return(&utils.BridgePortFlags{NeighSuppress: true}, nil).Once()
+				mockFrr.EXPECT().FrrBgpCmd(mock.Anything, utils.EvpnShowCommand(11)).Return(testEvpnShow, nil).Once()
+			},
+		},
+		"failed LinkByName call": {
+			in:      testOptionsName,
+			errCode: codes.NotFound,
+			errMsg:  "unable to find key vni11",
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni11").Return(nil, errors.New(errMsg)).Once()
+			},
+		},
+		"invalid name": {
+			in:      resourceIDToFullName("blue/unknown"),
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("name %s does not match %s", resourceIDToFullName("blue/unknown"), optionsPattern),
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pa.NewLogicalBridgeOptionsServiceClient(env.conn)
+
+			_ = env.opi.store.Set(testLogicalBridgeName, &testLogicalBridgeWithStatus)
+			if tt.on != nil {
+				tt.on(env.mockNetlink, env.mockFrr, tt.errMsg)
+			}
+
+			request := &pa.GetLogicalBridgeOptionsRequest{Name: tt.in}
+			response, err := client.GetLogicalBridgeOptions(ctx, request)
+			if !proto.Equal(tt.out, response) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+		})
+	}
+}
+
+func Test_UpdateLogicalBridgeOptionsSingleVxlan(t *testing.T) {
+	useVxlanMode(t, config.SingleVxlan)
+	ctx := context.Background()
+	env := newTestEnv(ctx, t)
+	defer env.Close()
+	client := pa.NewLogicalBridgeOptionsServiceClient(env.conn)
+
+	in := &pa.LogicalBridgeOptions{Name: testOptionsName, Spec: &pa.LogicalBridgeOptionsSpec{NeighSuppress: proto.Bool(false)}}
+	request := &pa.UpdateLogicalBridgeOptionsRequest{LogicalBridgeOptions: in}
+	_, err := client.UpdateLogicalBridgeOptions(ctx, request)
+	if er, ok := status.FromError(err); !ok || er.Code() != codes.FailedPrecondition {
+		t.Error("error: expected", codes.FailedPrecondition, "received", err)
+	}
+}
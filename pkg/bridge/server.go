@@ -6,6 +6,7 @@ package bridge
 
 import (
 	"log"
+	"sync"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
@@ -14,18 +15,23 @@ import (
 
 	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
 
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
 	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
 )
 
 // Server represents the Server object
 type Server struct {
 	pb.UnimplementedLogicalBridgeServiceServer
+	pa.UnimplementedLogicalBridgeBatchServiceServer
+	pa.UnimplementedLogicalBridgeOptionsServiceServer
 	Pagination map[string]int
 	ListHelper map[string]bool
 	nLink      utils.Netlink
 	frr        utils.Frr
 	tracer     trace.Tracer
 	store      gokv.Store
+	dryRun     bool
+	mu         sync.Mutex
 }
 
 // NewServer creates initialized instance of EVPN server
@@ -56,3 +62,18 @@ func NewServerWithArgs(nLink utils.Netlink, frr utils.Frr, store gokv.Store) *Se
 		store:      store,
 	}
 }
+
+// dryRunServer returns a copy of the server, which records netlink operations
+// and FRR commands instead of executing them and does not write to the store
+func (s *Server) dryRunServer() (*Server, *utils.DryRun) {
+	recorder := utils.NewDryRun(s.nLink, s.store)
+	return &Server{
+		ListHelper: make(map[string]bool),
+		Pagination: make(map[string]int),
+		nLink:      recorder,
+		frr:        recorder,
+		tracer:     s.tracer,
+		store:      recorder.Store(),
+		dryRun:     true,
+	}, recorder
+}
@@ -6,6 +6,7 @@ package bridge
 
 import (
 	"fmt"
+	"strings"
 
 	"go.einride.tech/aip/fieldbehavior"
 	"go.einride.tech/aip/fieldmask"
@@ -16,9 +17,12 @@ import (
 	"google.golang.org/grpc/status"
 
 	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
 )
 
-func (s *Server) validateCreateLogicalBridgeRequest(in *pb.CreateLogicalBridgeRequest) error {
+// ValidateCreateLogicalBridgeRequest checks a create request without executing it
+func (s *Server) ValidateCreateLogicalBridgeRequest(in *pb.CreateLogicalBridgeRequest) error {
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		return err
@@ -68,3 +72,39 @@ func (s *Server) validateGetLogicalBridgeRequest(in *pb.GetLogicalBridgeRequest)
 	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
 	return resourcename.Validate(in.Name)
 }
+
+// ValidateUpdateLogicalBridgeOptionsRequest checks an options update without executing it
+func (s *Server) ValidateUpdateLogicalBridgeOptionsRequest(in *pa.UpdateLogicalBridgeOptionsRequest) error {
+	// check required fields
+	if in.LogicalBridgeOptions == nil {
+		return status.Error(codes.InvalidArgument, "missing required field: logical_bridge_options")
+	}
+	if in.LogicalBridgeOptions.Spec == nil {
+		return status.Error(codes.InvalidArgument, "missing required field: logical_bridge_options.spec")
+	}
+	// update_mask = 2
+	if err := fieldmask.Validate(in.UpdateMask, in.LogicalBridgeOptions); err != nil {
+		return err
+	}
+	for _, path := range in.UpdateMask.GetPaths() {
+		if path != "spec" && !strings.HasPrefix(path, "spec.") {
+			return status.Errorf(codes.InvalidArgument, "field %s can not be updated", path)
+		}
+	}
+	return validateOptionsName(in.LogicalBridgeOptions.Name)
+}
+
+// validateOptionsName checks that name is a LogicalBridge name followed by /options
+func validateOptionsName(name string) error {
+	if name == "" {
+		return status.Error(codes.InvalidArgument, "missing required field: name")
+	}
+	if err := resourcename.Validate(name); err != nil {
+		return err
+	}
+	id := strings.TrimSuffix(strings.TrimPrefix(name, "//network.opiproject.org/bridges/"), "/options")
+	if id == "" || strings.Contains(id, "/") || name != optionsName(resourceIDToFullName(id)) {
+		return status.Errorf(codes.InvalidArgument, "name %s does not match %s", name, optionsPattern)
+	}
+	return nil
+}
@@ -0,0 +1,461 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package config holds the runtime configuration of the application
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// envPrefix is prepended to every environment variable understood by LoadConfig
+const envPrefix = "OPI_EVPN_"
+
+// Supported persistence backends
+const (
+	MemoryStore = "memory"
+	RedisStore  = "redis"
+	BoltStore   = "bolt"
+	EtcdStore   = "etcd"
+)
+
+// Modes of the VXLAN devices of LogicalBridges
+const (
+	// PerVniVxlan creates a VXLAN device for each VNI
+	PerVniVxlan = "pervni"
+	// SingleVxlan maps the VLANs to VNIs on a single external VXLAN device
+	SingleVxlan = "single"
+)
+
+// DatabaseConfig describes the persistence backend
+type DatabaseConfig struct {
+	Type string `yaml:"type"`
+	// Address is ip_address:port for redis, comma separated list of endpoints for etcd
+	Address string `yaml:"address"`
+	// Path is the database file for bolt
+	Path string `yaml:"path"`
+}
+
+// FrrConfig describes how to reach and configure FRR
+type FrrConfig struct {
+	Address  string `yaml:"address"`
+	Password string `yaml:"password"`
+	LocalAS  uint32 `yaml:"localas"`
+}
+
+// LinuxConfig describes the Linux dataplane objects managed by the application
+type LinuxConfig struct {
+	TenantBridge string `yaml:"tenantbridge"`
+	VxlanPort    int    `yaml:"vxlanport"`
+	// VxlanMode is PerVniVxlan or SingleVxlan
+	VxlanMode string `yaml:"vxlanmode"`
+	// VxlanDevice names the shared VXLAN device of SingleVxlan mode
+	VxlanDevice string `yaml:"vxlandevice"`
+}
+
+// PaginationConfig describes page sizes used by List calls
+type PaginationConfig struct {
+	DefaultPageSize int `yaml:"defaultpagesize"`
+	MaxPageSize     int `yaml:"maxpagesize"`
+}
+
+// HTTPConfig describes the HTTP gateway server
+type HTTPConfig struct {
+	ReadTimeout  time.Duration `yaml:"readtimeout"`
+	WriteTimeout time.Duration `yaml:"writetimeout"`
+	// TLSFiles enables HTTPS, in server_cert:server_key[:ca_cert] format,
+	// the CA certificate requires and verifies client certificates
+	TLSFiles string `yaml:"tlsfiles"`
+	// ClientTLSFiles identify the gateway as client of the gRPC server with
+	// TLS, in client_cert:client_key format
+	ClientTLSFiles string `yaml:"clienttlsfiles"`
+}
+
+// AuditConfig describes the audit trail of configuration changes
+type AuditConfig struct {
+	// MaxEntries is the number of entries kept, older entries are dropped
+	MaxEntries int `yaml:"maxentries"`
+}
+
+// Roles of the authorization policy
+const (
+	// RoleReadOnly may call Get, List, Watch and Export
+	RoleReadOnly = "read-only"
+	// RoleTenantAdmin may additionally create, update and delete resources
+	RoleTenantAdmin = "tenant-admin"
+	// RoleGlobalAdmin may call everything, including import and apply
+	RoleGlobalAdmin = "global-admin"
+)
+
+// RoleBinding grants a role to client certificates matching any of the
+// subjects or SANs, "*" matches every verified certificate. Prefixes limit
+// the role to resources whose names start with one of them.
+type RoleBinding struct {
+	Role     string   `yaml:"role"`
+	Subjects []string `yaml:"subjects"`
+	SANs     []string `yaml:"sans"`
+	Prefixes []string `yaml:"prefixes"`
+}
+
+// AuthorizationConfig describes the role based access control of client
+// certificates, it requires TLS
+type AuthorizationConfig struct {
+	Enabled  bool          `yaml:"enabled"`
+	Bindings []RoleBinding `yaml:"bindings"`
+	// GatewayRole is bound to the subject of the client certificate of the
+	// HTTP gateway, empty leaves the gateway to the bindings
+	GatewayRole string `yaml:"gatewayrole"`
+}
+
+// RateLimitConfig describes the token buckets limiting unary calls, a rate
+// of zero disables the limit
+type RateLimitConfig struct {
+	// Rate is the calls per second admitted from all clients together
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
+	// ClientRate is the calls per second admitted from each client identity
+	ClientRate  float64 `yaml:"clientrate"`
+	ClientBurst int     `yaml:"clientburst"`
+}
+
+// Config is the complete configuration of the application
+type Config struct {
+	GRPCPort   int              `yaml:"grpcport"`
+	HTTPPort   int              `yaml:"httpport"`
+	TLSFiles   string           `yaml:"tlsfiles"`
+	Database   DatabaseConfig   `yaml:"database"`
+	Frr        FrrConfig        `yaml:"frr"`
+	Linux      LinuxConfig      `yaml:"linux"`
+	Pagination PaginationConfig `yaml:"pagination"`
+	HTTP       HTTPConfig       `yaml:"http"`
+	Audit      AuditConfig      `yaml:"audit"`
+	// Authorization of the callers
+	Authorization AuthorizationConfig `yaml:"authorization"`
+	RateLimit     RateLimitConfig     `yaml:"ratelimit"`
+	// TLSReloadInterval is how often certificate files are checked for changes, zero disables reloading
+	TLSReloadInterval time.Duration `yaml:"tlsreloadinterval"`
+	// ShutdownTimeout bounds how long in-flight requests are drained on exit
+	ShutdownTimeout time.Duration `yaml:"shutdowntimeout"`
+}
+
+// GlobalConfig is the configuration in use by the running application
+var GlobalConfig = Default()
+
+// Default returns configuration with all values set to their defaults
+func Default() Config {
+	return Config{
+		GRPCPort: 50151,
+		HTTPPort: 8082,
+		TLSFiles: "",
+		Database: DatabaseConfig{
+			Type:    RedisStore,
+			Address: "127.0.0.1:6379",
+			Path:    "opi-evpn-bridge.db",
+		},
+		Frr: FrrConfig{
+			Address:  "127.0.0.1",
+			Password: "opi",
+			LocalAS:  65000,
+		},
+		Linux: LinuxConfig{
+			TenantBridge: "br-tenant",
+			VxlanPort:    4789,
+			VxlanMode:    PerVniVxlan,
+			VxlanDevice:  "vxlan0",
+		},
+		Pagination: PaginationConfig{
+			DefaultPageSize: 50,
+			MaxPageSize:     250,
+		},
+		HTTP: HTTPConfig{
+			ReadTimeout:  5 * time.Second,
+			WriteTimeout: 10 * time.Second,
+		},
+		Audit: AuditConfig{
+			MaxEntries: 10000,
+		},
+		Authorization: AuthorizationConfig{
+			GatewayRole: RoleReadOnly,
+		},
+		RateLimit: RateLimitConfig{
+			Burst:       100,
+			ClientBurst: 20,
+		},
+		TLSReloadInterval: 30 * time.Second,
+		ShutdownTimeout:   30 * time.Second,
+	}
+}
+
+// GetConfig returns the configuration in use by the running application
+func GetConfig() *Config {
+	return &GlobalConfig
+}
+
+// SetConfig replaces the configuration in use by the running application
+func SetConfig(cfg Config) {
+	GlobalConfig = cfg
+}
+
+// LoadConfig builds configuration from defaults, then the optional YAML or JSON
+// file at path, then OPI_EVPN_* environment variables. The result is not validated.
+func LoadConfig(path string) (Config, error) {
+	return loadConfig(path, os.ReadFile, os.LookupEnv)
+}
+
+func loadConfig(path string,
+	readFile func(string) ([]byte, error),
+	lookupEnv func(string) (string, bool),
+) (Config, error) {
+	cfg := Default()
+	if path != "" {
+		data, err := readFile(path)
+		if err != nil {
+			return Config{}, fmt.Errorf("failed to read config file %v: %w", path, err)
+		}
+		// YAML is a superset of JSON, so both formats are handled here
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+			return Config{}, fmt.Errorf("failed to parse config file %v: %w", path, err)
+		}
+	}
+	if err := cfg.applyEnv(lookupEnv); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+func (c *Config) applyEnv(lookupEnv func(string) (string, bool)) error {
+	texts := map[string]*string{
+		"TLS":                &c.TLSFiles,
+		"HTTP_TLS":           &c.HTTP.TLSFiles,
+		"HTTP_CLIENT_TLS":    &c.HTTP.ClientTLSFiles,
+		"AUTHZ_GATEWAY_ROLE": &c.Authorization.GatewayRole,
+		"DB_TYPE":            &c.Database.Type,
+		"DB_ADDR":            &c.Database.Address,
+		"DB_PATH":            &c.Database.Path,
+		"FRR_ADDR":           &c.Frr.Address,
+		"FRR_PASSWORD":       &c.Frr.Password,
+		"TENANT_BRIDGE":      &c.Linux.TenantBridge,
+		"VXLAN_MODE":         &c.Linux.VxlanMode,
+		"VXLAN_DEVICE":       &c.Linux.VxlanDevice,
+	}
+	for name, field := range texts {
+		if value, ok := lookupEnv(envPrefix + name); ok {
+			*field = value
+		}
+	}
+	ints := map[string]*int{
+		"GRPC_PORT":         &c.GRPCPort,
+		"HTTP_PORT":         &c.HTTPPort,
+		"VXLAN_PORT":        &c.Linux.VxlanPort,
+		"DEFAULT_PAGE_SIZE": &c.Pagination.DefaultPageSize,
+		"MAX_PAGE_SIZE":     &c.Pagination.MaxPageSize,
+		"AUDIT_MAX_ENTRIES": &c.Audit.MaxEntries,
+		"RATE_BURST":        &c.RateLimit.Burst,
+		"CLIENT_RATE_BURST": &c.RateLimit.ClientBurst,
+	}
+	for name, field := range ints {
+		if value, ok := lookupEnv(envPrefix + name); ok {
+			number, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("invalid value %q for %v%v: %w", value, envPrefix, name, err)
+			}
+			*field = number
+		}
+	}
+	floats := map[string]*float64{
+		"RATE_LIMIT":        &c.RateLimit.Rate,
+		"CLIENT_RATE_LIMIT": &c.RateLimit.ClientRate,
+	}
+	for name, field := range floats {
+		if value, ok := lookupEnv(envPrefix + name); ok {
+			number, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return fmt.Errorf("invalid value %q for %v%v: %w", value, envPrefix, name, err)
+			}
+			*field = number
+		}
+	}
+	if value, ok := lookupEnv(envPrefix + "AUTHZ_ENABLED"); ok {
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value %q for %vAUTHZ_ENABLED: %w", value, envPrefix, err)
+		}
+		c.Authorization.Enabled = enabled
+	}
+	if value, ok := lookupEnv(envPrefix + "FRR_LOCAL_AS"); ok {
+		number, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid value %q for %vFRR_LOCAL_AS: %w", value, envPrefix, err)
+		}
+		c.Frr.LocalAS = uint32(number)
+	}
+	durations := map[string]*time.Duration{
+		"HTTP_READ_TIMEOUT":   &c.HTTP.ReadTimeout,
+		"HTTP_WRITE_TIMEOUT":  &c.HTTP.WriteTimeout,
+		"SHUTDOWN_TIMEOUT":    &c.ShutdownTimeout,
+		"TLS_RELOAD_INTERVAL": &c.TLSReloadInterval,
+	}
+	for name, field := range durations {
+		if value, ok := lookupEnv(envPrefix + name); ok {
+			duration, err := time.ParseDuration(value)
+			if err != nil {
+				return fmt.Errorf("invalid value %q for %v%v: %w", value, envPrefix, name, err)
+			}
+			*field = duration
+		}
+	}
+	return nil
+}
+
+// Validate checks that configuration values are consistent and in range
+func (c *Config) Validate() error {
+	const portErr = "%v (%d) have to be between 1 and 65535"
+	if c.GRPCPort < 1 || c.GRPCPort > 65535 {
+		return fmt.Errorf(portErr, "grpcport", c.GRPCPort)
+	}
+	if c.HTTPPort < 1 || c.HTTPPort > 65535 {
+		return fmt.Errorf(portErr, "httpport", c.HTTPPort)
+	}
+	if c.GRPCPort == c.HTTPPort {
+		return fmt.Errorf("grpcport and httpport must differ, both are %d", c.GRPCPort)
+	}
+	if c.Linux.VxlanPort < 1 || c.Linux.VxlanPort > 65535 {
+		return fmt.Errorf(portErr, "linux.vxlanport", c.Linux.VxlanPort)
+	}
+	const emptyErr = "empty %v is not allowed"
+	switch c.Database.Type {
+	case MemoryStore:
+	case RedisStore, EtcdStore:
+		if c.Database.Address == "" {
+			return fmt.Errorf(emptyErr, "database.address")
+		}
+	case BoltStore:
+		if c.Database.Path == "" {
+			return fmt.Errorf(emptyErr, "database.path")
+		}
+	default:
+		return fmt.Errorf("database.type (%v) have to be one of %v, %v, %v or %v",
+			c.Database.Type, MemoryStore, RedisStore, BoltStore, EtcdStore)
+	}
+	if c.Frr.Address == "" {
+		return fmt.Errorf(emptyErr, "frr.address")
+	}
+	if c.Frr.Password == "" {
+		return fmt.Errorf(emptyErr, "frr.password")
+	}
+	if c.Linux.TenantBridge == "" {
+		return fmt.Errorf(emptyErr, "linux.tenantbridge")
+	}
+	// see IFNAMSIZ in linux/if.h, includes trailing zero byte
+	if len(c.Linux.TenantBridge) > 15 {
+		return fmt.Errorf("linux.tenantbridge (%v) is longer than 15 characters", c.Linux.TenantBridge)
+	}
+	switch c.Linux.VxlanMode {
+	case PerVniVxlan:
+	case SingleVxlan:
+		if c.Linux.VxlanDevice == "" {
+			return fmt.Errorf(emptyErr, "linux.vxlandevice")
+		}
+	default:
+		return fmt.Errorf("linux.vxlanmode (%v) have to be one of %v or %v",
+			c.Linux.VxlanMode, PerVniVxlan, SingleVxlan)
+	}
+	if len(c.Linux.VxlanDevice) > 15 {
+		return fmt.Errorf("linux.vxlandevice (%v) is longer than 15 characters", c.Linux.VxlanDevice)
+	}
+	if c.Frr.LocalAS == 0 {
+		return errors.New("frr.localas have to be a non-zero AS number")
+	}
+	if c.Pagination.DefaultPageSize < 1 {
+		return fmt.Errorf("pagination.defaultpagesize (%d) have to be positive", c.Pagination.DefaultPageSize)
+	}
+	if c.Pagination.MaxPageSize < c.Pagination.DefaultPageSize {
+		return fmt.Errorf("pagination.maxpagesize (%d) have to be at least pagination.defaultpagesize (%d)",
+			c.Pagination.MaxPageSize, c.Pagination.DefaultPageSize)
+	}
+	if c.HTTP.ReadTimeout <= 0 {
+		return fmt.Errorf("http.readtimeout (%v) have to be positive", c.HTTP.ReadTimeout)
+	}
+	if c.HTTP.WriteTimeout <= 0 {
+		return fmt.Errorf("http.writetimeout (%v) have to be positive", c.HTTP.WriteTimeout)
+	}
+	if c.TLSFiles != "" && c.HTTP.ClientTLSFiles == "" {
+		return errors.New("http.clienttlsfiles is required with tlsfiles, the gateway needs its own client certificate")
+	}
+	if c.TLSReloadInterval < 0 {
+		return fmt.Errorf("tlsreloadinterval (%v) can not be negative", c.TLSReloadInterval)
+	}
+	if c.Audit.MaxEntries < 1 {
+		return fmt.Errorf("audit.maxentries (%d) have to be positive", c.Audit.MaxEntries)
+	}
+	if err := c.RateLimit.validate(); err != nil {
+		return err
+	}
+	if c.ShutdownTimeout <= 0 {
+		return fmt.Errorf("shutdowntimeout (%v) have to be positive", c.ShutdownTimeout)
+	}
+	return c.Authorization.validate(c.TLSFiles)
+}
+
+func (a *AuthorizationConfig) validate(tlsFiles string) error {
+	if !a.Enabled {
+		return nil
+	}
+	if tlsFiles == "" {
+		return errors.New("authorization requires client certificates, set tlsfiles")
+	}
+	switch a.GatewayRole {
+	case "", RoleReadOnly, RoleTenantAdmin, RoleGlobalAdmin:
+	default:
+		return fmt.Errorf("authorization.gatewayrole (%v) have to be empty or one of %v, %v or %v",
+			a.GatewayRole, RoleReadOnly, RoleTenantAdmin, RoleGlobalAdmin)
+	}
+	for i, b := range a.Bindings {
+		switch b.Role {
+		case RoleReadOnly, RoleTenantAdmin:
+		case RoleGlobalAdmin:
+			if len(b.Prefixes) != 0 {
+				return fmt.Errorf("authorization.bindings[%d] of role %v can not have prefixes", i, b.Role)
+			}
+		default:
+			return fmt.Errorf("authorization.bindings[%d].role (%v) have to be one of %v, %v or %v",
+				i, b.Role, RoleReadOnly, RoleTenantAdmin, RoleGlobalAdmin)
+		}
+		if len(b.Subjects) == 0 && len(b.SANs) == 0 {
+			return fmt.Errorf("authorization.bindings[%d] has neither subjects nor sans", i)
+		}
+		for _, prefix := range b.Prefixes {
+			if !strings.HasPrefix(prefix, "//network.opiproject.org/") {
+				return fmt.Errorf("authorization.bindings[%d].prefixes (%v) have to start with //network.opiproject.org/", i, prefix)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *RateLimitConfig) validate() error {
+	if r.Rate < 0 {
+		return fmt.Errorf("ratelimit.rate (%v) can not be negative", r.Rate)
+	}
+	if r.Rate > 0 && r.Burst < 1 {
+		return fmt.Errorf("ratelimit.burst (%d) have to be positive", r.Burst)
+	}
+	if r.ClientRate < 0 {
+		return fmt.Errorf("ratelimit.clientrate (%v) can not be negative", r.ClientRate)
+	}
+	if r.ClientRate > 0 && r.ClientBurst < 1 {
+		return fmt.Errorf("ratelimit.clientburst (%d) have to be positive", r.ClientBurst)
+	}
+	return nil
+}
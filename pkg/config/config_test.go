@@ -0,0 +1,314 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package config holds the runtime configuration of the application
+package config
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestConfig_LoadConfig(t *testing.T) {
+	tests := map[string]struct {
+		path        string
+		file        string
+		readFileErr error
+		env         map[string]string
+		expectErr   bool
+		check       func(cfg Config) bool
+	}{
+		"no file and no environment": {
+			check: func(cfg Config) bool { return reflect.DeepEqual(cfg, Default()) },
+		},
+		"failed to read file": {
+			path:        "a.yaml",
+			readFileErr: errors.New("Failed to read file"),
+			expectErr:   true,
+		},
+		"empty file": {
+			path:  "a.yaml",
+			file:  "",
+			check: func(cfg Config) bool { return reflect.DeepEqual(cfg, Default()) },
+		},
+		"yaml file": {
+			path: "a.yaml",
+			file: "grpcport: 1234\nlinux:\n  tenantbridge: br-test\nhttp:\n  readtimeout: 7s\n",
+			check: func(cfg Config) bool {
+				return cfg.GRPCPort == 1234 && cfg.Linux.TenantBridge == "br-test" &&
+					cfg.HTTP.ReadTimeout == 7*time.Second && cfg.Linux.VxlanPort == 4789
+			},
+		},
+		"json file": {
+			path: "a.json",
+			file: `{"frr": {"localas": 65123, "password": "secret"}}`,
+			check: func(cfg Config) bool {
+				return cfg.Frr.LocalAS == 65123 && cfg.Frr.Password == "secret" && cfg.Frr.Address == "127.0.0.1"
+			},
+		},
+		"unknown field in file": {
+			path:      "a.yaml",
+			file:      "grpc_port: 1234\n",
+			expectErr: true,
+		},
+		"malformed file": {
+			path:      "a.yaml",
+			file:      "grpcport: [",
+			expectErr: true,
+		},
+		"environment overrides file": {
+			path: "a.yaml",
+			file: "grpcport: 1234\nlinux:\n  vxlanport: 4790\n",
+			env: map[string]string{
+				"OPI_EVPN_GRPC_PORT":          "4321",
+				"OPI_EVPN_TENANT_BRIDGE":      "br-env",
+				"OPI_EVPN_FRR_LOCAL_AS":       "65001",
+				"OPI_EVPN_HTTP_WRITE_TIMEOUT": "1m",
+			},
+			check: func(cfg Config) bool {
+				return cfg.GRPCPort == 4321 && cfg.Linux.VxlanPort == 4790 && cfg.Linux.TenantBridge == "br-env" &&
+					cfg.Frr.LocalAS == 65001 && cfg.HTTP.WriteTimeout == time.Minute
+			},
+		},
+		"invalid integer in environment": {
+			env:       map[string]string{"OPI_EVPN_HTTP_PORT": "http"},
+			expectErr: true,
+		},
+		"invalid AS in environment": {
+			env:       map[string]string{"OPI_EVPN_FRR_LOCAL_AS": "-1"},
+			expectErr: true,
+		},
+		"authorization in file": {
+			path: "a.yaml",
+			file: "authorization:\n  enabled: true\n  bindings:\n  - role: tenant-admin\n    sans: [tenant-a.example]\n    prefixes: [//network.opiproject.org/vrfs/tenant-a-]\n",
+			check: func(cfg Config) bool {
+				return cfg.Authorization.Enabled && len(cfg.Authorization.Bindings) == 1 &&
+					cfg.Authorization.Bindings[0].SANs[0] == "tenant-a.example"
+			},
+		},
+		"invalid boolean in environment": {
+			env:       map[string]string{"OPI_EVPN_AUTHZ_ENABLED": "maybe"},
+			expectErr: true,
+		},
+		"rate limit in environment": {
+			env: map[string]string{"OPI_EVPN_RATE_LIMIT": "12.5", "OPI_EVPN_CLIENT_RATE_BURST": "3"},
+			check: func(cfg Config) bool {
+				return cfg.RateLimit.Rate == 12.5 && cfg.RateLimit.ClientBurst == 3 && cfg.RateLimit.Burst == 100
+			},
+		},
+		"invalid float in environment": {
+			env:       map[string]string{"OPI_EVPN_CLIENT_RATE_LIMIT": "fast"},
+			expectErr: true,
+		},
+		"invalid duration in environment": {
+			env:       map[string]string{"OPI_EVPN_HTTP_READ_TIMEOUT": "5"},
+			expectErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg, err := loadConfig(tt.path, func(string) ([]byte, error) {
+				return []byte(tt.file), tt.readFileErr
+			}, func(key string) (string, bool) {
+				value, ok := tt.env[key]
+				return value, ok
+			})
+
+			if (err != nil) != tt.expectErr {
+				t.Error("Expect error", tt.expectErr, "received", err)
+			}
+			if !tt.expectErr && !tt.check(cfg) {
+				t.Error("Unexpected config", cfg)
+			}
+		})
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := map[string]struct {
+		modify    func(cfg *Config)
+		expectErr bool
+	}{
+		"defaults": {
+			modify:    func(cfg *Config) {},
+			expectErr: false,
+		},
+		"grpc port out of range": {
+			modify:    func(cfg *Config) { cfg.GRPCPort = 65536 },
+			expectErr: true,
+		},
+		"http port out of range": {
+			modify:    func(cfg *Config) { cfg.HTTPPort = 0 },
+			expectErr: true,
+		},
+		"same grpc and http port": {
+			modify:    func(cfg *Config) { cfg.HTTPPort = cfg.GRPCPort },
+			expectErr: true,
+		},
+		"vxlan port out of range": {
+			modify:    func(cfg *Config) { cfg.Linux.VxlanPort = -1 },
+			expectErr: true,
+		},
+		"empty database address": {
+			modify:    func(cfg *Config) { cfg.Database.Address = "" },
+			expectErr: true,
+		},
+		"unknown database type": {
+			modify:    func(cfg *Config) { cfg.Database.Type = "mysql" },
+			expectErr: true,
+		},
+		"memory database without address": {
+			modify:    func(cfg *Config) { cfg.Database = DatabaseConfig{Type: MemoryStore} },
+			expectErr: false,
+		},
+		"bolt database without path": {
+			modify:    func(cfg *Config) { cfg.Database = DatabaseConfig{Type: BoltStore} },
+			expectErr: true,
+		},
+		"etcd database without address": {
+			modify:    func(cfg *Config) { cfg.Database = DatabaseConfig{Type: EtcdStore} },
+			expectErr: true,
+		},
+		"empty frr address": {
+			modify:    func(cfg *Config) { cfg.Frr.Address = "" },
+			expectErr: true,
+		},
+		"empty frr password": {
+			modify:    func(cfg *Config) { cfg.Frr.Password = "" },
+			expectErr: true,
+		},
+		"zero local AS": {
+			modify:    func(cfg *Config) { cfg.Frr.LocalAS = 0 },
+			expectErr: true,
+		},
+		"empty tenant bridge": {
+			modify:    func(cfg *Config) { cfg.Linux.TenantBridge = "" },
+			expectErr: true,
+		},
+		"too long tenant bridge": {
+			modify:    func(cfg *Config) { cfg.Linux.TenantBridge = "br-tenant-too-long" },
+			expectErr: true,
+		},
+		"unknown vxlan mode": {
+			modify:    func(cfg *Config) { cfg.Linux.VxlanMode = "shared" },
+			expectErr: true,
+		},
+		"single vxlan mode without device": {
+			modify:    func(cfg *Config) { cfg.Linux.VxlanMode, cfg.Linux.VxlanDevice = SingleVxlan, "" },
+			expectErr: true,
+		},
+		"zero default page size": {
+			modify:    func(cfg *Config) { cfg.Pagination.DefaultPageSize = 0 },
+			expectErr: true,
+		},
+		"max page size below default": {
+			modify:    func(cfg *Config) { cfg.Pagination.MaxPageSize = cfg.Pagination.DefaultPageSize - 1 },
+			expectErr: true,
+		},
+		"zero read timeout": {
+			modify:    func(cfg *Config) { cfg.HTTP.ReadTimeout = 0 },
+			expectErr: true,
+		},
+		"negative write timeout": {
+			modify:    func(cfg *Config) { cfg.HTTP.WriteTimeout = -time.Second },
+			expectErr: true,
+		},
+		"negative tls reload interval": {
+			modify:    func(cfg *Config) { cfg.TLSReloadInterval = -time.Second },
+			expectErr: true,
+		},
+		"zero audit entries": {
+			modify:    func(cfg *Config) { cfg.Audit.MaxEntries = 0 },
+			expectErr: true,
+		},
+		"negative rate limit": {
+			modify:    func(cfg *Config) { cfg.RateLimit.Rate = -1 },
+			expectErr: true,
+		},
+		"rate limit without burst": {
+			modify:    func(cfg *Config) { cfg.RateLimit.Rate, cfg.RateLimit.Burst = 10, 0 },
+			expectErr: true,
+		},
+		"client rate limit without burst": {
+			modify:    func(cfg *Config) { cfg.RateLimit.ClientRate, cfg.RateLimit.ClientBurst = 10, 0 },
+			expectErr: true,
+		},
+		"authorization without tls": {
+			modify:    func(cfg *Config) { cfg.Authorization.Enabled = true },
+			expectErr: true,
+		},
+		"authorization with unknown role": {
+			modify: func(cfg *Config) {
+				cfg.TLSFiles, cfg.HTTP.ClientTLSFiles = "a:b:c", "d:e"
+				cfg.Authorization = AuthorizationConfig{Enabled: true, Bindings: []RoleBinding{
+					{Role: "root", Subjects: []string{"*"}},
+				}}
+			},
+			expectErr: true,
+		},
+		"authorization binding without identity": {
+			modify: func(cfg *Config) {
+				cfg.TLSFiles, cfg.HTTP.ClientTLSFiles = "a:b:c", "d:e"
+				cfg.Authorization = AuthorizationConfig{Enabled: true, Bindings: []RoleBinding{
+					{Role: RoleReadOnly},
+				}}
+			},
+			expectErr: true,
+		},
+		"global admin with prefixes": {
+			modify: func(cfg *Config) {
+				cfg.TLSFiles, cfg.HTTP.ClientTLSFiles = "a:b:c", "d:e"
+				cfg.Authorization = AuthorizationConfig{Enabled: true, Bindings: []RoleBinding{
+					{Role: RoleGlobalAdmin, Subjects: []string{"CN=admin"}, Prefixes: []string{"//network.opiproject.org/vrfs/"}},
+				}}
+			},
+			expectErr: true,
+		},
+		"malformed prefix": {
+			modify: func(cfg *Config) {
+				cfg.TLSFiles, cfg.HTTP.ClientTLSFiles = "a:b:c", "d:e"
+				cfg.Authorization = AuthorizationConfig{Enabled: true, Bindings: []RoleBinding{
+					{Role: RoleTenantAdmin, SANs: []string{"tenant-a.example"}, Prefixes: []string{"vrfs/tenant-a"}},
+				}}
+			},
+			expectErr: true,
+		},
+		"valid authorization": {
+			modify: func(cfg *Config) {
+				cfg.TLSFiles, cfg.HTTP.ClientTLSFiles = "a:b:c", "d:e"
+				cfg.Authorization = AuthorizationConfig{Enabled: true, Bindings: []RoleBinding{
+					{Role: RoleGlobalAdmin, Subjects: []string{"CN=admin"}},
+					{Role: RoleTenantAdmin, SANs: []string{"tenant-a.example"}, Prefixes: []string{"//network.opiproject.org/vrfs/tenant-a-"}},
+				}}
+			},
+			expectErr: false,
+		},
+		"tls without gateway client certificate": {
+			modify:    func(cfg *Config) { cfg.TLSFiles = "a:b:c" },
+			expectErr: true,
+		},
+		"unknown gateway role": {
+			modify: func(cfg *Config) {
+				cfg.TLSFiles, cfg.HTTP.ClientTLSFiles = "a:b:c", "d:e"
+				cfg.Authorization = AuthorizationConfig{Enabled: true, GatewayRole: "root"}
+			},
+			expectErr: true,
+		},
+		"zero shutdown timeout": {
+			modify:    func(cfg *Config) { cfg.ShutdownTimeout = 0 },
+			expectErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := Default()
+			tt.modify(&cfg)
+			err := cfg.Validate()
+			if (err != nil) != tt.expectErr {
+				t.Error("Expect error", tt.expectErr, "received", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package port is the main package of the application
+package port
+
+import (
+	"context"
+	"fmt"
+
+	"go.einride.tech/aip/resourceid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+)
+
+// BatchCreateBridgePorts validates all requests, including the referenced
+// LogicalBridges, then creates the BridgePorts through a single netlink socket
+func (s *Server) BatchCreateBridgePorts(ctx context.Context, in *pa.BatchCreateBridgePortsRequest) (*pa.BatchCreateBridgePortsResponse, error) {
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.BatchCreateBridgePorts(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
+	created := make([]*pb.BridgePort, len(in.Requests))
+	steps := make([]utils.BatchStep, len(in.Requests))
+	names := make(map[string]bool)
+	for i, req := range in.Requests {
+		i, req := i, req
+		if req.GetBridgePortId() != "" {
+			steps[i].Name = resourceIDToFullName(req.BridgePortId)
+		}
+		steps[i].Invalid = s.ValidateCreateBridgePortRequest(req)
+		if steps[i].Invalid != nil {
+			continue
+		}
+		// name system generated ids now, so every request has a name to report
+		if req.BridgePortId == "" {
+			req.BridgePortId = resourceid.NewSystemGenerated()
+		}
+		steps[i].Name = resourceIDToFullName(req.BridgePortId)
+		steps[i].Invalid = utils.CheckBatchDuplicate(names, steps[i].Name)
+		if steps[i].Invalid == nil {
+			steps[i].Invalid = s.checkLogicalBridges(req.BridgePort.Spec.LogicalBridges)
+		}
+		steps[i].Apply = func(ctx context.Context) (func(context.Context) error, error) {
+			obj, isNew, err := s.createBridgePort(ctx, req)
+			created[i] = obj
+			if err != nil || !isNew {
+				return nil, err
+			}
+			return func(ctx context.Context) error { return s.deleteBridgePort(ctx, obj) }, nil
+		}
+	}
+	// serialize writers, so other calls do not interleave with the batch
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	results, err := utils.RunBatch(ctx, steps, in.AllOrNothing)
+	if err != nil {
+		return nil, err
+	}
+	response := &pa.BatchCreateBridgePortsResponse{Results: results}
+	for _, obj := range created {
+		if obj != nil {
+			response.BridgePorts = append(response.BridgePorts, obj)
+		}
+	}
+	return response, nil
+}
+
+// BatchDeleteBridgePorts validates all requests, then deletes the BridgePorts
+// through a single netlink socket. Etags are not checked.
+func (s *Server) BatchDeleteBridgePorts(ctx context.Context, in *pa.BatchDeleteBridgePortsRequest) (*pa.BatchDeleteBridgePortsResponse, error) {
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.BatchDeleteBridgePorts(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
+	// serialize writers, so the validated objects stay as they are
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	steps := make([]utils.BatchStep, len(in.Requests))
+	names := make(map[string]bool)
+	for i, req := range in.Requests {
+		steps[i].Name = req.GetName()
+		steps[i].Invalid = s.validateDeleteBridgePortRequest(req)
+		if steps[i].Invalid == nil {
+			steps[i].Invalid = utils.CheckBatchDuplicate(names, req.Name)
+		}
+		if steps[i].Invalid != nil {
+			continue
+		}
+		// fetch object from the database
+		obj := new(pb.BridgePort)
+		ok, err := s.store.Get(req.Name, obj)
+		switch {
+		case err != nil:
+			fmt.Printf("Failed to interact with store: %v", err)
+			steps[i].Invalid = err
+		case !ok && !req.AllowMissing:
+			steps[i].Invalid = status.Errorf(codes.NotFound, "unable to find key %s", req.Name)
+		case !ok:
+			steps[i].Apply = func(context.Context) (func(context.Context) error, error) { return nil, nil }
+		default:
+			steps[i].Apply = func(ctx context.Context) (func(context.Context) error, error) {
+				if err := s.deleteBridgePort(ctx, obj); err != nil {
+					return nil, err
+				}
+				return func(ctx context.Context) error { return s.restoreBridgePort(ctx, obj) }, nil
+			}
+		}
+	}
+	results, err := utils.RunBatch(ctx, steps, in.AllOrNothing)
+	if err != nil {
+		return nil, err
+	}
+	return &pa.BatchDeleteBridgePortsResponse{Results: results}, nil
+}
+
+// checkLogicalBridges fails with NotFound when a referenced LogicalBridge is not stored
+func (s *Server) checkLogicalBridges(names []string) error {
+	for _, name := range names {
+		ok, err := s.store.Get(name, new(pb.LogicalBridge))
+		if err != nil {
+			fmt.Printf("Failed to interact with store: %v", err)
+			return err
+		}
+		if !ok {
+			return status.Errorf(codes.NotFound, "unable to find key %s", name)
+		}
+	}
+	return nil
+}
+
+// restoreBridgePort configures and stores a deleted BridgePort again, it
+// creates its bond or virtual device again, other interfaces stayed on delete
+func (s *Server) restoreBridgePort(ctx context.Context, obj *pb.BridgePort) error {
+	in := &pb.CreateBridgePortRequest{BridgePort: utils.ProtoClone(obj)}
+	if err := s.netlinkCreateBridgePort(ctx, in); err != nil {
+		return err
+	}
+	if err := s.frrCreateBridgePort(ctx, obj); err != nil {
+		return err
+	}
+	s.ListHelper[obj.Name] = false
+	return s.store.Set(obj.Name, obj)
+}
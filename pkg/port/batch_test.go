@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package port is the main package of the application
+package port
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/vishvananda/netlink"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils/mocks"
+)
+
+func resultCodes(results []*pa.BatchResult) []codes.Code {
+	var out []codes.Code
+	for _, r := range results {
+		out = append(out, codes.Code(r.Code))
+	}
+	return out
+}
+
+// expectCreate expects the netlink calls creating the test port
+func expectCreate(mockNetlink *mocks.Netlink) *netlink.Dummy {
+	bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: tenantbridgeName}}
+	mockNetlink.EXPECT().LinkByName(mock.Anything, tenantbridgeName).Return(bridge, nil).Once()
+	iface := &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: testBridgePortID}}
+	mockNetlink.EXPECT().LinkByName(mock.Anything, testBridgePortID).Return(iface, nil).Once()
+	mac := net.HardwareAddr(testBridgePort.Spec.MacAddress[:])
+	mockNetlink.EXPECT().LinkSetHardwareAddr(mock.Anything, iface, mac).Return(nil).Once()
+	mockNetlink.EXPECT().LinkSetMaster(mock.Anything, iface, bridge).Return(nil).Once()
+	vid := uint16(testLogicalBridge.Spec.VlanId)
+	mockNetlink.EXPECT().BridgeVlanAdd(mock.Anything, iface, vid, false, false, false, false).Return(nil).Once()
+	mockNetlink.EXPECT().LinkSetUp(mock.Anything, iface).Return(nil).Once()
+	return iface
+}
+
+func Test_BatchCreateBridgePorts(t *testing.T) {
+	missingBridge := proto.Clone(&testBridgePort).(*pb.BridgePort)
+	missingBridge.Spec.LogicalBridges = []string{"//network.opiproject.org/bridges/missing"}
+	tests := map[string]struct {
+		in           []*pb.CreateBridgePortRequest
+		allOrNothing bool
+		results      []codes.Code
+		errCode      codes.Code
+		stored       []string
+		on           func(mockNetlink *mocks.Netlink)
+	}{
+		"missing logical bridge reported": {
+			in: []*pb.CreateBridgePortRequest{
+				{BridgePortId: testBridgePortID, BridgePort: &testBridgePort},
+				{BridgePortId: "port-b", BridgePort: missingBridge},
+			},
+			results: []codes.Code{codes.OK, codes.NotFound},
+			errCode: codes.OK,
+			stored:  []string{testBridgePortName},
+			on:      func(mockNetlink *mocks.Netlink) { expectCreate(mockNetlink) },
+		},
+		"missing logical bridge fails all or nothing": {
+			in: []*pb.CreateBridgePortRequest{
+				{BridgePortId: testBridgePortID, BridgePort: &testBridgePort},
+				{BridgePortId: "port-b", BridgePort: missingBridge},
+			},
+			allOrNothing: true,
+			errCode:      codes.NotFound,
+		},
+		"failure undoes applied requests": {
+			in: []*pb.CreateBridgePortRequest{
+				{BridgePortId: testBridgePortID, BridgePort: &testBridgePort},
+				{BridgePortId: "port-b", BridgePort: &testBridgePort},
+			},
+			allOrNothing: true,
+			errCode:      codes.NotFound,
+			on: func(mockNetlink *mocks.Netlink) {
+				iface := expectCreate(mockNetlink)
+				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: tenantbridgeName}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, tenantbridgeName).Return(bridge, nil).Once()
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "port-b").Return(nil, errors.New("Failed to call LinkByName")).Once()
+				// undo the first port
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testBridgePortID).Return(iface, nil).Once()
+				mockNetlink.EXPECT().LinkSetDown(mock.Anything, iface).Return(nil).Once()
+				vid := uint16(testLogicalBridge.Spec.VlanId)
+				mockNetlink.EXPECT().BridgeVlanDel(mock.Anything, iface, vid, true, true, false, false).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetNoMaster(mock.Anything, iface).Return(nil).Once()
+			},
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pa.NewBridgePortBatchServiceClient(env.conn)
+
+			_ = env.opi.store.Set(testLogicalBridgeName, &testLogicalBridgeWithStatus)
+			if tt.on != nil {
+				tt.on(env.mockNetlink)
+			}
+
+			request := &pa.BatchCreateBridgePortsRequest{Requests: tt.in, AllOrNothing: tt.allOrNothing}
+			response, err := client.BatchCreateBridgePorts(ctx, request)
+			if status.Code(err) != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", err)
+			}
+			if got := resultCodes(response.GetResults()); fmt.Sprint(got) != fmt.Sprint(tt.results) {
+				t.Error("results: expected", tt.results, "received", got)
+			}
+			if len(response.GetBridgePorts()) != len(tt.stored) {
+				t.Error("response: expected", len(tt.stored), "ports, received", response.GetBridgePorts())
+			}
+			if len(env.opi.ListHelper) != len(tt.stored) {
+				t.Error("stored: expected", tt.stored, "received", env.opi.ListHelper)
+			}
+		})
+	}
+}
+
+func Test_BatchDeleteBridgePorts(t *testing.T) {
+	tests := map[string]struct {
+		in           []*pb.DeleteBridgePortRequest
+		allOrNothing bool
+		results      []codes.Code
+		errCode      codes.Code
+		stored       int
+		on           func(mockNetlink *mocks.Netlink)
+	}{
+		"deleted and missing": {
+			in: []*pb.DeleteBridgePortRequest{
+				{Name: testBridgePortName},
+				{Name: resourceIDToFullName("missing"), AllowMissing: true},
+				{Name: resourceIDToFullName("missing-b")},
+			},
+			results: []codes.Code{codes.OK, codes.OK, codes.NotFound},
+			errCode: codes.OK,
+			stored:  0,
+			on: func(mockNetlink *mocks.Netlink) {
+				iface := &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: testBridgePortID}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testBridgePortID).Return(iface, nil).Once()
+				mockNetlink.EXPECT().LinkSetDown(mock.Anything, iface).Return(nil).Once()
+				vid := uint16(testLogicalBridge.Spec.VlanId)
+				mockNetlink.EXPECT().BridgeVlanDel(mock.Anything, iface, vid, true, true, false, false).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetNoMaster(mock.Anything, iface).Return(nil).Once()
+			},
+		},
+		"duplicate fails all or nothing": {
+			in: []*pb.DeleteBridgePortRequest{
+				{Name: testBridgePortName},
+				{Name: testBridgePortName},
+			},
+			allOrNothing: true,
+			errCode:      codes.InvalidArgument,
+			stored:       1,
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pa.NewBridgePortBatchServiceClient(env.conn)
+
+			_ = env.opi.store.Set(testLogicalBridgeName, &testLogicalBridgeWithStatus)
+			_ = env.opi.store.Set(testBridgePortName, &testBridgePortWithStatus)
+			env.opi.ListHelper[testBridgePortName] = false
+			if tt.on != nil {
+				tt.on(env.mockNetlink)
+			}
+
+			request := &pa.BatchDeleteBridgePortsRequest{Requests: tt.in, AllOrNothing: tt.allOrNothing}
+			response, err := client.BatchDeleteBridgePorts(ctx, request)
+			if status.Code(err) != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", err)
+			}
+			if got := resultCodes(response.GetResults()); fmt.Sprint(got) != fmt.Sprint(tt.results) {
+				t.Error("results: expected", tt.results, "received", got)
+			}
+			if len(env.opi.ListHelper) != tt.stored {
+				t.Error("stored: expected", tt.stored, "received", env.opi.ListHelper)
+			}
+		})
+	}
+}
@@ -0,0 +1,268 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package port is the main package of the application
+package port
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+)
+
+// defaultBondMode is the bonding mode of hosts attached with LACP
+const defaultBondMode = "802.3ad"
+
+// bondMode returns the bonding mode of the options, 802.3ad when not set
+func bondMode(options *pa.BondOptions) string {
+	if options.GetMode() == "" {
+		return defaultBondMode
+	}
+	return options.Mode
+}
+
+// newBond returns the bond device of a BridgePort with the parameters of the options
+func newBond(name string, options *pa.BondOptions) *netlink.Bond {
+	bond := netlink.NewLinkBond(netlink.LinkAttrs{Name: name})
+	bond.Mode = netlink.StringToBondMode(bondMode(options))
+	if options.LacpRate != "" {
+		bond.LacpRate = netlink.StringToBondLacpRate(options.LacpRate)
+	}
+	if options.XmitHashPolicy != "" {
+		bond.XmitHashPolicy = netlink.StringToBondXmitHashPolicy(options.XmitHashPolicy)
+	}
+	if options.MinLinks > 0 {
+		bond.MinLinks = int(options.MinLinks)
+	}
+	return bond
+}
+
+// netlinkCreateBond creates the bond of a BridgePort and enslaves its members,
+// the bond is deleted again when a member can not be enslaved
+func (s *Server) netlinkCreateBond(ctx context.Context, name string, options *pa.BondOptions) error {
+	bond := newBond(name, options)
+	// Example: ip link add bond1 type bond mode 802.3ad
+	if err := s.nLink.LinkAdd(ctx, bond); err != nil {
+		fmt.Printf("Failed to create bond: %v", err)
+		return err
+	}
+	if err := s.netlinkAddBondMembers(ctx, bond, options.Members); err != nil {
+		// Example: ip link del bond1
+		if err := s.nLink.LinkDel(ctx, bond); err != nil {
+			log.Printf("Failed to delete bond %v: %v", name, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// netlinkUndoBond deletes the bond of a BridgePort which could not be created
+// after all, its members are released first. Errors are only logged, the
+// error of the create is returned.
+func (s *Server) netlinkUndoBond(ctx context.Context, name string, options *pa.BondOptions) {
+	if err := s.netlinkDeleteBondMembers(ctx, options.Members); err != nil {
+		log.Printf("Failed to release members of bond %v: %v", name, err)
+	}
+	// Example: ip link del bond1
+	if err := s.nLink.LinkDel(ctx, newBond(name, options)); err != nil {
+		log.Printf("Failed to delete bond %v: %v", name, err)
+	}
+}
+
+// netlinkAddBondMembers enslaves the member interfaces to the bond,
+// they have to be down to be enslaved. On error the members enslaved
+// so far are released again.
+func (s *Server) netlinkAddBondMembers(ctx context.Context, bond *netlink.Bond, members []string) error {
+	var enslaved []netlink.Link
+	for _, name := range members {
+		member, err := s.nLink.LinkByName(ctx, name)
+		if err != nil {
+			s.netlinkReleaseBondMembers(ctx, enslaved)
+			err := status.Errorf(codes.NotFound, "unable to find key %s", name)
+			return err
+		}
+		// Example: ip link set eth2 down
+		if err := s.nLink.LinkSetDown(ctx, member); err != nil {
+			fmt.Printf("Failed to down link: %v", err)
+			s.netlinkReleaseBondMembers(ctx, enslaved)
+			return err
+		}
+		// Example: ip link set eth2 master bond1
+		if err := s.nLink.LinkSetBondSlave(ctx, member, bond); err != nil {
+			fmt.Printf("Failed to add member to bond: %v", err)
+			s.netlinkReleaseBondMembers(ctx, enslaved)
+			return err
+		}
+		enslaved = append(enslaved, member)
+		// Example: ip link set eth2 up
+		if err := s.nLink.LinkSetUp(ctx, member); err != nil {
+			fmt.Printf("Failed to up link: %v", err)
+			s.netlinkReleaseBondMembers(ctx, enslaved)
+			return err
+		}
+	}
+	return nil
+}
+
+// netlinkReleaseBondMembers releases the members enslaved by a failed change
+// of a bond, errors are only logged, the error of the change is returned
+func (s *Server) netlinkReleaseBondMembers(ctx context.Context, members []netlink.Link) {
+	for _, member := range members {
+		// Example: ip link set eth2 nomaster
+		if err := s.nLink.LinkSetNoMaster(ctx, member); err != nil {
+			log.Printf("Failed to release member %v from bond: %v", member.Attrs().Name, err)
+		}
+	}
+}
+
+// netlinkDeleteBondMembers releases the member interfaces from their bond,
+// members which are gone, like a removed NIC, have nothing to release
+func (s *Server) netlinkDeleteBondMembers(ctx context.Context, members []string) error {
+	for _, name := range members {
+		member, err := s.nLink.LinkByName(ctx, name)
+		if err != nil {
+			log.Printf("Skipping missing member %v of bond: %v", name, err)
+			continue
+		}
+		// Example: ip link set eth2 nomaster
+		if err := s.nLink.LinkSetNoMaster(ctx, member); err != nil {
+			fmt.Printf("Failed to delete member from bond: %v", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// netlinkUpdateBond enslaves the added members to the bond of an existing
+// BridgePort and releases the removed ones
+func (s *Server) netlinkUpdateBond(ctx context.Context, port *pb.BridgePort, oldBond, bond *pa.BondOptions) error {
+	added, removed := diffMembers(oldBond.GetMembers(), bond.GetMembers())
+	if err := s.netlinkDeleteBondMembers(ctx, removed); err != nil {
+		return err
+	}
+	if len(added) == 0 {
+		return nil
+	}
+	resourceID := path.Base(port.Name)
+	link, err := s.nLink.LinkByName(ctx, resourceID)
+	if err != nil {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", resourceID)
+		return err
+	}
+	master, ok := link.(*netlink.Bond)
+	if !ok {
+		err := status.Errorf(codes.FailedPrecondition, "interface %s is not a bond", resourceID)
+		return err
+	}
+	return s.netlinkAddBondMembers(ctx, master, added)
+}
+
+// diffMembers returns the members added to and removed from a bond
+func diffMembers(oldMembers, members []string) (added, removed []string) {
+	for _, name := range members {
+		if !contains(oldMembers, name) {
+			added = append(added, name)
+		}
+	}
+	for _, name := range oldMembers {
+		if !contains(members, name) {
+			removed = append(removed, name)
+		}
+	}
+	return added, removed
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// checkBondChange rejects changes of an existing bond other than its members,
+// the kernel fixes the mode while the bond has members
+func checkBondChange(portName string, oldBond, bond *pa.BondOptions) error {
+	if (oldBond == nil) != (bond == nil) || !proto.Equal(withoutMembers(oldBond), withoutMembers(bond)) {
+		err := status.Errorf(codes.FailedPrecondition, "bond of %s only changes its members while the BridgePort exists", portName)
+		return err
+	}
+	return nil
+}
+
+func withoutMembers(bond *pa.BondOptions) *pa.BondOptions {
+	if bond == nil {
+		return nil
+	}
+	rest := proto.Clone(bond).(*pa.BondOptions)
+	rest.Members = nil
+	return rest
+}
+
+// checkBondMembers rejects members used by other BridgePorts, either as
+// their interface or as a member of their bond
+func (s *Server) checkBondMembers(options *pa.BridgePortOptions) error {
+	members := options.Spec.GetBond().GetMembers()
+	if len(members) == 0 {
+		return nil
+	}
+	portName := strings.TrimSuffix(options.Name, "/options")
+	// scan the store, BridgePorts created before a restart and options of
+	// BridgePorts created later are not in ListHelper
+	users := make(map[string]string)
+	for _, key := range utils.StoreKeys(s.store) {
+		name := strings.TrimSuffix(key, "/options")
+		if name == portName || !strings.HasPrefix(name, "//network.opiproject.org/ports/") {
+			continue
+		}
+		users[path.Base(name)] = name
+		if key == name {
+			continue
+		}
+		other, err := s.loadOptions(key)
+		if err != nil {
+			return err
+		}
+		for _, member := range other.Spec.GetBond().GetMembers() {
+			users[member] = name
+		}
+	}
+	for _, member := range members {
+		if user, ok := users[member]; ok {
+			err := status.Errorf(codes.FailedPrecondition, "interface %s is used by %s already", member, user)
+			return err
+		}
+	}
+	return nil
+}
+
+// readBondStatus reads the state of the members of a bond
+func (s *Server) readBondStatus(ctx context.Context, bond *pa.BondOptions) (*pa.BondStatus, error) {
+	bondStatus := &pa.BondStatus{}
+	for _, name := range bond.Members {
+		member, err := s.nLink.LinkByName(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		slave, ok := member.Attrs().Slave.(*netlink.BondSlave)
+		bondStatus.Members = append(bondStatus.Members, &pa.BondMemberStatus{
+			Name:   name,
+			OperUp: member.Attrs().OperState == netlink.OperUp,
+			Active: ok && slave.State == netlink.BondStateActive,
+		})
+	}
+	return bondStatus, nil
+}
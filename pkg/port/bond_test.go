@@ -0,0 +1,405 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package port is the main package of the application
+package port
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/vishvananda/netlink"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils/mocks"
+)
+
+var (
+	testBond    = &pa.BondOptions{Members: []string{"eth1", "eth2"}, LacpRate: "fast", XmitHashPolicy: "layer3+4", MinLinks: 1}
+	testMember1 = &netlink.Device{LinkAttrs: netlink.LinkAttrs{Name: "eth1", OperState: netlink.OperUp, Slave: &netlink.BondSlave{State: netlink.BondStateActive}}}
+	testMember2 = &netlink.Device{LinkAttrs: netlink.LinkAttrs{Name: "eth2", OperState: netlink.OperDown, Slave: &netlink.BondSlave{State: netlink.BondStateBackup}}}
+)
+
+// bondDevice matches the bond of the BridgePort with the parameters of testBond
+func bondDevice() interface{} {
+	return mock.MatchedBy(func(link netlink.Link) bool {
+		bond, ok := link.(*netlink.Bond)
+		return ok && bond.Name == testBridgePortID && bond.Mode == netlink.BOND_MODE_802_3AD &&
+			bond.LacpRate == netlink.BOND_LACP_RATE_FAST && bond.XmitHashPolicy == netlink.BOND_XMIT_HASH_POLICY_LAYER3_4 && bond.MinLinks == 1
+	})
+}
+
+func Test_CreateBridgePortBond(t *testing.T) {
+	tests := map[string]struct {
+		out     *pb.BridgePort
+		errCode codes.Code
+		errMsg  string
+		on      func(mockNetlink *mocks.Netlink, errMsg string)
+	}{
+		"failed LinkAdd call": {
+			errCode: codes.Unknown,
+			errMsg:  "Failed to call LinkAdd",
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: tenantbridgeName}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, tenantbridgeName).Return(bridge, nil).Once()
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, bondDevice()).Return(errors.New(errMsg)).Once()
+			},
+		},
+		"member does not exist": {
+			errCode: codes.NotFound,
+			errMsg:  "unable to find key eth1",
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: tenantbridgeName}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, tenantbridgeName).Return(bridge, nil).Once()
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, bondDevice()).Return(nil).Once()
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "eth1").Return(nil, errors.New(errMsg)).Once()
+				mockNetlink.EXPECT().LinkDel(mock.Anything, bondDevice()).Return(nil).Once()
+			},
+		},
+		"failed LinkSetBondSlave call": {
+			errCode: codes.Unknown,
+			errMsg:  "Failed to call LinkSetBondSlave",
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: tenantbridgeName}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, tenantbridgeName).Return(bridge, nil).Once()
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, bondDevice()).Return(nil).Once()
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "eth1").Return(testMember1, nil).Once()
+				mockNetlink.EXPECT().LinkSetDown(mock.Anything, testMember1).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetBondSlave(mock.Anything, testMember1, bondDevice()).Return(errors.New(errMsg)).Once()
+				mockNetlink.EXPECT().LinkDel(mock.Anything, bondDevice()).Return(nil).Once()
+			},
+		},
+		"failed LinkSetDown call of second member": {
+			errCode: codes.Unknown,
+			errMsg:  "Failed to call LinkSetDown",
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: tenantbridgeName}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, tenantbridgeName).Return(bridge, nil).Once()
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, bondDevice()).Return(nil).Once()
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "eth1").Return(testMember1, nil).Once()
+				mockNetlink.EXPECT().LinkSetDown(mock.Anything, testMember1).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetBondSlave(mock.Anything, testMember1, bondDevice()).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetUp(mock.Anything, testMember1).Return(nil).Once()
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "eth2").Return(testMember2, nil).Once()
+				mockNetlink.EXPECT().LinkSetDown(mock.Anything, testMember2).Return(errors.New(errMsg)).Once()
+				// the first member is released and the bond deleted again
+				mockNetlink.EXPECT().LinkSetNoMaster(mock.Anything, testMember1).Return(nil).Once()
+				mockNetlink.EXPECT().LinkDel(mock.Anything, bondDevice()).Return(nil).Once()
+			},
+		},
+		"failed LinkSetMaster call": {
+			errCode: codes.Unknown,
+			errMsg:  "Failed to call LinkSetMaster",
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: tenantbridgeName}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, tenantbridgeName).Return(bridge, nil).Once()
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, bondDevice()).Return(nil).Once()
+				for _, member := range []*netlink.Device{testMember1, testMember2} {
+					mockNetlink.EXPECT().LinkByName(mock.Anything, member.Name).Return(member, nil).Once()
+					mockNetlink.EXPECT().LinkSetDown(mock.Anything, member).Return(nil).Once()
+					mockNetlink.EXPECT().LinkSetBondSlave(mock.Anything, member, bondDevice()).Return(nil).Once()
+					mockNetlink.EXPECT().LinkSetUp(mock.Anything, member).Return(nil).Once()
+				}
+				bond := netlink.NewLinkBond(netlink.LinkAttrs{Name: testBridgePortID})
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testBridgePortID).Return(bond, nil).Once()
+				mac := net.HardwareAddr(testBridgePort.Spec.MacAddress[:])
+				mockNetlink.EXPECT().LinkSetHardwareAddr(mock.Anything, bond, mac).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetMaster(mock.Anything, bond, bridge).Return(errors.New(errMsg)).Once()
+				// the members are released and the bond deleted again
+				for _, member := range []*netlink.Device{testMember1, testMember2} {
+					mockNetlink.EXPECT().LinkByName(mock.Anything, member.Name).Return(member, nil).Once()
+					mockNetlink.EXPECT().LinkSetNoMaster(mock.Anything, member).Return(nil).Once()
+				}
+				mockNetlink.EXPECT().LinkDel(mock.Anything, bondDevice()).Return(nil).Once()
+			},
+		},
+		"successful call": {
+			out:     &testBridgePortWithStatus,
+			errCode: codes.OK,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: tenantbridgeName}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, tenantbridgeName).Return(bridge, nil).Once()
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, bondDevice()).Return(nil).Once()
+				for _, member := range []*netlink.Device{testMember1, testMember2} {
+					mockNetlink.EXPECT().LinkByName(mock.Anything, member.Name).Return(member, nil).Once()
+					mockNetlink.EXPECT().LinkSetDown(mock.Anything, member).Return(nil).Once()
+					mockNetlink.EXPECT().LinkSetBondSlave(mock.Anything, member, bondDevice()).Return(nil).Once()
+					mockNetlink.EXPECT().LinkSetUp(mock.Anything, member).Return(nil).Once()
+				}
+				bond := netlink.NewLinkBond(netlink.LinkAttrs{Name: testBridgePortID})
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testBridgePortID).Return(bond, nil).Once()
+				mac := net.HardwareAddr(testBridgePort.Spec.MacAddress[:])
+				mockNetlink.EXPECT().LinkSetHardwareAddr(mock.Anything, bond, mac).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetMaster(mock.Anything, bond, bridge).Return(nil).Once()
+				vid := uint16(testLogicalBridge.Spec.VlanId)
+				mockNetlink.EXPECT().BridgeVlanAdd(mock.Anything, bond, vid, false, false, false, false).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetUp(mock.Anything, bond).Return(nil).Once()
+			},
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pb.NewBridgePortServiceClient(env.conn)
+
+			_ = env.opi.store.Set(testLogicalBridgeName, &testLogicalBridgeWithStatus)
+			_ = env.opi.store.Set(testOptionsName, &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{Bond: testBond}})
+			if tt.on != nil {
+				tt.on(env.mockNetlink, tt.errMsg)
+			}
+
+			request := &pb.CreateBridgePortRequest{BridgePort: proto.Clone(&testBridgePort).(*pb.BridgePort), BridgePortId: testBridgePortID}
+			response, err := client.CreateBridgePort(ctx, request)
+			if !proto.Equal(tt.out, response) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+		})
+	}
+}
+
+func Test_DeleteBridgePortBond(t *testing.T) {
+	tests := map[string]struct {
+		missing bool
+	}{
+		"members released": {
+			missing: false,
+		},
+		"missing member skipped": {
+			missing: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pb.NewBridgePortServiceClient(env.conn)
+
+			_ = env.opi.store.Set(testBridgePortName, &testBridgePortWithStatus)
+			_ = env.opi.store.Set(testLogicalBridgeName, &testLogicalBridgeWithStatus)
+			_ = env.opi.store.Set(testOptionsName, &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{Bond: testBond}})
+
+			// the members are released before the bond is deleted
+			bond := netlink.NewLinkBond(netlink.LinkAttrs{Name: testBridgePortID})
+			env.mockNetlink.EXPECT().LinkByName(mock.Anything, testBridgePortID).Return(bond, nil).Once()
+			env.mockNetlink.EXPECT().LinkSetDown(mock.Anything, bond).Return(nil).Once()
+			vid := uint16(testLogicalBridge.Spec.VlanId)
+			env.mockNetlink.EXPECT().BridgeVlanDel(mock.Anything, bond, vid, true, true, false, false).Return(nil).Once()
+			env.mockNetlink.EXPECT().LinkByName(mock.Anything, testMember1.Name).Return(testMember1, nil).Once()
+			env.mockNetlink.EXPECT().LinkSetNoMaster(mock.Anything, testMember1).Return(nil).Once()
+			if tt.missing {
+				env.mockNetlink.EXPECT().LinkByName(mock.Anything, testMember2.Name).Return(nil, errors.New("Link not found")).Once()
+			} else {
+				env.mockNetlink.EXPECT().LinkByName(mock.Anything, testMember2.Name).Return(testMember2, nil).Once()
+				env.mockNetlink.EXPECT().LinkSetNoMaster(mock.Anything, testMember2).Return(nil).Once()
+			}
+			env.mockNetlink.EXPECT().LinkDel(mock.Anything, bond).Return(nil).Once()
+
+			if _, err := client.DeleteBridgePort(ctx, &pb.DeleteBridgePortRequest{Name: testBridgePortName}); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func Test_UpdateBridgePortOptionsBond(t *testing.T) {
+	tests := map[string]struct {
+		mask    *fieldmaskpb.FieldMask
+		in      *pa.BondOptions
+		stored  *pa.BondOptions
+		out     *pa.BridgePortOptions
+		errCode codes.Code
+		errMsg  string
+		exist   bool
+		used    bool
+		port    string
+		on      func(mockNetlink *mocks.Netlink, errMsg string)
+	}{
+		"missing members": {
+			in:      &pa.BondOptions{},
+			errCode: codes.InvalidArgument,
+			errMsg:  "missing required field: bond.members",
+		},
+		"invalid member name": {
+			in:      &pa.BondOptions{Members: []string{"eth1/2"}},
+			errCode: codes.InvalidArgument,
+			errMsg:  "bond.members value (eth1/2) is not an interface name",
+		},
+		"member is the bond itself": {
+			in:      &pa.BondOptions{Members: []string{testBridgePortID}},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("bond.members value (%s) is the bond itself", testBridgePortID),
+		},
+		"member set twice": {
+			in:      &pa.BondOptions{Members: []string{"eth1", "eth1"}},
+			errCode: codes.InvalidArgument,
+			errMsg:  "bond.members value (eth1) is set more than once",
+		},
+		"invalid mode": {
+			in:      &pa.BondOptions{Members: []string{"eth1"}, Mode: "lacp"},
+			errCode: codes.InvalidArgument,
+			errMsg:  "bond.mode value (lacp) is not a bonding mode",
+		},
+		"lacp rate without lacp": {
+			in:      &pa.BondOptions{Members: []string{"eth1"}, Mode: "active-backup", LacpRate: "fast"},
+			errCode: codes.InvalidArgument,
+			errMsg:  "bond.lacp_rate can only be set in 802.3ad mode",
+		},
+		"invalid hash policy": {
+			in:      &pa.BondOptions{Members: []string{"eth1"}, XmitHashPolicy: "layer4"},
+			errCode: codes.InvalidArgument,
+			errMsg:  "bond.xmit_hash_policy value (layer4) is not a transmit hash policy",
+		},
+		"member used by another bond": {
+			in:      &pa.BondOptions{Members: []string{"eth1", "eth5"}},
+			errCode: codes.FailedPrecondition,
+			errMsg:  fmt.Sprintf("interface eth5 is used by %s already", resourceIDToFullName("bond2")),
+			used:    true,
+		},
+		"member is another port": {
+			in:      &pa.BondOptions{Members: []string{"bond2"}},
+			errCode: codes.FailedPrecondition,
+			errMsg:  fmt.Sprintf("interface bond2 is used by %s already", resourceIDToFullName("bond2")),
+			used:    true,
+		},
+		"member is a port created before a restart": {
+			in:      &pa.BondOptions{Members: []string{"eth7"}},
+			errCode: codes.FailedPrecondition,
+			errMsg:  fmt.Sprintf("interface eth7 is used by %s already", resourceIDToFullName("eth7")),
+			port:    "eth7",
+		},
+		"stored until the port is created": {
+			in: testBond,
+			out: &pa.BridgePortOptions{
+				Name:   testOptionsName,
+				Spec:   &pa.BridgePortOptionsSpec{Bond: testBond},
+				Status: &pa.BridgePortOptionsStatus{},
+			},
+			errCode: codes.OK,
+		},
+		"parameters fixed while the port exists": {
+			mask:    &fieldmaskpb.FieldMask{Paths: []string{"spec.bond.mode"}},
+			in:      &pa.BondOptions{Mode: "active-backup"},
+			stored:  &pa.BondOptions{Members: []string{"eth1"}},
+			errCode: codes.FailedPrecondition,
+			errMsg:  fmt.Sprintf("bond of %s only changes its members while the BridgePort exists", testBridgePortName),
+			exist:   true,
+		},
+		"members change while the port exists": {
+			mask:   &fieldmaskpb.FieldMask{Paths: []string{"spec.bond.members"}},
+			in:     &pa.BondOptions{Members: []string{"eth1", "eth2"}},
+			stored: &pa.BondOptions{Members: []string{"eth1", "eth3"}},
+			out: &pa.BridgePortOptions{
+				Name: testOptionsName,
+				Spec: &pa.BridgePortOptionsSpec{Bond: &pa.BondOptions{Members: []string{"eth1", "eth2"}}},
+				Status: &pa.BridgePortOptionsStatus{Bond: &pa.BondStatus{Members: []*pa.BondMemberStatus{
+					{Name: "eth1", OperUp: true, Active: true},
+					{Name: "eth2"},
+				}}},
+			},
+			errCode: codes.OK,
+			exist:   true,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				eth3 := &netlink.Device{LinkAttrs: netlink.LinkAttrs{Name: "eth3"}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "eth3").Return(eth3, nil).Once()
+				mockNetlink.EXPECT().LinkSetNoMaster(mock.Anything, eth3).Return(nil).Once()
+				bond := netlink.NewLinkBond(netlink.LinkAttrs{Name: testBridgePortID})
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testBridgePortID).Return(bond, nil).Once()
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "eth2").Return(testMember2, nil).Twice()
+				mockNetlink.EXPECT().LinkSetDown(mock.Anything, testMember2).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetBondSlave(mock.Anything, testMember2, bond).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetUp(mock.Anything, testMember2).Return(nil).Once()
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "eth1").Return(testMember1, nil).Once()
+			},
+		},
+		"interface is not a bond": {
+			mask:    &fieldmaskpb.FieldMask{Paths: []string{"spec.bond.members"}},
+			in:      &pa.BondOptions{Members: []string{"eth1", "eth2"}},
+			stored:  &pa.BondOptions{Members: []string{"eth1"}},
+			errCode: codes.FailedPrecondition,
+			errMsg:  fmt.Sprintf("interface %s is not a bond", testBridgePortID),
+			exist:   true,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				iface := &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: testBridgePortID}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testBridgePortID).Return(iface, nil).Once()
+			},
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pa.NewBridgePortOptionsServiceClient(env.conn)
+
+			if tt.exist {
+				_ = env.opi.store.Set(testBridgePortName, &testBridgePortWithStatus)
+				env.opi.ListHelper[testBridgePortName] = false
+			}
+			if tt.used {
+				// only the options of bond2 are stored, it is created later
+				otherName := resourceIDToFullName("bond2")
+				_ = env.opi.store.Set(optionsName(otherName), &pa.BridgePortOptions{Name: optionsName(otherName), Spec: &pa.BridgePortOptionsSpec{
+					Bond: &pa.BondOptions{Members: []string{"eth5", "eth6"}},
+				}})
+			}
+			if tt.port != "" {
+				// the port is stored, but not in ListHelper
+				_ = env.opi.store.Set(resourceIDToFullName(tt.port), &pb.BridgePort{Name: resourceIDToFullName(tt.port), Spec: testBridgePort.Spec})
+			}
+			if tt.stored != nil {
+				_ = env.opi.store.Set(testOptionsName, &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{Bond: tt.stored}})
+			}
+			if tt.on != nil {
+				tt.on(env.mockNetlink, tt.errMsg)
+			}
+
+			in := &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{Bond: tt.in}}
+			request := &pa.UpdateBridgePortOptionsRequest{BridgePortOptions: in, UpdateMask: tt.mask}
+			response, err := client.UpdateBridgePortOptions(ctx, request)
+			if !proto.Equal(tt.out, response) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+		})
+	}
+}
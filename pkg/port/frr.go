@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package port is the main package of the application
+package port
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"path"
+	"strings"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+)
+
+// frrCreateBridgePort adds the interface of the BridgePort to the
+// Ethernet Segment from its options
+func (s *Server) frrCreateBridgePort(ctx context.Context, obj *pb.BridgePort) error {
+	options, err := s.loadOptions(optionsName(obj.Name))
+	if err != nil {
+		return err
+	}
+	return s.frrUpdateMultihoming(ctx, obj, nil, options.Spec.GetMultihoming())
+}
+
+// frrDeleteBridgePort removes the interface of the BridgePort from its Ethernet Segment
+func (s *Server) frrDeleteBridgePort(ctx context.Context, obj *pb.BridgePort) error {
+	options, err := s.loadOptions(optionsName(obj.Name))
+	if err != nil {
+		return err
+	}
+	return s.frrUpdateMultihoming(ctx, obj, options.Spec.GetMultihoming(), nil)
+}
+
+// frrUpdateMultihoming changes the Ethernet Segment of the interface of a BridgePort
+func (s *Server) frrUpdateMultihoming(ctx context.Context, obj *pb.BridgePort, oldMultihoming, multihoming *pa.EvpnMultihoming) error {
+	var commands []string
+	// the ESI is made of es-id and es-sys-mac, so replace both when one changes
+	if oldMultihoming.GetEsId() != multihoming.GetEsId() || !bytes.Equal(oldMultihoming.GetSysMac(), multihoming.GetSysMac()) {
+		if oldMultihoming.GetEsId() != "" {
+			commands = append(commands, "no evpn mh es-id")
+		}
+		if len(oldMultihoming.GetSysMac()) > 0 {
+			commands = append(commands, "no evpn mh es-sys-mac")
+		}
+		if len(multihoming.GetSysMac()) > 0 {
+			commands = append(commands, "evpn mh es-sys-mac "+net.HardwareAddr(multihoming.SysMac).String())
+		}
+		if multihoming.GetEsId() != "" {
+			commands = append(commands, "evpn mh es-id "+multihoming.EsId)
+		}
+	}
+	if oldMultihoming.GetDfPreference() != multihoming.GetDfPreference() {
+		if multihoming.GetDfPreference() == 0 {
+			commands = append(commands, "no evpn mh es-df-pref")
+		} else {
+			commands = append(commands, fmt.Sprintf("evpn mh es-df-pref %d", multihoming.DfPreference))
+		}
+	}
+	if len(commands) == 0 {
+		return nil
+	}
+	var lines strings.Builder
+	for _, command := range commands {
+		fmt.Fprintf(&lines, "\n\t\t\t\t%s", command)
+	}
+	data, err := s.frr.FrrZebraCmd(ctx, fmt.Sprintf(
+		`configure terminal
+			interface %s%s
+				exit
+			exit`, path.Base(obj.Name), lines.String()))
+	fmt.Printf("FrrZebraCmd: %v:%v", data, err)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// frrReadMultihoming reads the Ethernet Segment of the interface of a BridgePort
+// and the result of the designated forwarder election from zebra
+func (s *Server) frrReadMultihoming(ctx context.Context, obj *pb.BridgePort) (*pa.EvpnMultihomingStatus, error) {
+	data, err := s.frr.FrrZebraCmd(ctx, "show evpn es detail json")
+	if err != nil {
+		return nil, err
+	}
+	start, end := strings.Index(data, "["), strings.LastIndex(data, "]")
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("no JSON array in %q", data)
+	}
+	var segments []struct {
+		Esi        string   `json:"esi"`
+		AccessPort string   `json:"accessPort"`
+		Flags      []string `json:"flags"`
+	}
+	if err := json.Unmarshal([]byte(data[start:end+1]), &segments); err != nil {
+		return nil, err
+	}
+	ifname := path.Base(obj.Name)
+	for _, segment := range segments {
+		if segment.AccessPort != ifname {
+			continue
+		}
+		flags := make(map[string]bool)
+		for _, flag := range segment.Flags {
+			flags[flag] = true
+		}
+		return &pa.EvpnMultihomingStatus{
+			Esi:                 segment.Esi,
+			DesignatedForwarder: flags["local"] && !flags["nonDF"],
+			OperUp:              flags["operUp"],
+		}, nil
+	}
+	return nil, fmt.Errorf("no Ethernet Segment of %s", ifname)
+}
@@ -28,9 +28,27 @@ import (
 // CreateBridgePort executes the creation of the port
 func (s *Server) CreateBridgePort(ctx context.Context, in *pb.CreateBridgePortRequest) (*pb.BridgePort, error) {
 	// check input correctness
-	if err := s.validateCreateBridgePortRequest(in); err != nil {
+	if err := s.ValidateCreateBridgePortRequest(in); err != nil {
 		return nil, err
 	}
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.CreateBridgePort(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
+	response, _, err := s.createBridgePort(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	utils.SendEtag(ctx, response)
+	return response, nil
+}
+
+// createBridgePort configures and stores the BridgePort, unless it already
+// exists, and tells whether it was created
+func (s *Server) createBridgePort(ctx context.Context, in *pb.CreateBridgePortRequest) (*pb.BridgePort, bool, error) {
 	// see https://google.aip.dev/133#user-specified-ids
 	resourceID := resourceid.NewSystemGenerated()
 	if in.BridgePortId != "" {
@@ -43,15 +61,19 @@ func (s *Server) CreateBridgePort(ctx context.Context, in *pb.CreateBridgePortRe
 	ok, err := s.store.Get(in.BridgePort.Name, obj)
 	if err != nil {
 		fmt.Printf("Failed to interact with store: %v", err)
-		return nil, err
+		return nil, false, err
 	}
 	if ok {
 		log.Printf("Already existing BridgePort with id %v", in.BridgePort.Name)
-		return obj, nil
+		return obj, false, nil
 	}
 	// configure netlink
 	if err := s.netlinkCreateBridgePort(ctx, in); err != nil {
-		return nil, err
+		return nil, false, err
+	}
+	// configure FRR
+	if err := s.frrCreateBridgePort(ctx, in.BridgePort); err != nil {
+		return nil, false, err
 	}
 	// translate object
 	response := utils.ProtoClone(in.BridgePort)
@@ -61,9 +83,9 @@ func (s *Server) CreateBridgePort(ctx context.Context, in *pb.CreateBridgePortRe
 	s.ListHelper[in.BridgePort.Name] = false
 	err = s.store.Set(in.BridgePort.Name, response)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
-	return response, nil
+	return response, true, nil
 }
 
 // DeleteBridgePort deletes a port
@@ -72,6 +94,16 @@ func (s *Server) DeleteBridgePort(ctx context.Context, in *pb.DeleteBridgePortRe
 	if err := s.validateDeleteBridgePortRequest(in); err != nil {
 		return nil, err
 	}
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.DeleteBridgePort(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
+	// serialize writers, so the etag check and the write are atomic
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// fetch object from the database
 	iface := new(pb.BridgePort)
 	ok, err := s.store.Get(in.Name, iface)
@@ -86,25 +118,47 @@ func (s *Server) DeleteBridgePort(ctx context.Context, in *pb.DeleteBridgePortRe
 		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
 		return nil, err
 	}
-	// configure netlink
-	if err := s.netlinkDeleteBridgePort(ctx, iface); err != nil {
+	// reject stale etag, see https://google.aip.dev/154
+	if err := utils.CheckEtag(ctx, iface); err != nil {
 		return nil, err
 	}
-	// remove from the Database
-	delete(s.ListHelper, iface.Name)
-	err = s.store.Delete(iface.Name)
-	if err != nil {
+	if err := s.deleteBridgePort(ctx, iface); err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
+// deleteBridgePort removes the stored BridgePort from netlink and the store
+func (s *Server) deleteBridgePort(ctx context.Context, iface *pb.BridgePort) error {
+	// delete from FRR, while the interface still exists
+	if err := s.frrDeleteBridgePort(ctx, iface); err != nil {
+		return err
+	}
+	// configure netlink
+	if err := s.netlinkDeleteBridgePort(ctx, iface); err != nil {
+		return err
+	}
+	// remove from the Database
+	delete(s.ListHelper, iface.Name)
+	return s.store.Delete(iface.Name)
+}
+
 // UpdateBridgePort updates an Nvme Subsystem
 func (s *Server) UpdateBridgePort(ctx context.Context, in *pb.UpdateBridgePortRequest) (*pb.BridgePort, error) {
 	// check input correctness
 	if err := s.validateUpdateBridgePortRequest(in); err != nil {
 		return nil, err
 	}
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.UpdateBridgePort(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
+	// serialize writers, so the etag check and the write are atomic
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// fetch object from the
 	port := new(pb.BridgePort)
 	ok, err := s.store.Get(in.BridgePort.Name, port)
@@ -117,6 +171,10 @@ func (s *Server) UpdateBridgePort(ctx context.Context, in *pb.UpdateBridgePortRe
 		err := status.Errorf(codes.NotFound, "unable to find key %s", in.BridgePort.Name)
 		return nil, err
 	}
+	// reject stale etag, see https://google.aip.dev/154
+	if err := utils.CheckEtag(ctx, port); err != nil {
+		return nil, err
+	}
 	resourceID := path.Base(port.Name)
 	iface, err := s.nLink.LinkByName(ctx, resourceID)
 	if err != nil {
@@ -135,6 +193,7 @@ func (s *Server) UpdateBridgePort(ctx context.Context, in *pb.UpdateBridgePortRe
 	if err != nil {
 		return nil, err
 	}
+	utils.SendEtag(ctx, response)
 	return response, nil
 }
 
@@ -155,6 +214,7 @@ func (s *Server) GetBridgePort(ctx context.Context, in *pb.GetBridgePortRequest)
 		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
 		return nil, err
 	}
+	utils.SendEtag(ctx, port)
 	resourceID := path.Base(port.Name)
 	_, err = s.nLink.LinkByName(ctx, resourceID)
 	if err != nil {
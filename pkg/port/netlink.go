@@ -10,8 +10,12 @@ import (
 	"fmt"
 	"path"
 
+	"github.com/vishvananda/netlink"
+
 	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
 
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -19,15 +23,49 @@ import (
 func (s *Server) netlinkCreateBridgePort(ctx context.Context, in *pb.CreateBridgePortRequest) error {
 	resourceID := path.Base(in.BridgePort.Name)
 	// use netlink to find br-tenant
-	bridge, err := s.nLink.LinkByName(ctx, tenantbridgeName)
+	tenantBridge := config.GetConfig().Linux.TenantBridge
+	bridge, err := s.nLink.LinkByName(ctx, tenantBridge)
 	if err != nil {
-		err := status.Errorf(codes.NotFound, "unable to find key %s", tenantbridgeName)
+		err := status.Errorf(codes.NotFound, "unable to find key %s", tenantBridge)
+		return err
+	}
+	// create the bond (e.g.: bond1) or virtual device of the BridgePort,
+	// the interface has to exist otherwise
+	options, err := s.loadOptions(optionsName(in.BridgePort.Name))
+	if err != nil {
+		return err
+	}
+	if options.Spec.Bond != nil {
+		if err := s.netlinkCreateBond(ctx, resourceID, options.Spec.Bond); err != nil {
+			return err
+		}
+	}
+	var virtual netlink.Link
+	if options.Spec.Virtual != nil {
+		virtual, err = s.netlinkCreateVirtual(ctx, resourceID, options.Spec.Virtual)
+		if err != nil {
+			return err
+		}
+	}
+	// the bond or virtual device goes away again when the BridgePort can not be attached
+	if err := s.netlinkAttachBridgePort(ctx, in, bridge); err != nil {
+		if options.Spec.Bond != nil {
+			s.netlinkUndoBond(ctx, resourceID, options.Spec.Bond)
+		}
+		if virtual != nil {
+			s.netlinkUndoVirtual(ctx, virtual)
+		}
 		return err
 	}
+	return nil
+}
+
+// netlinkAttachBridgePort adds the interface of a BridgePort to br-tenant
+// and to the VLANs of its logical bridges
+func (s *Server) netlinkAttachBridgePort(ctx context.Context, in *pb.CreateBridgePortRequest, bridge netlink.Link) error {
+	resourceID := path.Base(in.BridgePort.Name)
 	// get base interface (e.g.: eth2)
 	iface, err := s.nLink.LinkByName(ctx, resourceID)
-	// TODO: maybe we need to create a new iface here and not rely on existing one ?
-	//		 iface := &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: resourceID}}
 	if err != nil {
 		err := status.Errorf(codes.NotFound, "unable to find key %s", resourceID)
 		return err
@@ -117,7 +155,23 @@ func (s *Server) netlinkDeleteBridgePort(ctx context.Context, iface *pb.BridgePo
 			return err
 		}
 	}
-	// use netlink to delete dummy interface
+	// release the members of the bond of the BridgePort
+	options, err := s.loadOptions(optionsName(iface.Name))
+	if err != nil {
+		return err
+	}
+	if err := s.netlinkDeleteBondMembers(ctx, options.Spec.GetBond().GetMembers()); err != nil {
+		return err
+	}
+	// an existing interface, like a physical NIC, only leaves br-tenant
+	if !ownsDevice(options) {
+		if err := s.nLink.LinkSetNoMaster(ctx, dummy); err != nil {
+			fmt.Printf("Failed to delete iface from bridge: %v", err)
+			return err
+		}
+		return nil
+	}
+	// use netlink to delete the device of the BridgePort
 	if err := s.nLink.LinkDel(ctx, dummy); err != nil {
 		fmt.Printf("Failed to delete link: %v", err)
 		return err
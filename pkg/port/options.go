@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package port is the main package of the application
+package port
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"go.einride.tech/aip/fieldmask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+)
+
+// optionsPattern is the name of the options singleton below a BridgePort
+const optionsPattern = "//network.opiproject.org/ports/{port}/options"
+
+// GetBridgePortOptions gets the options of a BridgePort
+func (s *Server) GetBridgePortOptions(ctx context.Context, in *pa.GetBridgePortOptionsRequest) (*pa.BridgePortOptions, error) {
+	// check input correctness
+	if err := validateOptionsName(in.GetName()); err != nil {
+		return nil, err
+	}
+	options, err := s.loadOptions(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	utils.SendEtag(ctx, options)
+	if err := s.readOptionsStatus(ctx, options); err != nil {
+		return nil, err
+	}
+	return options, nil
+}
+
+// UpdateBridgePortOptions sets the options of a BridgePort and applies
+// them to its interface, if the BridgePort exists
+func (s *Server) UpdateBridgePortOptions(ctx context.Context, in *pa.UpdateBridgePortOptionsRequest) (*pa.BridgePortOptions, error) {
+	// check input correctness
+	if err := s.ValidateUpdateBridgePortOptionsRequest(in); err != nil {
+		return nil, err
+	}
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.UpdateBridgePortOptions(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
+	// serialize writers, so the etag check and the write are atomic
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	options, err := s.loadOptions(in.BridgePortOptions.Name)
+	if err != nil {
+		return nil, err
+	}
+	// reject stale etag, see https://google.aip.dev/154
+	if err := utils.CheckEtag(ctx, options); err != nil {
+		return nil, err
+	}
+	// status is output only
+	in.BridgePortOptions.Status = nil
+	// copy the old segment, the mask can update fields of it in place
+	oldMultihoming := proto.Clone(options.Spec.GetMultihoming()).(*pa.EvpnMultihoming)
+	oldBond := proto.Clone(options.Spec.GetBond()).(*pa.BondOptions)
+	oldVirtual := proto.Clone(options.Spec.GetVirtual()).(*pa.VirtualInterface)
+	fieldmask.Update(in.UpdateMask, options, in.BridgePortOptions)
+	// validate the merged options, the request may only set some fields of the segment
+	if err := validateMultihoming(options.Spec.GetMultihoming()); err != nil {
+		return nil, err
+	}
+	if err := validateBond(options); err != nil {
+		return nil, err
+	}
+	if err := validateVirtual(options); err != nil {
+		return nil, err
+	}
+	if err := s.checkEthernetSegment(options); err != nil {
+		return nil, err
+	}
+	if err := s.checkBondMembers(options); err != nil {
+		return nil, err
+	}
+	port, err := s.optionsPort(options.Name)
+	if err != nil {
+		return nil, err
+	}
+	// configure netlink, unless the options only apply on creation
+	if port != nil {
+		if err := checkBondChange(port.Name, oldBond, options.Spec.GetBond()); err != nil {
+			return nil, err
+		}
+		if err := checkVirtualChange(port.Name, oldVirtual, options.Spec.GetVirtual()); err != nil {
+			return nil, err
+		}
+		if err := s.netlinkUpdateBond(ctx, port, oldBond, options.Spec.GetBond()); err != nil {
+			return nil, err
+		}
+	}
+	// configure FRR, unless the options only apply on creation
+	if port != nil {
+		if err := s.frrUpdateMultihoming(ctx, port, oldMultihoming, options.Spec.GetMultihoming()); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.store.Set(options.Name, options); err != nil {
+		return nil, err
+	}
+	utils.SendEtag(ctx, options)
+	if err := s.readOptionsStatus(ctx, options); err != nil {
+		return nil, err
+	}
+	return options, nil
+}
+
+// optionsName returns the name of the options of a BridgePort
+func optionsName(portName string) string {
+	return portName + "/options"
+}
+
+// loadOptions returns the stored options, or the defaults when they were never set
+func (s *Server) loadOptions(name string) (*pa.BridgePortOptions, error) {
+	options := new(pa.BridgePortOptions)
+	ok, err := s.store.Get(name, options)
+	if err != nil {
+		fmt.Printf("Failed to interact with store: %v", err)
+		return nil, err
+	}
+	if !ok {
+		options = &pa.BridgePortOptions{Name: name}
+	}
+	if options.Spec == nil {
+		options.Spec = &pa.BridgePortOptionsSpec{}
+	}
+	return options, nil
+}
+
+// optionsPort returns the BridgePort the options belong to, or nil when it does not exist
+func (s *Server) optionsPort(name string) (*pb.BridgePort, error) {
+	port := new(pb.BridgePort)
+	ok, err := s.store.Get(strings.TrimSuffix(name, "/options"), port)
+	if err != nil {
+		fmt.Printf("Failed to interact with store: %v", err)
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return port, nil
+}
+
+// checkEthernetSegment rejects an Ethernet Segment used by another BridgePort,
+// a host is attached to a device through one interface only
+func (s *Server) checkEthernetSegment(options *pa.BridgePortOptions) error {
+	multihoming := options.Spec.GetMultihoming()
+	if multihoming.GetEsId() == "" {
+		return nil
+	}
+	// scan the store, options of BridgePorts created later and of BridgePorts
+	// created before a restart are not in ListHelper
+	for _, key := range utils.StoreKeys(s.store) {
+		if key == options.Name || !strings.HasPrefix(key, "//network.opiproject.org/ports/") || !strings.HasSuffix(key, "/options") {
+			continue
+		}
+		other, err := s.loadOptions(key)
+		if err != nil {
+			return err
+		}
+		if other.Spec.GetMultihoming().GetEsId() == multihoming.EsId && bytes.Equal(other.Spec.GetMultihoming().GetSysMac(), multihoming.SysMac) {
+			err := status.Errorf(codes.FailedPrecondition, "Ethernet Segment %s is used by %s already", multihoming.EsId, strings.TrimSuffix(key, "/options"))
+			return err
+		}
+	}
+	return nil
+}
+
+// readOptionsStatus reads the options in effect on the interface of the BridgePort
+func (s *Server) readOptionsStatus(ctx context.Context, options *pa.BridgePortOptions) error {
+	options.Status = &pa.BridgePortOptionsStatus{}
+	port, err := s.optionsPort(options.Name)
+	if err != nil || port == nil {
+		return err
+	}
+	if options.Spec.Multihoming != nil {
+		multihoming, err := s.frrReadMultihoming(ctx, port)
+		if err != nil {
+			log.Printf("Failed to read Ethernet Segment of %v: %v", port.Name, err)
+		} else {
+			options.Status.Multihoming = multihoming
+		}
+	}
+	if options.Spec.Bond != nil {
+		bond, err := s.readBondStatus(ctx, options.Spec.Bond)
+		if err != nil {
+			log.Printf("Failed to read bond members of %v: %v", port.Name, err)
+		} else {
+			options.Status.Bond = bond
+		}
+	}
+	return nil
+}
@@ -0,0 +1,328 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package port is the main package of the application
+package port
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils/mocks"
+)
+
+var (
+	testOptionsName = optionsName(testBridgePortName)
+	testSysMac      = []byte{0x44, 0x38, 0x39, 0xff, 0xff, 0x01}
+	testEsShow      = `show evpn es detail json
+[
+  {
+    "esi":"03:44:38:39:ff:ff:01:00:00:01",
+    "accessPort":"opi-port8",
+    "flags":["local","readyForBgp","bridgePort","operUp"],
+    "dfPreference":50000
+  },
+  {
+    "esi":"03:44:38:39:ff:ff:01:00:00:02",
+    "accessPort":"bond2",
+    "flags":["local","readyForBgp","bridgePort","operUp","nonDF"],
+    "dfPreference":32767
+  }
+]
+opi-evpn-bridge#`
+	testEsStatus = &pa.EvpnMultihomingStatus{Esi: "03:44:38:39:ff:ff:01:00:00:01", DesignatedForwarder: true, OperUp: true}
+)
+
+// zebraCommand matches a zebra command containing the lines
+func zebraCommand(lines ...string) interface{} {
+	return mock.MatchedBy(func(command string) bool {
+		return strings.Contains(command, "interface opi-port8\n\t\t\t\t"+strings.Join(lines, "\n\t\t\t\t")+"\n\t\t\t\texit")
+	})
+}
+
+func Test_UpdateBridgePortOptions(t *testing.T) {
+	tests := map[string]struct {
+		mask    *fieldmaskpb.FieldMask
+		in      *pa.BridgePortOptions
+		stored  *pa.BridgePortOptions
+		out     *pa.BridgePortOptions
+		errCode codes.Code
+		errMsg  string
+		exist   bool
+		on      func(mockFrr *mocks.Frr, errMsg string)
+	}{
+		"invalid name": {
+			in:      &pa.BridgePortOptions{Name: testBridgePortName, Spec: &pa.BridgePortOptionsSpec{}},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("name %s does not match %s", testBridgePortName, optionsPattern),
+		},
+		"missing spec": {
+			in:      &pa.BridgePortOptions{Name: testOptionsName},
+			errCode: codes.InvalidArgument,
+			errMsg:  "missing required field: bridge_port_options.spec",
+		},
+		"missing es id": {
+			in: &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{
+				Multihoming: &pa.EvpnMultihoming{DfPreference: 50000},
+			}},
+			errCode: codes.InvalidArgument,
+			errMsg:  "missing required field: multihoming.es_id",
+		},
+		"discriminator out of range": {
+			in: &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{
+				Multihoming: &pa.EvpnMultihoming{EsId: "16777216", SysMac: testSysMac},
+			}},
+			errCode: codes.InvalidArgument,
+			errMsg:  "multihoming.es_id value (16777216) have to be between 1 and 16777215",
+		},
+		"discriminator without sys mac": {
+			in: &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{
+				Multihoming: &pa.EvpnMultihoming{EsId: "1"},
+			}},
+			errCode: codes.InvalidArgument,
+			errMsg:  "multihoming.sys_mac has to be a unicast MAC with a local discriminator",
+		},
+		"invalid esi": {
+			in: &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{
+				Multihoming: &pa.EvpnMultihoming{EsId: "03:44:38:39:ff:ff:01:00:00:01"},
+			}},
+			errCode: codes.InvalidArgument,
+			errMsg:  "multihoming.es_id value (03:44:38:39:ff:ff:01:00:00:01) has to be a local discriminator or a type 0 ESI",
+		},
+		"type 0 esi with sys mac": {
+			in: &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{
+				Multihoming: &pa.EvpnMultihoming{EsId: "00:44:38:39:ff:ff:01:00:00:01", SysMac: testSysMac},
+			}},
+			errCode: codes.InvalidArgument,
+			errMsg:  "multihoming.sys_mac can only be set with a local discriminator",
+		},
+		"df preference out of range": {
+			in: &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{
+				Multihoming: &pa.EvpnMultihoming{EsId: "00:44:38:39:ff:ff:01:00:00:01", DfPreference: 65536},
+			}},
+			errCode: codes.InvalidArgument,
+			errMsg:  "multihoming.df_preference value (65536) have to be between 0 and 65535",
+		},
+		"stored until the port is created": {
+			in: &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{
+				Multihoming: &pa.EvpnMultihoming{EsId: "1", SysMac: testSysMac},
+			}},
+			out: &pa.BridgePortOptions{
+				Name: testOptionsName,
+				Spec: &pa.BridgePortOptionsSpec{
+					Multihoming: &pa.EvpnMultihoming{EsId: "1", SysMac: testSysMac},
+				},
+				Status: &pa.BridgePortOptionsStatus{},
+			},
+			errCode: codes.OK,
+		},
+		"ethernet segment used by a port not created yet": {
+			in: &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{
+				Multihoming: &pa.EvpnMultihoming{EsId: "2", SysMac: testSysMac},
+			}},
+			stored: &pa.BridgePortOptions{Name: optionsName(resourceIDToFullName("bond2")), Spec: &pa.BridgePortOptionsSpec{
+				Multihoming: &pa.EvpnMultihoming{EsId: "2", SysMac: testSysMac},
+			}},
+			errCode: codes.FailedPrecondition,
+			errMsg:  fmt.Sprintf("Ethernet Segment 2 is used by %s already", resourceIDToFullName("bond2")),
+		},
+		"applied to the port": {
+			in: &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{
+				Multihoming: &pa.EvpnMultihoming{EsId: "1", SysMac: testSysMac, DfPreference: 50000},
+			}},
+			out: &pa.BridgePortOptions{
+				Name: testOptionsName,
+				Spec: &pa.BridgePortOptionsSpec{
+					Multihoming: &pa.EvpnMultihoming{EsId: "1", SysMac: testSysMac, DfPreference: 50000},
+				},
+				Status: &pa.BridgePortOptionsStatus{Multihoming: testEsStatus},
+			},
+			errCode: codes.OK,
+			exist:   true,
+			on: func(mockFrr *mocks.Frr, errMsg string) {
+				command := zebraCommand("evpn mh es-sys-mac 44:38:39:ff:ff:01", "evpn mh es-id 1", "evpn mh es-df-pref 50000")
+				mockFrr.EXPECT().FrrZebraCmd(mock.Anything, command).Return("", nil).Once()
+				mockFrr.EXPECT().FrrZebraCmd(mock.Anything, "show evpn es detail json").Return(testEsShow, nil).Once()
+			},
+		},
+		"df preference keeps the ethernet segment": {
+			mask: &fieldmaskpb.FieldMask{Paths: []string{"spec.multihoming.df_preference"}},
+			in: &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{
+				Multihoming: &pa.EvpnMultihoming{},
+			}},
+			stored: &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{
+				Multihoming: &pa.EvpnMultihoming{EsId: "1", SysMac: testSysMac, DfPreference: 50000},
+			}},
+			out: &pa.BridgePortOptions{
+				Name: testOptionsName,
+				Spec: &pa.BridgePortOptionsSpec{
+					Multihoming: &pa.EvpnMultihoming{EsId: "1", SysMac: testSysMac},
+				},
+				Status: &pa.BridgePortOptionsStatus{Multihoming: testEsStatus},
+			},
+			errCode: codes.OK,
+			exist:   true,
+			on: func(mockFrr *mocks.Frr, errMsg string) {
+				mockFrr.EXPECT().FrrZebraCmd(mock.Anything, zebraCommand("no evpn mh es-df-pref")).Return("", nil).Once()
+				mockFrr.EXPECT().FrrZebraCmd(mock.Anything, "show evpn es detail json").Return(testEsShow, nil).Once()
+			},
+		},
+		"removed from the port": {
+			mask: &fieldmaskpb.FieldMask{Paths: []string{"spec.multihoming"}},
+			in:   &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{}},
+			stored: &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{
+				Multihoming: &pa.EvpnMultihoming{EsId: "00:44:38:39:ff:ff:01:00:00:01"},
+			}},
+			out: &pa.BridgePortOptions{
+				Name:   testOptionsName,
+				Spec:   &pa.BridgePortOptionsSpec{},
+				Status: &pa.BridgePortOptionsStatus{},
+			},
+			errCode: codes.OK,
+			exist:   true,
+			on: func(mockFrr *mocks.Frr, errMsg string) {
+				mockFrr.EXPECT().FrrZebraCmd(mock.Anything, zebraCommand("no evpn mh es-id")).Return("", nil).Once()
+			},
+		},
+		"failed FrrZebraCmd call": {
+			in: &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{
+				Multihoming: &pa.EvpnMultihoming{EsId: "00:44:38:39:ff:ff:01:00:00:01"},
+			}},
+			errCode: codes.Unknown,
+			errMsg:  "Failed to run FrrZebraCmd",
+			exist:   true,
+			on: func(mockFrr *mocks.Frr, errMsg string) {
+				command := zebraCommand("evpn mh es-id 00:44:38:39:ff:ff:01:00:00:01")
+				mockFrr.EXPECT().FrrZebraCmd(mock.Anything, command).Return("", errors.New(errMsg)).Once()
+			},
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pa.NewBridgePortOptionsServiceClient(env.conn)
+
+			if tt.exist {
+				_ = env.opi.store.Set(testBridgePortName, &testBridgePortWithStatus)
+				env.opi.ListHelper[testBridgePortName] = false
+			}
+			if tt.stored != nil {
+				_ = env.opi.store.Set(tt.stored.Name, tt.stored)
+			}
+			if tt.on != nil {
+				tt.on(env.mockFrr, tt.errMsg)
+			}
+
+			request := &pa.UpdateBridgePortOptionsRequest{BridgePortOptions: tt.in, UpdateMask: tt.mask}
+			response, err := client.UpdateBridgePortOptions(ctx, request)
+			if !proto.Equal(tt.out, response) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+		})
+	}
+}
+
+func Test_GetBridgePortOptions(t *testing.T) {
+	tests := map[string]struct {
+		in      string
+		out     *pa.BridgePortOptions
+		errCode codes.Code
+		errMsg  string
+		on      func(mockFrr *mocks.Frr, errMsg string)
+	}{
+		"designated forwarder": {
+			in: testOptionsName,
+			out: &pa.BridgePortOptions{
+				Name: testOptionsName,
+				Spec: &pa.BridgePortOptionsSpec{
+					Multihoming: &pa.EvpnMultihoming{EsId: "1", SysMac: testSysMac},
+				},
+				Status: &pa.BridgePortOptionsStatus{Multihoming: testEsStatus},
+			},
+			errCode: codes.OK,
+			on: func(mockFrr *mocks.Frr, errMsg string) {
+				mockFrr.EXPECT().FrrZebraCmd(mock.Anything, "show evpn es detail json").Return(testEsShow, nil).Once()
+			},
+		},
+		"failed FrrZebraCmd call leaves the status empty": {
+			in: testOptionsName,
+			out: &pa.BridgePortOptions{
+				Name: testOptionsName,
+				Spec: &pa.BridgePortOptionsSpec{
+					Multihoming: &pa.EvpnMultihoming{EsId: "1", SysMac: testSysMac},
+				},
+				Status: &pa.BridgePortOptionsStatus{},
+			},
+			errCode: codes.OK,
+			on: func(mockFrr *mocks.Frr, errMsg string) {
+				mockFrr.EXPECT().FrrZebraCmd(mock.Anything, "show evpn es detail json").Return("", errors.New(errMsg)).Once()
+			},
+		},
+		"invalid name": {
+			in:      resourceIDToFullName("bond1/unknown"),
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("name %s does not match %s", resourceIDToFullName("bond1/unknown"), optionsPattern),
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pa.NewBridgePortOptionsServiceClient(env.conn)
+
+			_ = env.opi.store.Set(testBridgePortName, &testBridgePortWithStatus)
+			_ = env.opi.store.Set(testOptionsName, &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{
+				Multihoming: &pa.EvpnMultihoming{EsId: "1", SysMac: testSysMac},
+			}})
+			if tt.on != nil {
+				tt.on(env.mockFrr, tt.errMsg)
+			}
+
+			request := &pa.GetBridgePortOptionsRequest{Name: tt.in}
+			response, err := client.GetBridgePortOptions(ctx, request)
+			if !proto.Equal(tt.out, response) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+		})
+	}
+}
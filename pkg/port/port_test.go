@@ -16,7 +16,9 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/vishvananda/netlink"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -28,6 +30,10 @@ import (
 	"github.com/opiproject/opi-evpn-bridge/pkg/utils/mocks"
 )
 
+const (
+	tenantbridgeName = "br-tenant"
+)
+
 var (
 	testBridgePortID   = "opi-port8"
 	testBridgePortName = resourceIDToFullName(testBridgePortID)
@@ -330,12 +336,21 @@ func Test_CreateBridgePort(t *testing.T) {
 }
 
 func Test_DeleteBridgePort(t *testing.T) {
+	successfulDelete := func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+		iface := &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: testBridgePortID}}
+		mockNetlink.EXPECT().LinkByName(mock.Anything, testBridgePortID).Return(iface, nil).Once()
+		mockNetlink.EXPECT().LinkSetDown(mock.Anything, iface).Return(nil).Once()
+		vid := uint16(testLogicalBridge.Spec.VlanId)
+		mockNetlink.EXPECT().BridgeVlanDel(mock.Anything, iface, vid, true, true, false, false).Return(nil).Once()
+		mockNetlink.EXPECT().LinkSetNoMaster(mock.Anything, iface).Return(nil).Once()
+	}
 	tests := map[string]struct {
 		in      string
 		out     *emptypb.Empty
 		errCode codes.Code
 		errMsg  string
 		missing bool
+		etag    string
 		on      func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string)
 	}{
 		"valid request with unknown key": {
@@ -398,11 +413,11 @@ func Test_DeleteBridgePort(t *testing.T) {
 				mockNetlink.EXPECT().BridgeVlanDel(mock.Anything, iface, vid, true, true, false, false).Return(errors.New(errMsg)).Once()
 			},
 		},
-		"failed LinkDel call": {
+		"failed LinkSetNoMaster call": {
 			in:      testBridgePortID,
 			out:     &emptypb.Empty{},
 			errCode: codes.Unknown,
-			errMsg:  "Failed to call LinkDel",
+			errMsg:  "Failed to call LinkSetNoMaster",
 			missing: false,
 			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
 				iface := &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: testBridgePortID}}
@@ -410,7 +425,7 @@ func Test_DeleteBridgePort(t *testing.T) {
 				mockNetlink.EXPECT().LinkSetDown(mock.Anything, iface).Return(nil).Once()
 				vid := uint16(testLogicalBridge.Spec.VlanId)
 				mockNetlink.EXPECT().BridgeVlanDel(mock.Anything, iface, vid, true, true, false, false).Return(nil).Once()
-				mockNetlink.EXPECT().LinkDel(mock.Anything, iface).Return(errors.New(errMsg)).Once()
+				mockNetlink.EXPECT().LinkSetNoMaster(mock.Anything, iface).Return(errors.New(errMsg)).Once()
 			},
 		},
 		"successful call": {
@@ -419,14 +434,25 @@ func Test_DeleteBridgePort(t *testing.T) {
 			errCode: codes.OK,
 			errMsg:  "",
 			missing: false,
-			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
-				iface := &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: testBridgePortID}}
-				mockNetlink.EXPECT().LinkByName(mock.Anything, testBridgePortID).Return(iface, nil).Once()
-				mockNetlink.EXPECT().LinkSetDown(mock.Anything, iface).Return(nil).Once()
-				vid := uint16(testLogicalBridge.Spec.VlanId)
-				mockNetlink.EXPECT().BridgeVlanDel(mock.Anything, iface, vid, true, true, false, false).Return(nil).Once()
-				mockNetlink.EXPECT().LinkDel(mock.Anything, iface).Return(nil).Once()
-			},
+			on:      successfulDelete,
+		},
+		"stale etag": {
+			in:      testBridgePortID,
+			out:     nil,
+			errCode: codes.Aborted,
+			errMsg:  fmt.Sprintf("etag %v does not match the current etag %v of %v, re-read the resource and retry", "0123456789abcdef", utils.Etag(&testBridgePortWithStatus), testBridgePortName),
+			missing: false,
+			etag:    "0123456789abcdef",
+			on:      nil,
+		},
+		"current etag": {
+			in:      testBridgePortID,
+			out:     &emptypb.Empty{},
+			errCode: codes.OK,
+			errMsg:  "",
+			missing: false,
+			etag:    utils.Etag(&testBridgePortWithStatus),
+			on:      successfulDelete,
 		},
 	}
 
@@ -445,6 +471,10 @@ func Test_DeleteBridgePort(t *testing.T) {
 				tt.on(env.mockNetlink, env.mockFrr, tt.errMsg)
 			}
 
+			if tt.etag != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, utils.EtagHeader, tt.etag)
+			}
+
 			request := &pb.DeleteBridgePortRequest{Name: fname1, AllowMissing: tt.missing}
 			response, err := client.DeleteBridgePort(ctx, request)
 
@@ -472,6 +502,11 @@ func Test_UpdateBridgePort(t *testing.T) {
 		Ptype:          pb.BridgePortType_ACCESS,
 		LogicalBridges: []string{"Japan", "Australia", "Germany"},
 	}
+	successfulUpdate := func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+		iface := &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: testBridgePortID}}
+		mockNetlink.EXPECT().LinkByName(mock.Anything, testBridgePortID).Return(iface, nil).Once()
+		mockNetlink.EXPECT().LinkModify(mock.Anything, iface).Return(nil).Once()
+	}
 	tests := map[string]struct {
 		mask    *fieldmaskpb.FieldMask
 		in      *pb.BridgePort
@@ -480,6 +515,8 @@ func Test_UpdateBridgePort(t *testing.T) {
 		errMsg  string
 		start   bool
 		exist   bool
+		etag    string
+		on      func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string)
 	}{
 		"invalid fieldmask": {
 			mask: &fieldmaskpb.FieldMask{Paths: []string{"*", "author"}},
@@ -505,6 +542,46 @@ func Test_UpdateBridgePort(t *testing.T) {
 			start:   false,
 			exist:   true,
 		},
+		"stale etag": {
+			mask: nil,
+			in: &pb.BridgePort{
+				Name: testBridgePortName,
+				Spec: spec,
+			},
+			out:     nil,
+			errCode: codes.Aborted,
+			errMsg:  fmt.Sprintf("etag %v does not match the current etag %v of %v, re-read the resource and retry", "0123456789abcdef", utils.Etag(&testBridgePortWithStatus), testBridgePortName),
+			start:   false,
+			exist:   true,
+			etag:    "0123456789abcdef",
+		},
+		"current etag": {
+			mask: nil,
+			in: &pb.BridgePort{
+				Name: testBridgePortName,
+				Spec: spec,
+			},
+			out:     &pb.BridgePort{Spec: spec, Status: &pb.BridgePortStatus{OperStatus: pb.BPOperStatus_BP_OPER_STATUS_UP}},
+			errCode: codes.OK,
+			errMsg:  "",
+			start:   false,
+			exist:   true,
+			etag:    utils.Etag(&testBridgePortWithStatus),
+			on:      successfulUpdate,
+		},
+		"missing etag": {
+			mask: nil,
+			in: &pb.BridgePort{
+				Name: testBridgePortName,
+				Spec: spec,
+			},
+			out:     &pb.BridgePort{Spec: spec, Status: &pb.BridgePortStatus{OperStatus: pb.BPOperStatus_BP_OPER_STATUS_UP}},
+			errCode: codes.OK,
+			errMsg:  "",
+			start:   false,
+			exist:   true,
+			on:      successfulUpdate,
+		},
 	}
 
 	// run tests
@@ -523,8 +600,19 @@ func Test_UpdateBridgePort(t *testing.T) {
 				tt.out.Name = testBridgePortName
 			}
 
+			if tt.on != nil {
+				tt.on(env.mockNetlink, env.mockFrr, tt.errMsg)
+			}
+			if tt.etag != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, utils.EtagHeader, tt.etag)
+			}
+
+			var header metadata.MD
 			request := &pb.UpdateBridgePortRequest{BridgePort: tt.in, UpdateMask: tt.mask}
-			response, err := client.UpdateBridgePort(ctx, request)
+			response, err := client.UpdateBridgePort(ctx, request, grpc.Header(&header))
+			if etags := header.Get(utils.EtagHeader); tt.errCode == codes.OK && (len(etags) == 0 || etags[0] != utils.Etag(tt.out)) {
+				t.Error("etag: expected", utils.Etag(tt.out), "received", header.Get(utils.EtagHeader))
+			}
 			if !proto.Equal(tt.out, response) {
 				t.Error("response: expected", tt.out, "received", response)
 			}
@@ -5,7 +5,13 @@
 package port
 
 import (
+	"encoding/hex"
 	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/vishvananda/netlink"
 
 	"go.einride.tech/aip/fieldbehavior"
 	"go.einride.tech/aip/fieldmask"
@@ -16,9 +22,21 @@ import (
 	"google.golang.org/grpc/status"
 
 	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+)
+
+const (
+	// esiLen is the length of an Ethernet Segment ID
+	esiLen = 10
+	// maxEsDiscriminator is the highest local discriminator of a type 3 ESI
+	maxEsDiscriminator = 16777215
+	// maxIfNameLen is the longest name of a Linux interface
+	maxIfNameLen = 15
 )
 
-func (s *Server) validateCreateBridgePortRequest(in *pb.CreateBridgePortRequest) error {
+// ValidateCreateBridgePortRequest checks a create request without executing it
+func (s *Server) ValidateCreateBridgePortRequest(in *pb.CreateBridgePortRequest) error {
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		return err
@@ -69,3 +87,160 @@ func (s *Server) validateGetBridgePortRequest(in *pb.GetBridgePortRequest) error
 	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
 	return resourcename.Validate(in.Name)
 }
+
+// ValidateUpdateBridgePortOptionsRequest checks an options update without executing it
+func (s *Server) ValidateUpdateBridgePortOptionsRequest(in *pa.UpdateBridgePortOptionsRequest) error {
+	// check required fields
+	if in.BridgePortOptions == nil {
+		return status.Error(codes.InvalidArgument, "missing required field: bridge_port_options")
+	}
+	if in.BridgePortOptions.Spec == nil {
+		return status.Error(codes.InvalidArgument, "missing required field: bridge_port_options.spec")
+	}
+	// update_mask = 2
+	if err := fieldmask.Validate(in.UpdateMask, in.BridgePortOptions); err != nil {
+		return err
+	}
+	for _, path := range in.UpdateMask.GetPaths() {
+		if path != "spec" && !strings.HasPrefix(path, "spec.") {
+			return status.Errorf(codes.InvalidArgument, "field %s can not be updated", path)
+		}
+	}
+	return validateOptionsName(in.BridgePortOptions.Name)
+}
+
+// validateOptionsName checks that name is a BridgePort name followed by /options
+func validateOptionsName(name string) error {
+	if name == "" {
+		return status.Error(codes.InvalidArgument, "missing required field: name")
+	}
+	if err := resourcename.Validate(name); err != nil {
+		return err
+	}
+	id := strings.TrimSuffix(strings.TrimPrefix(name, "//network.opiproject.org/ports/"), "/options")
+	if id == "" || strings.Contains(id, "/") || name != optionsName(resourceIDToFullName(id)) {
+		return status.Errorf(codes.InvalidArgument, "name %s does not match %s", name, optionsPattern)
+	}
+	return nil
+}
+
+// validateMultihoming checks the Ethernet Segment ID, system MAC and DF preference
+func validateMultihoming(multihoming *pa.EvpnMultihoming) error {
+	if multihoming == nil {
+		return nil
+	}
+	if multihoming.EsId == "" {
+		return status.Error(codes.InvalidArgument, "missing required field: multihoming.es_id")
+	}
+	if discriminator, err := strconv.ParseUint(multihoming.EsId, 10, 32); err == nil {
+		// a local discriminator makes a type 3 ESI together with the system MAC
+		if discriminator == 0 || discriminator > maxEsDiscriminator {
+			return status.Errorf(codes.InvalidArgument, "multihoming.es_id value (%d) have to be between 1 and %d", discriminator, maxEsDiscriminator)
+		}
+		if len(multihoming.SysMac) != 6 || multihoming.SysMac[0]&1 == 1 {
+			return status.Error(codes.InvalidArgument, "multihoming.sys_mac has to be a unicast MAC with a local discriminator")
+		}
+	} else {
+		esi, err := hex.DecodeString(strings.ReplaceAll(multihoming.EsId, ":", ""))
+		if err != nil || len(esi) != esiLen || len(multihoming.EsId) != 3*esiLen-1 || esi[0] != 0 {
+			return status.Errorf(codes.InvalidArgument, "multihoming.es_id value (%s) has to be a local discriminator or a type 0 ESI", multihoming.EsId)
+		}
+		if len(multihoming.SysMac) > 0 {
+			return status.Error(codes.InvalidArgument, "multihoming.sys_mac can only be set with a local discriminator")
+		}
+	}
+	if multihoming.DfPreference > 65535 {
+		return status.Errorf(codes.InvalidArgument, "multihoming.df_preference value (%d) have to be between 0 and 65535", multihoming.DfPreference)
+	}
+	return nil
+}
+
+// validateBond checks the members and parameters of the bond of a BridgePort
+func validateBond(options *pa.BridgePortOptions) error {
+	bond := options.Spec.GetBond()
+	if bond == nil {
+		return nil
+	}
+	if len(bond.Members) == 0 {
+		return status.Error(codes.InvalidArgument, "missing required field: bond.members")
+	}
+	resourceID := path.Base(strings.TrimSuffix(options.Name, "/options"))
+	seen := make(map[string]bool)
+	for _, member := range bond.Members {
+		if !validInterfaceName(member) {
+			return status.Errorf(codes.InvalidArgument, "bond.members value (%s) is not an interface name", member)
+		}
+		if member == resourceID {
+			return status.Errorf(codes.InvalidArgument, "bond.members value (%s) is the bond itself", member)
+		}
+		if seen[member] {
+			return status.Errorf(codes.InvalidArgument, "bond.members value (%s) is set more than once", member)
+		}
+		seen[member] = true
+	}
+	if _, ok := netlink.StringToBondModeMap[bondMode(bond)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "bond.mode value (%s) is not a bonding mode", bond.Mode)
+	}
+	if bond.LacpRate != "" {
+		if _, ok := netlink.StringToBondLacpRateMap[bond.LacpRate]; !ok {
+			return status.Errorf(codes.InvalidArgument, "bond.lacp_rate value (%s) has to be slow or fast", bond.LacpRate)
+		}
+		if bondMode(bond) != defaultBondMode {
+			return status.Errorf(codes.InvalidArgument, "bond.lacp_rate can only be set in %s mode", defaultBondMode)
+		}
+	}
+	if bond.XmitHashPolicy != "" {
+		if _, ok := netlink.StringToBondXmitHashPolicyMap[bond.XmitHashPolicy]; !ok {
+			return status.Errorf(codes.InvalidArgument, "bond.xmit_hash_policy value (%s) is not a transmit hash policy", bond.XmitHashPolicy)
+		}
+	}
+	return nil
+}
+
+// validateVirtual checks the kind and parameters of the virtual device of a BridgePort
+func validateVirtual(options *pa.BridgePortOptions) error {
+	virtual := options.Spec.GetVirtual()
+	if virtual == nil {
+		return nil
+	}
+	if options.Spec.Bond != nil {
+		return status.Error(codes.InvalidArgument, "bond and virtual can not be set together")
+	}
+	switch virtual.Type {
+	case virtualVeth:
+		if virtual.Peer == "" {
+			return status.Error(codes.InvalidArgument, "missing required field: virtual.peer")
+		}
+		if !validInterfaceName(virtual.Peer) {
+			return status.Errorf(codes.InvalidArgument, "virtual.peer value (%s) is not an interface name", virtual.Peer)
+		}
+		if virtual.Peer == path.Base(strings.TrimSuffix(options.Name, "/options")) {
+			return status.Errorf(codes.InvalidArgument, "virtual.peer value (%s) is the BridgePort itself", virtual.Peer)
+		}
+	case virtualDummy:
+	case virtualMacvlan:
+		if virtual.Parent == "" {
+			return status.Error(codes.InvalidArgument, "missing required field: virtual.parent")
+		}
+		if !validInterfaceName(virtual.Parent) {
+			return status.Errorf(codes.InvalidArgument, "virtual.parent value (%s) is not an interface name", virtual.Parent)
+		}
+		if _, ok := macvlanModes[virtual.MacvlanMode]; virtual.MacvlanMode != "" && !ok {
+			return status.Errorf(codes.InvalidArgument, "virtual.macvlan_mode value (%s) has to be private, vepa, bridge or passthru", virtual.MacvlanMode)
+		}
+	default:
+		return status.Errorf(codes.InvalidArgument, "virtual.type value (%s) has to be veth, dummy or macvlan", virtual.Type)
+	}
+	if virtual.Type != virtualVeth && (virtual.Peer != "" || virtual.PeerNetns != "") {
+		return status.Error(codes.InvalidArgument, "virtual.peer and virtual.peer_netns can only be set for a veth")
+	}
+	if virtual.Type != virtualMacvlan && (virtual.Parent != "" || virtual.MacvlanMode != "") {
+		return status.Error(codes.InvalidArgument, "virtual.parent and virtual.macvlan_mode can only be set for a macvlan")
+	}
+	return nil
+}
+
+// validInterfaceName tells whether Linux accepts name as the name of an interface
+func validInterfaceName(name string) bool {
+	return name != "" && len(name) <= maxIfNameLen && !strings.ContainsAny(name, "/: \t")
+}
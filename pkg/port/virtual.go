@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package port is the main package of the application
+package port
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+)
+
+// kinds of virtual devices of BridgePorts
+const (
+	virtualVeth    = "veth"
+	virtualDummy   = "dummy"
+	virtualMacvlan = "macvlan"
+)
+
+// macvlanModes maps the macvlan modes of a BridgePort to netlink
+var macvlanModes = map[string]netlink.MacvlanMode{
+	"private":  netlink.MACVLAN_MODE_PRIVATE,
+	"vepa":     netlink.MACVLAN_MODE_VEPA,
+	"bridge":   netlink.MACVLAN_MODE_BRIDGE,
+	"passthru": netlink.MACVLAN_MODE_PASSTHRU,
+}
+
+// macvlanMode returns the mode of a macvlan, bridge when not set
+func macvlanMode(virtual *pa.VirtualInterface) netlink.MacvlanMode {
+	if virtual.MacvlanMode == "" {
+		return netlink.MACVLAN_MODE_BRIDGE
+	}
+	return macvlanModes[virtual.MacvlanMode]
+}
+
+// ownsDevice tells whether the BridgePort created its interface, then
+// deleting the BridgePort deletes it, otherwise it is an existing device
+// like a physical NIC, which stays. The options can not change the device
+// while the BridgePort exists, so they tell how it was created.
+func ownsDevice(options *pa.BridgePortOptions) bool {
+	return options.Spec.GetBond() != nil || options.Spec.GetVirtual() != nil
+}
+
+// netlinkCreateVirtual creates the virtual device of a BridgePort and returns it,
+// a veth is deleted again when its peer can not be set up
+func (s *Server) netlinkCreateVirtual(ctx context.Context, name string, virtual *pa.VirtualInterface) (netlink.Link, error) {
+	var link netlink.Link
+	switch virtual.Type {
+	case virtualVeth:
+		veth := &netlink.Veth{LinkAttrs: netlink.LinkAttrs{Name: name}, PeerName: virtual.Peer}
+		// Example: ip link add opi-port8 type veth peer name opi-port8-ns
+		if err := s.nLink.LinkAdd(ctx, veth); err != nil {
+			fmt.Printf("Failed to create veth: %v", err)
+			return nil, err
+		}
+		if err := s.netlinkSetupPeer(ctx, virtual); err != nil {
+			s.netlinkUndoVirtual(ctx, veth)
+			return nil, err
+		}
+		link = veth
+	case virtualDummy:
+		dummy := &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: name}}
+		// Example: ip link add opi-port8 type dummy
+		if err := s.nLink.LinkAdd(ctx, dummy); err != nil {
+			fmt.Printf("Failed to create dummy: %v", err)
+			return nil, err
+		}
+		link = dummy
+	case virtualMacvlan:
+		parent, err := s.nLink.LinkByName(ctx, virtual.Parent)
+		if err != nil {
+			err := status.Errorf(codes.NotFound, "unable to find key %s", virtual.Parent)
+			return nil, err
+		}
+		macvlan := &netlink.Macvlan{
+			LinkAttrs: netlink.LinkAttrs{Name: name, ParentIndex: parent.Attrs().Index},
+			Mode:      macvlanMode(virtual),
+		}
+		// Example: ip link add link eth2 name opi-port8 type macvlan mode bridge
+		if err := s.nLink.LinkAdd(ctx, macvlan); err != nil {
+			fmt.Printf("Failed to create macvlan: %v", err)
+			return nil, err
+		}
+		link = macvlan
+	}
+	return link, nil
+}
+
+// netlinkSetupPeer moves the peer of a veth to its network namespace,
+// or brings it up when it stays in the namespace of the bridge
+func (s *Server) netlinkSetupPeer(ctx context.Context, virtual *pa.VirtualInterface) error {
+	peer, err := s.nLink.LinkByName(ctx, virtual.Peer)
+	if err != nil {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", virtual.Peer)
+		return err
+	}
+	if virtual.PeerNetns != "" {
+		// Example: ip link set opi-port8-ns netns vm1
+		if err := s.nLink.LinkSetNetns(ctx, peer, virtual.PeerNetns); err != nil {
+			fmt.Printf("Failed to move veth peer: %v", err)
+			return err
+		}
+		return nil
+	}
+	// Example: ip link set opi-port8-ns up
+	if err := s.nLink.LinkSetUp(ctx, peer); err != nil {
+		fmt.Printf("Failed to up veth peer: %v", err)
+		return err
+	}
+	return nil
+}
+
+// netlinkUndoVirtual deletes the virtual device of a BridgePort which could
+// not be created after all, deleting a veth deletes its peer too. Errors are
+// only logged, the error of the create is returned.
+func (s *Server) netlinkUndoVirtual(ctx context.Context, link netlink.Link) {
+	// Example: ip link del opi-port8
+	if err := s.nLink.LinkDel(ctx, link); err != nil {
+		log.Printf("Failed to delete %v: %v", link.Attrs().Name, err)
+	}
+}
+
+// checkVirtualChange rejects changes of the virtual device of an existing BridgePort
+func checkVirtualChange(portName string, oldVirtual, virtual *pa.VirtualInterface) error {
+	if !proto.Equal(oldVirtual, virtual) {
+		err := status.Errorf(codes.FailedPrecondition, "virtual interface of %s can not change while the BridgePort exists", portName)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,354 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package port is the main package of the application
+package port
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/vishvananda/netlink"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils/mocks"
+)
+
+// attachInterface expects the calls, which add the interface of the BridgePort to br-tenant
+func attachInterface(mockNetlink *mocks.Netlink, iface netlink.Link) {
+	mockNetlink.EXPECT().LinkByName(mock.Anything, testBridgePortID).Return(iface, nil).Once()
+	mac := net.HardwareAddr(testBridgePort.Spec.MacAddress[:])
+	mockNetlink.EXPECT().LinkSetHardwareAddr(mock.Anything, iface, mac).Return(nil).Once()
+	mockNetlink.EXPECT().LinkSetMaster(mock.Anything, iface, mock.Anything).Return(nil).Once()
+	vid := uint16(testLogicalBridge.Spec.VlanId)
+	mockNetlink.EXPECT().BridgeVlanAdd(mock.Anything, iface, vid, false, false, false, false).Return(nil).Once()
+	mockNetlink.EXPECT().LinkSetUp(mock.Anything, iface).Return(nil).Once()
+}
+
+func Test_CreateBridgePortVirtual(t *testing.T) {
+	tests := map[string]struct {
+		virtual *pa.VirtualInterface
+		out     *pb.BridgePort
+		errCode codes.Code
+		errMsg  string
+		on      func(mockNetlink *mocks.Netlink, errMsg string)
+	}{
+		"veth": {
+			virtual: &pa.VirtualInterface{Type: "veth", Peer: "opi-port8-vm"},
+			out:     &testBridgePortWithStatus,
+			errCode: codes.OK,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				veth := &netlink.Veth{LinkAttrs: netlink.LinkAttrs{Name: testBridgePortID}, PeerName: "opi-port8-vm"}
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, veth).Return(nil).Once()
+				peer := &netlink.Veth{LinkAttrs: netlink.LinkAttrs{Name: "opi-port8-vm"}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "opi-port8-vm").Return(peer, nil).Once()
+				mockNetlink.EXPECT().LinkSetUp(mock.Anything, peer).Return(nil).Once()
+				attachInterface(mockNetlink, veth)
+			},
+		},
+		"veth with the peer in a network namespace": {
+			virtual: &pa.VirtualInterface{Type: "veth", Peer: "eth0", PeerNetns: "vm1"},
+			out:     &testBridgePortWithStatus,
+			errCode: codes.OK,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				veth := &netlink.Veth{LinkAttrs: netlink.LinkAttrs{Name: testBridgePortID}, PeerName: "eth0"}
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, veth).Return(nil).Once()
+				peer := &netlink.Veth{LinkAttrs: netlink.LinkAttrs{Name: "eth0"}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "eth0").Return(peer, nil).Once()
+				mockNetlink.EXPECT().LinkSetNetns(mock.Anything, peer, "vm1").Return(nil).Once()
+				attachInterface(mockNetlink, veth)
+			},
+		},
+		"failed LinkSetNetns call": {
+			virtual: &pa.VirtualInterface{Type: "veth", Peer: "eth0", PeerNetns: "vm1"},
+			errCode: codes.Unknown,
+			errMsg:  "Failed to call LinkSetNetns",
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				veth := &netlink.Veth{LinkAttrs: netlink.LinkAttrs{Name: testBridgePortID}, PeerName: "eth0"}
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, veth).Return(nil).Once()
+				peer := &netlink.Veth{LinkAttrs: netlink.LinkAttrs{Name: "eth0"}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "eth0").Return(peer, nil).Once()
+				mockNetlink.EXPECT().LinkSetNetns(mock.Anything, peer, "vm1").Return(errors.New(errMsg)).Once()
+				mockNetlink.EXPECT().LinkDel(mock.Anything, veth).Return(nil).Once()
+			},
+		},
+		"veth peer does not exist": {
+			virtual: &pa.VirtualInterface{Type: "veth", Peer: "opi-port8-vm"},
+			errCode: codes.NotFound,
+			errMsg:  "unable to find key opi-port8-vm",
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				veth := &netlink.Veth{LinkAttrs: netlink.LinkAttrs{Name: testBridgePortID}, PeerName: "opi-port8-vm"}
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, veth).Return(nil).Once()
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "opi-port8-vm").Return(nil, errors.New(errMsg)).Once()
+				mockNetlink.EXPECT().LinkDel(mock.Anything, veth).Return(nil).Once()
+			},
+		},
+		"veth deleted when it can not be attached": {
+			virtual: &pa.VirtualInterface{Type: "veth", Peer: "opi-port8-vm"},
+			errCode: codes.Unknown,
+			errMsg:  "Failed to call LinkSetMaster",
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				veth := &netlink.Veth{LinkAttrs: netlink.LinkAttrs{Name: testBridgePortID}, PeerName: "opi-port8-vm"}
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, veth).Return(nil).Once()
+				peer := &netlink.Veth{LinkAttrs: netlink.LinkAttrs{Name: "opi-port8-vm"}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "opi-port8-vm").Return(peer, nil).Once()
+				mockNetlink.EXPECT().LinkSetUp(mock.Anything, peer).Return(nil).Once()
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testBridgePortID).Return(veth, nil).Once()
+				mac := net.HardwareAddr(testBridgePort.Spec.MacAddress[:])
+				mockNetlink.EXPECT().LinkSetHardwareAddr(mock.Anything, veth, mac).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetMaster(mock.Anything, veth, mock.Anything).Return(errors.New(errMsg)).Once()
+				mockNetlink.EXPECT().LinkDel(mock.Anything, veth).Return(nil).Once()
+			},
+		},
+		"dummy": {
+			virtual: &pa.VirtualInterface{Type: "dummy"},
+			out:     &testBridgePortWithStatus,
+			errCode: codes.OK,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				dummy := &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: testBridgePortID}}
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, dummy).Return(nil).Once()
+				attachInterface(mockNetlink, dummy)
+			},
+		},
+		"existing device is not taken over": {
+			virtual: &pa.VirtualInterface{Type: "dummy"},
+			errCode: codes.Unknown,
+			errMsg:  "file exists",
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				dummy := &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: testBridgePortID}}
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, dummy).Return(errors.New(errMsg)).Once()
+			},
+		},
+		"dummy deleted when it can not be attached": {
+			virtual: &pa.VirtualInterface{Type: "dummy"},
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %s", testBridgePortID),
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				dummy := &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: testBridgePortID}}
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, dummy).Return(nil).Once()
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testBridgePortID).Return(nil, errors.New("Failed to call LinkByName")).Once()
+				mockNetlink.EXPECT().LinkDel(mock.Anything, dummy).Return(nil).Once()
+			},
+		},
+		"macvlan": {
+			virtual: &pa.VirtualInterface{Type: "macvlan", Parent: "eth2", MacvlanMode: "vepa"},
+			out:     &testBridgePortWithStatus,
+			errCode: codes.OK,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				parent := &netlink.Device{LinkAttrs: netlink.LinkAttrs{Name: "eth2", Index: 3}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "eth2").Return(parent, nil).Once()
+				macvlan := &netlink.Macvlan{LinkAttrs: netlink.LinkAttrs{Name: testBridgePortID, ParentIndex: 3}, Mode: netlink.MACVLAN_MODE_VEPA}
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, macvlan).Return(nil).Once()
+				attachInterface(mockNetlink, macvlan)
+			},
+		},
+		"macvlan parent does not exist": {
+			virtual: &pa.VirtualInterface{Type: "macvlan", Parent: "eth2"},
+			errCode: codes.NotFound,
+			errMsg:  "unable to find key eth2",
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "eth2").Return(nil, errors.New(errMsg)).Once()
+			},
+		},
+		"macvlan deleted when it can not be attached": {
+			virtual: &pa.VirtualInterface{Type: "macvlan", Parent: "eth2"},
+			errCode: codes.Unknown,
+			errMsg:  "Failed to call BridgeVlanAdd",
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				parent := &netlink.Device{LinkAttrs: netlink.LinkAttrs{Name: "eth2", Index: 3}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "eth2").Return(parent, nil).Once()
+				macvlan := &netlink.Macvlan{LinkAttrs: netlink.LinkAttrs{Name: testBridgePortID, ParentIndex: 3}, Mode: netlink.MACVLAN_MODE_BRIDGE}
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, macvlan).Return(nil).Once()
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testBridgePortID).Return(macvlan, nil).Once()
+				mac := net.HardwareAddr(testBridgePort.Spec.MacAddress[:])
+				mockNetlink.EXPECT().LinkSetHardwareAddr(mock.Anything, macvlan, mac).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetMaster(mock.Anything, macvlan, mock.Anything).Return(nil).Once()
+				vid := uint16(testLogicalBridge.Spec.VlanId)
+				mockNetlink.EXPECT().BridgeVlanAdd(mock.Anything, macvlan, vid, false, false, false, false).Return(errors.New(errMsg)).Once()
+				mockNetlink.EXPECT().LinkDel(mock.Anything, macvlan).Return(nil).Once()
+			},
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pb.NewBridgePortServiceClient(env.conn)
+
+			_ = env.opi.store.Set(testLogicalBridgeName, &testLogicalBridgeWithStatus)
+			_ = env.opi.store.Set(testOptionsName, &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{Virtual: tt.virtual}})
+			bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: tenantbridgeName}}
+			env.mockNetlink.EXPECT().LinkByName(mock.Anything, tenantbridgeName).Return(bridge, nil).Once()
+			if tt.on != nil {
+				tt.on(env.mockNetlink, tt.errMsg)
+			}
+
+			request := &pb.CreateBridgePortRequest{BridgePort: proto.Clone(&testBridgePort).(*pb.BridgePort), BridgePortId: testBridgePortID}
+			response, err := client.CreateBridgePort(ctx, request)
+			if !proto.Equal(tt.out, response) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+		})
+	}
+}
+
+func Test_DeleteBridgePortVirtual(t *testing.T) {
+	ctx := context.Background()
+	env := newTestEnv(ctx, t)
+	defer env.Close()
+	client := pb.NewBridgePortServiceClient(env.conn)
+
+	_ = env.opi.store.Set(testBridgePortName, &testBridgePortWithStatus)
+	_ = env.opi.store.Set(testLogicalBridgeName, &testLogicalBridgeWithStatus)
+	_ = env.opi.store.Set(testOptionsName, &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{
+		Virtual: &pa.VirtualInterface{Type: "veth", Peer: "eth0", PeerNetns: "vm1"},
+	}})
+
+	// the BridgePort created the veth pair, so it deletes both ends
+	veth := &netlink.Veth{LinkAttrs: netlink.LinkAttrs{Name: testBridgePortID}}
+	env.mockNetlink.EXPECT().LinkByName(mock.Anything, testBridgePortID).Return(veth, nil).Once()
+	env.mockNetlink.EXPECT().LinkSetDown(mock.Anything, veth).Return(nil).Once()
+	vid := uint16(testLogicalBridge.Spec.VlanId)
+	env.mockNetlink.EXPECT().BridgeVlanDel(mock.Anything, veth, vid, true, true, false, false).Return(nil).Once()
+	env.mockNetlink.EXPECT().LinkDel(mock.Anything, veth).Return(nil).Once()
+
+	if _, err := client.DeleteBridgePort(ctx, &pb.DeleteBridgePortRequest{Name: testBridgePortName}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func Test_UpdateBridgePortOptionsVirtual(t *testing.T) {
+	tests := map[string]struct {
+		in      *pa.BridgePortOptionsSpec
+		stored  *pa.VirtualInterface
+		out     *pa.BridgePortOptions
+		errCode codes.Code
+		errMsg  string
+		exist   bool
+	}{
+		"invalid type": {
+			in:      &pa.BridgePortOptionsSpec{Virtual: &pa.VirtualInterface{Type: "tap"}},
+			errCode: codes.InvalidArgument,
+			errMsg:  "virtual.type value (tap) has to be veth, dummy or macvlan",
+		},
+		"veth without peer": {
+			in:      &pa.BridgePortOptionsSpec{Virtual: &pa.VirtualInterface{Type: "veth"}},
+			errCode: codes.InvalidArgument,
+			errMsg:  "missing required field: virtual.peer",
+		},
+		"veth peer is the port itself": {
+			in:      &pa.BridgePortOptionsSpec{Virtual: &pa.VirtualInterface{Type: "veth", Peer: testBridgePortID}},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("virtual.peer value (%s) is the BridgePort itself", testBridgePortID),
+		},
+		"macvlan without parent": {
+			in:      &pa.BridgePortOptionsSpec{Virtual: &pa.VirtualInterface{Type: "macvlan"}},
+			errCode: codes.InvalidArgument,
+			errMsg:  "missing required field: virtual.parent",
+		},
+		"invalid macvlan mode": {
+			in:      &pa.BridgePortOptionsSpec{Virtual: &pa.VirtualInterface{Type: "macvlan", Parent: "eth2", MacvlanMode: "source"}},
+			errCode: codes.InvalidArgument,
+			errMsg:  "virtual.macvlan_mode value (source) has to be private, vepa, bridge or passthru",
+		},
+		"peer of a dummy": {
+			in:      &pa.BridgePortOptionsSpec{Virtual: &pa.VirtualInterface{Type: "dummy", PeerNetns: "vm1"}},
+			errCode: codes.InvalidArgument,
+			errMsg:  "virtual.peer and virtual.peer_netns can only be set for a veth",
+		},
+		"parent of a veth": {
+			in:      &pa.BridgePortOptionsSpec{Virtual: &pa.VirtualInterface{Type: "veth", Peer: "eth0", Parent: "eth2"}},
+			errCode: codes.InvalidArgument,
+			errMsg:  "virtual.parent and virtual.macvlan_mode can only be set for a macvlan",
+		},
+		"bond and virtual": {
+			in: &pa.BridgePortOptionsSpec{
+				Bond:    &pa.BondOptions{Members: []string{"eth1"}},
+				Virtual: &pa.VirtualInterface{Type: "dummy"},
+			},
+			errCode: codes.InvalidArgument,
+			errMsg:  "bond and virtual can not be set together",
+		},
+		"stored until the port is created": {
+			in: &pa.BridgePortOptionsSpec{Virtual: &pa.VirtualInterface{Type: "veth", Peer: "eth0", PeerNetns: "/proc/1234/ns/net"}},
+			out: &pa.BridgePortOptions{
+				Name:   testOptionsName,
+				Spec:   &pa.BridgePortOptionsSpec{Virtual: &pa.VirtualInterface{Type: "veth", Peer: "eth0", PeerNetns: "/proc/1234/ns/net"}},
+				Status: &pa.BridgePortOptionsStatus{},
+			},
+			errCode: codes.OK,
+		},
+		"fixed while the port exists": {
+			in:      &pa.BridgePortOptionsSpec{Virtual: &pa.VirtualInterface{Type: "veth", Peer: "eth1"}},
+			stored:  &pa.VirtualInterface{Type: "veth", Peer: "eth0"},
+			errCode: codes.FailedPrecondition,
+			errMsg:  fmt.Sprintf("virtual interface of %s can not change while the BridgePort exists", testBridgePortName),
+			exist:   true,
+		},
+		"not added to an existing port": {
+			in:      &pa.BridgePortOptionsSpec{Virtual: &pa.VirtualInterface{Type: "dummy"}},
+			errCode: codes.FailedPrecondition,
+			errMsg:  fmt.Sprintf("virtual interface of %s can not change while the BridgePort exists", testBridgePortName),
+			exist:   true,
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pa.NewBridgePortOptionsServiceClient(env.conn)
+
+			if tt.exist {
+				_ = env.opi.store.Set(testBridgePortName, &testBridgePortWithStatus)
+				env.opi.ListHelper[testBridgePortName] = false
+			}
+			if tt.stored != nil {
+				_ = env.opi.store.Set(testOptionsName, &pa.BridgePortOptions{Name: testOptionsName, Spec: &pa.BridgePortOptionsSpec{Virtual: tt.stored}})
+			}
+
+			request := &pa.UpdateBridgePortOptionsRequest{BridgePortOptions: &pa.BridgePortOptions{Name: testOptionsName, Spec: tt.in}}
+			response, err := client.UpdateBridgePortOptions(ctx, request)
+			if !proto.Equal(tt.out, response) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package ratelimit limits the rate of calls globally and per client
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+)
+
+// Scopes of the limits, as reported in the metrics
+const (
+	scopeGlobal = "global"
+	scopeClient = "client"
+)
+
+// sweepInterval is how often buckets of idle clients are dropped
+const sweepInterval = time.Minute
+
+// bucket holds up to burst tokens and gains rate tokens per second, every
+// admitted call takes one token
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// refill adds the tokens gained since the last refill
+func (b *bucket) refill(now time.Time, rate float64, burst int) {
+	b.tokens = math.Min(float64(burst), b.tokens+now.Sub(b.last).Seconds()*rate)
+	b.last = now
+}
+
+// wait returns how long it takes until the bucket has a token
+func (b *bucket) wait(rate float64) time.Duration {
+	if b.tokens >= 1 {
+		return 0
+	}
+	return time.Duration(math.Ceil((1 - b.tokens) / rate * float64(time.Second)))
+}
+
+// Limiter admits calls while both the global bucket and the bucket of the
+// calling client have tokens
+type Limiter struct {
+	limits config.RateLimitConfig
+	now    func() time.Time
+
+	mu        sync.Mutex
+	global    bucket
+	clients   map[string]*bucket
+	lastSweep time.Time
+
+	allowed  *prometheus.CounterVec
+	rejected *prometheus.CounterVec
+	tracked  prometheus.Gauge
+}
+
+// NewLimiter creates Limiter enforcing limits, with full buckets, and
+// registers its metrics with registerer
+func NewLimiter(limits config.RateLimitConfig, registerer prometheus.Registerer) *Limiter {
+	return newLimiter(limits, registerer, time.Now)
+}
+
+func newLimiter(limits config.RateLimitConfig, registerer prometheus.Registerer, now func() time.Time) *Limiter {
+	factory := promauto.With(registerer)
+	l := &Limiter{
+		limits:    limits,
+		now:       now,
+		global:    bucket{tokens: float64(limits.Burst), last: now()},
+		clients:   make(map[string]*bucket),
+		lastSweep: now(),
+		allowed: factory.NewCounterVec(prometheus.CounterOpts{
+			Name: "opi_evpn_ratelimit_allowed_total",
+			Help: "Calls admitted by the rate limiter.",
+		}, []string{"method"}),
+		rejected: factory.NewCounterVec(prometheus.CounterOpts{
+			Name: "opi_evpn_ratelimit_rejected_total",
+			Help: "Calls rejected by the rate limiter, by the scope of the exceeded limit.",
+		}, []string{"method", "scope"}),
+		tracked: factory.NewGauge(prometheus.GaugeOpts{
+			Name: "opi_evpn_ratelimit_clients",
+			Help: "Clients with a token bucket.",
+		}),
+	}
+	limitsGauge := factory.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "opi_evpn_ratelimit_limit",
+		Help: "Configured rate limits, zero rate means unlimited.",
+	}, []string{"scope", "parameter"})
+	limitsGauge.WithLabelValues(scopeGlobal, "rate").Set(limits.Rate)
+	limitsGauge.WithLabelValues(scopeGlobal, "burst").Set(float64(limits.Burst))
+	limitsGauge.WithLabelValues(scopeClient, "rate").Set(limits.ClientRate)
+	limitsGauge.WithLabelValues(scopeClient, "burst").Set(float64(limits.ClientBurst))
+	return l
+}
+
+// UnaryServerInterceptor rejects calls beyond the limits with ResourceExhausted,
+// the RetryInfo detail tells when the call can be retried
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		scope, wait := l.take(clientOf(ctx))
+		if scope != "" {
+			l.rejected.WithLabelValues(info.FullMethod, scope).Inc()
+			st := status.Newf(codes.ResourceExhausted, "%v rate limit exceeded, retry in %v", scope, wait)
+			if detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(wait)}); err == nil {
+				st = detailed
+			}
+			return nil, st.Err()
+		}
+		l.allowed.WithLabelValues(info.FullMethod).Inc()
+		return handler(ctx, req)
+	}
+}
+
+// take takes a token from the global bucket and the bucket of client. When
+// either is empty, no token is taken and the scope of the empty bucket is
+// returned with the time until it refills.
+func (l *Limiter) take(client string) (string, time.Duration) {
+	now := l.now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sweep(now)
+
+	var global, own *bucket
+	if l.limits.Rate > 0 {
+		global = &l.global
+		global.refill(now, l.limits.Rate, l.limits.Burst)
+	}
+	if l.limits.ClientRate > 0 {
+		own = l.clients[client]
+		if own == nil {
+			own = &bucket{tokens: float64(l.limits.ClientBurst), last: now}
+			l.clients[client] = own
+			l.tracked.Set(float64(len(l.clients)))
+		}
+		own.refill(now, l.limits.ClientRate, l.limits.ClientBurst)
+	}
+	// report the client limit first, the caller can only fix its own rate
+	if own != nil && own.tokens < 1 {
+		return scopeClient, own.wait(l.limits.ClientRate)
+	}
+	if global != nil && global.tokens < 1 {
+		return scopeGlobal, global.wait(l.limits.Rate)
+	}
+	if global != nil {
+		global.tokens--
+	}
+	if own != nil {
+		own.tokens--
+	}
+	return "", 0
+}
+
+// sweep drops the buckets which refilled completely, they are recreated
+// full on the next call of the client anyway
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for client, b := range l.clients {
+		b.refill(now, l.limits.ClientRate, l.limits.ClientBurst)
+		if b.tokens >= float64(l.limits.ClientBurst) {
+			delete(l.clients, client)
+		}
+	}
+	l.tracked.Set(float64(len(l.clients)))
+}
+
+// clientOf identifies the caller by its verified certificate, or by its
+// address for connections without mutual TLS
+func clientOf(ctx context.Context) string {
+	if subject := utils.PeerSubject(ctx); subject != "" {
+		return subject
+	}
+	address := utils.PeerAddress(ctx)
+	// ignore the source port, which changes with every connection
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		return host
+	}
+	return address
+}
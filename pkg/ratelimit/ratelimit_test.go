@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package ratelimit limits the rate of calls globally and per client
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+)
+
+// call is a call of client after the clock advanced by after
+type call struct {
+	client  string
+	after   time.Duration
+	errCode codes.Code
+}
+
+func Test_UnaryServerInterceptor(t *testing.T) {
+	tests := map[string]struct {
+		limits config.RateLimitConfig
+		calls  []call
+	}{
+		"unlimited": {
+			limits: config.RateLimitConfig{Burst: 1, ClientBurst: 1},
+			calls: []call{
+				{client: "10.0.0.1", errCode: codes.OK},
+				{client: "10.0.0.1", errCode: codes.OK},
+				{client: "10.0.0.1", errCode: codes.OK},
+			},
+		},
+		"global burst exhausted": {
+			limits: config.RateLimitConfig{Rate: 1, Burst: 2},
+			calls: []call{
+				{client: "10.0.0.1", errCode: codes.OK},
+				{client: "10.0.0.2", errCode: codes.OK},
+				{client: "10.0.0.3", errCode: codes.ResourceExhausted},
+			},
+		},
+		"global bucket refills": {
+			limits: config.RateLimitConfig{Rate: 2, Burst: 1},
+			calls: []call{
+				{client: "10.0.0.1", errCode: codes.OK},
+				{client: "10.0.0.1", after: 100 * time.Millisecond, errCode: codes.ResourceExhausted},
+				{client: "10.0.0.1", after: 400 * time.Millisecond, errCode: codes.OK},
+			},
+		},
+		"client limit spares other clients": {
+			limits: config.RateLimitConfig{ClientRate: 1, ClientBurst: 2},
+			calls: []call{
+				{client: "10.0.0.1", errCode: codes.OK},
+				{client: "10.0.0.1", errCode: codes.OK},
+				{client: "10.0.0.1", errCode: codes.ResourceExhausted},
+				{client: "10.0.0.2", errCode: codes.OK},
+			},
+		},
+		"rejected call takes no global token": {
+			limits: config.RateLimitConfig{Rate: 1, Burst: 2, ClientRate: 1, ClientBurst: 1},
+			calls: []call{
+				{client: "10.0.0.1", errCode: codes.OK},
+				{client: "10.0.0.1", errCode: codes.ResourceExhausted},
+				{client: "10.0.0.2", errCode: codes.OK},
+				{client: "10.0.0.3", errCode: codes.ResourceExhausted},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			now := time.Unix(1700000000, 0)
+			limiter := newLimiter(tt.limits, prometheus.NewRegistry(), func() time.Time { return now })
+			interceptor := limiter.UnaryServerInterceptor()
+			info := &grpc.UnaryServerInfo{FullMethod: "/opi_api.network.evpn_gw.v1alpha1.BridgePortService/CreateBridgePort"}
+			handler := func(context.Context, interface{}) (interface{}, error) { return nil, nil }
+			rejected := 0
+			for i, c := range tt.calls {
+				now = now.Add(c.after)
+				ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(c.client), Port: 40000 + i}})
+				_, err := interceptor(ctx, nil, info, handler)
+				if status.Code(err) != c.errCode {
+					t.Error("call", i, "error code: expected", c.errCode, "received", err)
+				}
+				if status.Code(err) != codes.ResourceExhausted {
+					continue
+				}
+				rejected++
+				details := status.Convert(err).Details()
+				if len(details) != 1 {
+					t.Fatal("call", i, "expected retry info, received", details)
+				}
+				retry, ok := details[0].(*errdetails.RetryInfo)
+				if !ok || retry.RetryDelay.AsDuration() <= 0 {
+					t.Error("call", i, "expected positive retry delay, received", details[0])
+				}
+			}
+			if count := testutil.ToFloat64(limiter.rejected.WithLabelValues(info.FullMethod, scopeClient)) +
+				testutil.ToFloat64(limiter.rejected.WithLabelValues(info.FullMethod, scopeGlobal)); int(count) != rejected {
+				t.Error("rejected metric: expected", rejected, "received", count)
+			}
+		})
+	}
+}
+
+func TestLimiter_Sweep(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	limiter := newLimiter(config.RateLimitConfig{ClientRate: 1, ClientBurst: 5}, prometheus.NewRegistry(), func() time.Time { return now })
+	limiter.take("10.0.0.1")
+	limiter.take("10.0.0.2")
+	if len(limiter.clients) != 2 {
+		t.Fatal("Expect 2 clients, received", len(limiter.clients))
+	}
+	// the first client keeps calling while the second one stays idle
+	now = now.Add(sweepInterval / 2)
+	limiter.take("10.0.0.1")
+	for i := 0; i < 5; i++ {
+		limiter.take("10.0.0.1")
+	}
+	now = now.Add(sweepInterval / 2)
+	limiter.take("10.0.0.1")
+	if _, ok := limiter.clients["10.0.0.2"]; ok || len(limiter.clients) != 1 {
+		t.Error("Expect idle client to be dropped, received", limiter.clients)
+	}
+	if gauge := testutil.ToFloat64(limiter.tracked); gauge != 1 {
+		t.Error("clients metric: expected 1, received", gauge)
+	}
+}
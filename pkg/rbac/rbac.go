@@ -0,0 +1,245 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package rbac authorizes calls by the roles bound to client certificates
+package rbac
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+)
+
+// namePrefix starts the names of all EVPN resources
+const namePrefix = "//network.opiproject.org/"
+
+// collections of the resources created by the services, by service name
+var collections = map[string]string{
+	"VrfService":           "vrfs",
+	"LogicalBridgeService": "bridges",
+	"BridgePortService":    "ports",
+	"SviService":           "svis",
+	"StaticRouteService":   "routes",
+}
+
+// operation is the kind of access a call needs
+type operation int
+
+const (
+	read operation = iota
+	write
+	admin
+)
+
+// Authorizer checks every call against the role bindings of the policy
+type Authorizer struct {
+	bindings []config.RoleBinding
+}
+
+// NewAuthorizer creates Authorizer enforcing the bindings of policy
+func NewAuthorizer(policy config.AuthorizationConfig) *Authorizer {
+	return &Authorizer{bindings: policy.Bindings}
+}
+
+// UnaryServerInterceptor rejects calls not allowed by any binding of the caller
+func (a *Authorizer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		msg, _ := req.(proto.Message)
+		if err := a.authorize(ctx, info.FullMethod, msg); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects streams not allowed by any binding of the caller.
+// The request is not known yet, so streams are authorized like calls
+// without resource names.
+func (a *Authorizer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := a.authorize(ss.Context(), info.FullMethod, nil); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func (a *Authorizer) authorize(ctx context.Context, fullMethod string, req proto.Message) error {
+	cert := utils.PeerCertificate(ctx)
+	if cert == nil {
+		return status.Error(codes.Unauthenticated, "verified client certificate is required")
+	}
+	op := operationOf(fullMethod)
+	names := resourceNames(req)
+	for _, b := range a.bindings {
+		if matchesIdentity(b, cert) && allows(b.Role, op) && inScope(b.Prefixes, names) {
+			return nil
+		}
+	}
+	if len(names) == 0 {
+		return status.Errorf(codes.PermissionDenied, "%v is not allowed to call %v", cert.Subject, fullMethod)
+	}
+	return status.Errorf(codes.PermissionDenied, "%v is not allowed to call %v on %v",
+		cert.Subject, fullMethod, strings.Join(names, ", "))
+}
+
+// operationOf classifies the method by its name, unknown methods need the admin role
+func operationOf(fullMethod string) operation {
+	if strings.HasPrefix(fullMethod, "/grpc.reflection.") {
+		return read
+	}
+	method := path.Base(fullMethod)
+	for _, prefix := range []string{"Get", "List", "Watch", "Export"} {
+		if strings.HasPrefix(method, prefix) {
+			return read
+		}
+	}
+	for _, prefix := range []string{"Create", "Update", "Delete", "Batch"} {
+		if strings.HasPrefix(method, prefix) {
+			return write
+		}
+	}
+	return admin
+}
+
+func allows(role string, op operation) bool {
+	switch role {
+	case config.RoleReadOnly:
+		return op == read
+	case config.RoleTenantAdmin:
+		return op == read || op == write
+	case config.RoleGlobalAdmin:
+		return true
+	}
+	return false
+}
+
+func matchesIdentity(b config.RoleBinding, cert *x509.Certificate) bool {
+	for _, subject := range b.Subjects {
+		if subject == "*" || subject == cert.Subject.String() {
+			return true
+		}
+	}
+	var sans []string
+	sans = append(sans, cert.DNSNames...)
+	sans = append(sans, cert.EmailAddresses...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	for _, uri := range cert.URIs {
+		sans = append(sans, uri.String())
+	}
+	for _, want := range b.SANs {
+		for _, san := range sans {
+			if want == "*" || want == san {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// inScope tells whether all names start with one of the prefixes. Calls
+// without names, like List, reach all resources and need a binding without
+// prefixes.
+func inScope(prefixes, names []string) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+	if len(names) == 0 {
+		return false
+	}
+	for _, name := range names {
+		found := false
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(name, prefix) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// resourceNames returns the names of the resources the request refers to,
+// including the names create requests, also those within batches, are going
+// to give to the new resources
+func resourceNames(req proto.Message) []string {
+	if req == nil {
+		return nil
+	}
+	var names []string
+	collectNames(req.ProtoReflect(), &names)
+	return names
+}
+
+// createdName returns the name of the resource created by m, when m is a
+// create request of one of the collections
+func createdName(m protoreflect.Message) (string, bool) {
+	msgName := string(m.Descriptor().Name())
+	if !strings.HasPrefix(msgName, "Create") || !strings.HasSuffix(msgName, "Request") {
+		return "", false
+	}
+	collection, ok := collections[strings.TrimSuffix(strings.TrimPrefix(msgName, "Create"), "Request")+"Service"]
+	if !ok {
+		return "", false
+	}
+	id := ""
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() == protoreflect.StringKind && strings.HasSuffix(string(fd.Name()), "_id") {
+			id = m.Get(fd).String()
+		}
+	}
+	// system generated names are unknown upfront and never in scope of prefixes
+	if id == "" {
+		id = "<system generated>"
+	}
+	return fmt.Sprintf("%v%v/%v", namePrefix, collection, id), true
+}
+
+// collectNames adds all string fields holding resource names
+func collectNames(m protoreflect.Message, names *[]string) {
+	if name, ok := createdName(m); ok {
+		*names = append(*names, name)
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				addName(fd, list.Get(i), names)
+			}
+		default:
+			addName(fd, v, names)
+		}
+		return true
+	})
+}
+
+func addName(fd protoreflect.FieldDescriptor, v protoreflect.Value, names *[]string) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		if strings.HasPrefix(v.String(), namePrefix) {
+			*names = append(*names, v.String())
+		}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		collectNames(v.Message(), names)
+	}
+}
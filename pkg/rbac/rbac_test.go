@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package rbac authorizes calls by the roles bound to client certificates
+package rbac
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+)
+
+var (
+	adminCert   = &x509.Certificate{Subject: pkix.Name{CommonName: "admin"}}
+	tenantCert  = &x509.Certificate{Subject: pkix.Name{CommonName: "ops"}, DNSNames: []string{"tenant-a.example"}}
+	viewerCert  = &x509.Certificate{Subject: pkix.Name{CommonName: "viewer"}}
+	unboundCert = &x509.Certificate{Subject: pkix.Name{CommonName: "nobody"}}
+	testPolicy  = config.AuthorizationConfig{
+		Enabled: true,
+		Bindings: []config.RoleBinding{
+			{Role: config.RoleGlobalAdmin, Subjects: []string{"CN=admin"}},
+			{Role: config.RoleTenantAdmin, SANs: []string{"tenant-a.example"}, Prefixes: []string{
+				"//network.opiproject.org/vrfs/tenant-a-",
+				"//network.opiproject.org/svis/tenant-a-",
+				"//network.opiproject.org/bridges/shared",
+			}},
+			{Role: config.RoleReadOnly, Subjects: []string{"CN=viewer"}},
+		},
+	}
+)
+
+func peerContext(cert *x509.Certificate) context.Context {
+	ctx := context.Background()
+	if cert == nil {
+		return ctx
+	}
+	info := credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}}
+	return peer.NewContext(ctx, &peer.Peer{AuthInfo: info})
+}
+
+func Test_UnaryServerInterceptor(t *testing.T) {
+	tests := map[string]struct {
+		cert    *x509.Certificate
+		method  string
+		in      proto.Message
+		errCode codes.Code
+	}{
+		"no certificate": {
+			cert:    nil,
+			method:  "/opi_api.network.evpn_gw.v1alpha1.VrfService/ListVrfs",
+			in:      &pb.ListVrfsRequest{},
+			errCode: codes.Unauthenticated,
+		},
+		"unbound certificate": {
+			cert:    unboundCert,
+			method:  "/opi_api.network.evpn_gw.v1alpha1.VrfService/GetVrf",
+			in:      &pb.GetVrfRequest{Name: "//network.opiproject.org/vrfs/tenant-a-blue"},
+			errCode: codes.PermissionDenied,
+		},
+		"global admin imports": {
+			cert:    adminCert,
+			method:  "/opi_evpn_bridge.v1alpha1.AdminService/ImportConfig",
+			in:      &pa.ImportConfigRequest{},
+			errCode: codes.OK,
+		},
+		"read only lists": {
+			cert:    viewerCert,
+			method:  "/opi_api.network.evpn_gw.v1alpha1.VrfService/ListVrfs",
+			in:      &pb.ListVrfsRequest{},
+			errCode: codes.OK,
+		},
+		"read only deletes": {
+			cert:    viewerCert,
+			method:  "/opi_api.network.evpn_gw.v1alpha1.VrfService/DeleteVrf",
+			in:      &pb.DeleteVrfRequest{Name: "//network.opiproject.org/vrfs/tenant-a-blue"},
+			errCode: codes.PermissionDenied,
+		},
+		"tenant admin creates in scope": {
+			cert:    tenantCert,
+			method:  "/opi_api.network.evpn_gw.v1alpha1.VrfService/CreateVrf",
+			in:      &pb.CreateVrfRequest{VrfId: "tenant-a-blue", Vrf: &pb.Vrf{Spec: &pb.VrfSpec{}}},
+			errCode: codes.OK,
+		},
+		"tenant admin creates out of scope": {
+			cert:    tenantCert,
+			method:  "/opi_api.network.evpn_gw.v1alpha1.VrfService/CreateVrf",
+			in:      &pb.CreateVrfRequest{VrfId: "tenant-b-blue", Vrf: &pb.Vrf{Spec: &pb.VrfSpec{}}},
+			errCode: codes.PermissionDenied,
+		},
+		"tenant admin creates with system generated id": {
+			cert:    tenantCert,
+			method:  "/opi_api.network.evpn_gw.v1alpha1.VrfService/CreateVrf",
+			in:      &pb.CreateVrfRequest{Vrf: &pb.Vrf{Spec: &pb.VrfSpec{}}},
+			errCode: codes.PermissionDenied,
+		},
+		"tenant admin references shared bridge": {
+			cert:   tenantCert,
+			method: "/opi_api.network.evpn_gw.v1alpha1.SviService/CreateSvi",
+			in: &pb.CreateSviRequest{SviId: "tenant-a-svi", Svi: &pb.Svi{Spec: &pb.SviSpec{
+				Vrf:           "//network.opiproject.org/vrfs/tenant-a-blue",
+				LogicalBridge: "//network.opiproject.org/bridges/shared",
+			}}},
+			errCode: codes.OK,
+		},
+		"tenant admin references foreign vrf": {
+			cert:   tenantCert,
+			method: "/opi_api.network.evpn_gw.v1alpha1.SviService/CreateSvi",
+			in: &pb.CreateSviRequest{SviId: "tenant-a-svi", Svi: &pb.Svi{Spec: &pb.SviSpec{
+				Vrf:           "//network.opiproject.org/vrfs/tenant-b-blue",
+				LogicalBridge: "//network.opiproject.org/bridges/shared",
+			}}},
+			errCode: codes.PermissionDenied,
+		},
+		"tenant admin updates in scope": {
+			cert:    tenantCert,
+			method:  "/opi_api.network.evpn_gw.v1alpha1.VrfService/UpdateVrf",
+			in:      &pb.UpdateVrfRequest{Vrf: &pb.Vrf{Name: "//network.opiproject.org/vrfs/tenant-a-blue"}},
+			errCode: codes.OK,
+		},
+		"tenant admin batch creates in scope": {
+			cert:   tenantCert,
+			method: "/opi_evpn_bridge.v1alpha1.LogicalBridgeBatchService/BatchCreateLogicalBridges",
+			in: &pa.BatchCreateLogicalBridgesRequest{Requests: []*pb.CreateLogicalBridgeRequest{
+				{LogicalBridgeId: "shared", LogicalBridge: &pb.LogicalBridge{Spec: &pb.LogicalBridgeSpec{}}},
+			}},
+			errCode: codes.OK,
+		},
+		"tenant admin batch creates out of scope": {
+			cert:   tenantCert,
+			method: "/opi_evpn_bridge.v1alpha1.LogicalBridgeBatchService/BatchCreateLogicalBridges",
+			in: &pa.BatchCreateLogicalBridgesRequest{Requests: []*pb.CreateLogicalBridgeRequest{
+				{LogicalBridgeId: "shared", LogicalBridge: &pb.LogicalBridge{Spec: &pb.LogicalBridgeSpec{}}},
+				{LogicalBridgeId: "tenant-b-blue", LogicalBridge: &pb.LogicalBridge{Spec: &pb.LogicalBridgeSpec{}}},
+			}},
+			errCode: codes.PermissionDenied,
+		},
+		"read only batch deletes": {
+			cert:   viewerCert,
+			method: "/opi_evpn_bridge.v1alpha1.BridgePortBatchService/BatchDeleteBridgePorts",
+			in: &pa.BatchDeleteBridgePortsRequest{Requests: []*pb.DeleteBridgePortRequest{
+				{Name: "//network.opiproject.org/ports/tenant-a-port"},
+			}},
+			errCode: codes.PermissionDenied,
+		},
+		"tenant admin lists": {
+			cert:    tenantCert,
+			method:  "/opi_api.network.evpn_gw.v1alpha1.VrfService/ListVrfs",
+			in:      &pb.ListVrfsRequest{},
+			errCode: codes.PermissionDenied,
+		},
+		"tenant admin applies": {
+			cert:    tenantCert,
+			method:  "/opi_evpn_bridge.v1alpha1.AdminService/ApplyConfig",
+			in:      &pa.ApplyConfigRequest{},
+			errCode: codes.PermissionDenied,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			handler := func(context.Context, interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			}
+			interceptor := NewAuthorizer(testPolicy).UnaryServerInterceptor()
+			_, err := interceptor(peerContext(tt.cert), tt.in, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if status.Code(err) != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", err)
+			}
+			if called != (tt.errCode == codes.OK) {
+				t.Error("Expect handler to be called", tt.errCode == codes.OK, "called", called)
+			}
+		})
+	}
+}
+
+type testStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s testStream) Context() context.Context {
+	return s.ctx
+}
+
+func Test_StreamServerInterceptor(t *testing.T) {
+	tests := map[string]struct {
+		cert    *x509.Certificate
+		errCode codes.Code
+	}{
+		"read only watches": {
+			cert:    viewerCert,
+			errCode: codes.OK,
+		},
+		"tenant admin watches": {
+			cert:    tenantCert,
+			errCode: codes.PermissionDenied,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			interceptor := NewAuthorizer(testPolicy).StreamServerInterceptor()
+			info := &grpc.StreamServerInfo{FullMethod: "/opi_evpn_bridge.v1alpha1.WatchService/Watch", IsServerStream: true}
+			err := interceptor(nil, testStream{ctx: peerContext(tt.cert)}, info, func(interface{}, grpc.ServerStream) error { return nil })
+			if status.Code(err) != tt.errCode {
+				t.Error("error code: expected", tt.errCode, "received", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package route is the main package of the application
+package route
+
+import (
+	"context"
+	"log"
+	"net"
+	"sort"
+	"testing"
+
+	"github.com/philippgille/gokv/gomap"
+	"go.einride.tech/aip/resourcename"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+	pc "github.com/opiproject/opi-api/network/opinetcommon/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils/mocks"
+)
+
+func sortStaticRoutes(routes []*pa.StaticRoute) {
+	sort.Slice(routes, func(i int, j int) bool {
+		return routes[i].Name < routes[j].Name
+	})
+}
+
+func resourceIDToFullName(resourceID string) string {
+	return resourcename.Join(
+		"//network.opiproject.org/",
+		"routes", resourceID,
+	)
+}
+
+// TODO: move all of this to a common place
+var (
+	testVrfID   = "opi-vrf8"
+	testVrfName = resourcename.Join("//network.opiproject.org/", "vrfs", testVrfID)
+	testVrf     = pb.Vrf{
+		Name: testVrfName,
+		Spec: &pb.VrfSpec{
+			Vni: proto.Uint32(1000),
+			VtepIpPrefix: &pc.IPPrefix{
+				Addr: &pc.IPAddress{
+					Af: pc.IpAf_IP_AF_INET,
+					V4OrV6: &pc.IPAddress_V4Addr{
+						V4Addr: 167772162,
+					},
+				},
+				Len: 24,
+			},
+		},
+		Status: &pb.VrfStatus{
+			LocalAs:      4,
+			RoutingTable: 1001,
+		},
+	}
+)
+
+type testEnv struct {
+	mockNetlink *mocks.Netlink
+	opi         *Server
+	conn        *grpc.ClientConn
+}
+
+func (e *testEnv) Close() {
+	err := e.conn.Close()
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func newTestEnv(ctx context.Context, t *testing.T) *testEnv {
+	store := gomap.NewStore(gomap.Options{Codec: utils.ProtoCodec{}})
+	env := &testEnv{}
+	env.mockNetlink = mocks.NewNetlink(t)
+	env.opi = NewServerWithArgs(env.mockNetlink, store)
+	conn, err := grpc.DialContext(ctx,
+		"",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(dialer(env.opi)))
+	if err != nil {
+		log.Fatal(err)
+	}
+	env.conn = conn
+	return env
+}
+
+func dialer(opi *Server) func(context.Context, string) (net.Conn, error) {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+
+	pa.RegisterStaticRouteServiceServer(server, opi)
+
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			log.Fatal(err)
+		}
+	}()
+
+	return func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}
+}
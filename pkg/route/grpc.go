@@ -0,0 +1,299 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package route is the main package of the application
+package route
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+
+	"go.einride.tech/aip/fieldbehavior"
+	"go.einride.tech/aip/fieldmask"
+	"go.einride.tech/aip/resourceid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// CreateStaticRoute adds a static route to the routing table of a VRF
+func (s *Server) CreateStaticRoute(ctx context.Context, in *pa.CreateStaticRouteRequest) (*pa.StaticRoute, error) {
+	// check input correctness
+	if err := s.ValidateCreateStaticRouteRequest(in); err != nil {
+		return nil, err
+	}
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.CreateStaticRoute(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
+	// see https://google.aip.dev/133#user-specified-ids
+	resourceID := resourceid.NewSystemGenerated()
+	if in.StaticRouteId != "" {
+		log.Printf("client provided the ID of a resource %v, ignoring the name field %v", in.StaticRouteId, in.StaticRoute.Name)
+		resourceID = in.StaticRouteId
+	}
+	in.StaticRoute.Name = resourceIDToFullName(resourceID)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// idempotent API when called with same key, should return same object
+	obj := new(pa.StaticRoute)
+	ok, err := s.store.Get(in.StaticRoute.Name, obj)
+	if err != nil {
+		fmt.Printf("Failed to interact with store: %v", err)
+		return nil, err
+	}
+	if ok {
+		log.Printf("Already existing StaticRoute with id %v", in.StaticRoute.Name)
+		utils.SendEtag(ctx, obj)
+		return obj, nil
+	}
+	// now get Vrf to add the route to its routing table
+	vrf, err := s.fetchVrf(in.StaticRoute.Spec.Vrf)
+	if err != nil {
+		return nil, err
+	}
+	if vrf == nil {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.StaticRoute.Spec.Vrf)
+		return nil, err
+	}
+	if err := checkAddressFamily(vrf, in.StaticRoute.Spec); err != nil {
+		return nil, err
+	}
+	// configure netlink
+	if err := s.netlinkCreateStaticRoute(ctx, in.StaticRoute, vrf); err != nil {
+		return nil, err
+	}
+	// translate object
+	response := utils.ProtoClone(in.StaticRoute)
+	response.Status = &pa.StaticRouteStatus{OperStatus: pa.StaticRouteOperStatus_STATIC_ROUTE_OPER_STATUS_UP}
+	log.Printf("new object %v", response)
+	// save object to the database
+	s.ListHelper[in.StaticRoute.Name] = false
+	err = s.store.Set(in.StaticRoute.Name, response)
+	if err != nil {
+		return nil, err
+	}
+	utils.SendEtag(ctx, response)
+	return response, nil
+}
+
+// DeleteStaticRoute removes a static route from the routing table of its VRF
+func (s *Server) DeleteStaticRoute(ctx context.Context, in *pa.DeleteStaticRouteRequest) (*emptypb.Empty, error) {
+	// check input correctness
+	if err := s.validateDeleteStaticRouteRequest(in); err != nil {
+		return nil, err
+	}
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.DeleteStaticRoute(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
+	// serialize writers, so the etag check and the write are atomic
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// fetch object from the database
+	obj := new(pa.StaticRoute)
+	ok, err := s.store.Get(in.Name, obj)
+	if err != nil {
+		fmt.Printf("Failed to interact with store: %v", err)
+		return nil, err
+	}
+	if !ok {
+		if in.AllowMissing {
+			return &emptypb.Empty{}, nil
+		}
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
+		return nil, err
+	}
+	// reject stale etag, see https://google.aip.dev/154
+	if err := utils.CheckEtag(ctx, obj); err != nil {
+		return nil, err
+	}
+	// the routing table is gone already with a deleted VRF
+	vrf, err := s.fetchVrf(obj.Spec.Vrf)
+	if err != nil {
+		return nil, err
+	}
+	if vrf != nil {
+		if err := s.netlinkDeleteStaticRoute(ctx, obj, vrf); err != nil {
+			return nil, err
+		}
+	}
+	// remove from the Database
+	delete(s.ListHelper, obj.Name)
+	err = s.store.Delete(obj.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// UpdateStaticRoute replaces a static route in the routing table of its VRF
+func (s *Server) UpdateStaticRoute(ctx context.Context, in *pa.UpdateStaticRouteRequest) (*pa.StaticRoute, error) {
+	// check input correctness
+	if err := s.validateUpdateStaticRouteRequest(in); err != nil {
+		return nil, err
+	}
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.UpdateStaticRoute(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
+	// serialize writers, so the etag check and the write are atomic
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// fetch object from the database
+	obj := new(pa.StaticRoute)
+	ok, err := s.store.Get(in.StaticRoute.Name, obj)
+	if err != nil {
+		fmt.Printf("Failed to interact with store: %v", err)
+		return nil, err
+	}
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.StaticRoute.Name)
+		return nil, err
+	}
+	// reject stale etag, see https://google.aip.dev/154
+	if err := utils.CheckEtag(ctx, obj); err != nil {
+		return nil, err
+	}
+	// status is output only
+	in.StaticRoute.Status = nil
+	response := utils.ProtoClone(obj)
+	fieldmask.Update(in.UpdateMask, response, in.StaticRoute)
+	// validate the merged route, the request may only set some of its fields
+	if err := fieldbehavior.ValidateRequiredFields(response); err != nil {
+		return nil, err
+	}
+	if err := validateStaticRouteSpec(response.Spec); err != nil {
+		return nil, err
+	}
+	vrf, err := s.fetchVrf(response.Spec.Vrf)
+	if err != nil {
+		return nil, err
+	}
+	if vrf == nil {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", response.Spec.Vrf)
+		return nil, err
+	}
+	if err := checkAddressFamily(vrf, response.Spec); err != nil {
+		return nil, err
+	}
+	if !proto.Equal(obj.Spec, response.Spec) {
+		oldVrf, err := s.fetchVrf(obj.Spec.Vrf)
+		if err != nil {
+			return nil, err
+		}
+		if oldVrf != nil {
+			if err := s.netlinkDeleteStaticRoute(ctx, obj, oldVrf); err != nil {
+				return nil, err
+			}
+		}
+		if err := s.netlinkCreateStaticRoute(ctx, response, vrf); err != nil {
+			if oldVrf != nil {
+				s.netlinkRestoreStaticRoute(ctx, obj, oldVrf)
+			}
+			return nil, err
+		}
+	}
+	response.Status = &pa.StaticRouteStatus{OperStatus: pa.StaticRouteOperStatus_STATIC_ROUTE_OPER_STATUS_UP}
+	err = s.store.Set(response.Name, response)
+	if err != nil {
+		return nil, err
+	}
+	utils.SendEtag(ctx, response)
+	return response, nil
+}
+
+// GetStaticRoute gets a static route
+func (s *Server) GetStaticRoute(ctx context.Context, in *pa.GetStaticRouteRequest) (*pa.StaticRoute, error) {
+	// check input correctness
+	if err := s.validateGetStaticRouteRequest(in); err != nil {
+		return nil, err
+	}
+	// fetch object from the database
+	obj := new(pa.StaticRoute)
+	ok, err := s.store.Get(in.Name, obj)
+	if err != nil {
+		fmt.Printf("Failed to interact with store: %v", err)
+		return nil, err
+	}
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
+		return nil, err
+	}
+	utils.SendEtag(ctx, obj)
+	return obj, nil
+}
+
+// ListStaticRoutes lists static routes
+func (s *Server) ListStaticRoutes(_ context.Context, in *pa.ListStaticRoutesRequest) (*pa.ListStaticRoutesResponse, error) {
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		return nil, err
+	}
+	// fetch pagination from the database, calculate size and offset
+	size, offset, perr := utils.ExtractPagination(in.PageSize, in.PageToken, s.Pagination)
+	if perr != nil {
+		return nil, perr
+	}
+	// fetch object from the database
+	Blobarray := []*pa.StaticRoute{}
+	for key := range s.ListHelper {
+		if !strings.HasPrefix(key, "//network.opiproject.org/routes") {
+			continue
+		}
+		route := new(pa.StaticRoute)
+		ok, err := s.store.Get(key, route)
+		if err != nil {
+			fmt.Printf("Failed to interact with store: %v", err)
+			return nil, err
+		}
+		if !ok {
+			err := status.Errorf(codes.NotFound, "unable to find key %s", key)
+			return nil, err
+		}
+		Blobarray = append(Blobarray, route)
+	}
+	// sort is needed, since MAP is unsorted in golang, and we might get different results
+	sortStaticRoutes(Blobarray)
+	log.Printf("Limiting result len(%d) to [%d:%d]", len(Blobarray), offset, size)
+	Blobarray, hasMoreElements := utils.LimitPagination(Blobarray, offset, size)
+	token := ""
+	if hasMoreElements {
+		token = uuid.New().String()
+		s.Pagination[token] = offset + size
+	}
+	return &pa.ListStaticRoutesResponse{StaticRoutes: Blobarray, NextPageToken: token}, nil
+}
+
+// fetchVrf returns the VRF of a route, or nil when it does not exist
+func (s *Server) fetchVrf(name string) (*pb.Vrf, error) {
+	vrf := new(pb.Vrf)
+	ok, err := s.store.Get(name, vrf)
+	if err != nil {
+		fmt.Printf("Failed to interact with store: %v", err)
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return vrf, nil
+}
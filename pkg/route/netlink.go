@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package route is the main package of the application
+package route
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"path"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+	pc "github.com/opiproject/opi-api/network/opinetcommon/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ipOf returns the address, or nil when it does not match its address family
+func ipOf(addr *pc.IPAddress) net.IP {
+	switch addr.GetAf() {
+	case pc.IpAf_IP_AF_INET:
+		if _, ok := addr.GetV4OrV6().(*pc.IPAddress_V6Addr); ok {
+			return nil
+		}
+		myip := make(net.IP, 4)
+		binary.BigEndian.PutUint32(myip, addr.GetV4Addr())
+		return myip
+	case pc.IpAf_IP_AF_INET6:
+		if len(addr.GetV6Addr()) != net.IPv6len {
+			return nil
+		}
+		return net.IP(addr.GetV6Addr())
+	}
+	return nil
+}
+
+// ipNetOf returns the prefix, which has to be validated before
+func ipNetOf(prefix *pc.IPPrefix) *net.IPNet {
+	myip := ipOf(prefix.Addr)
+	return &net.IPNet{IP: myip, Mask: net.CIDRMask(int(prefix.Len), len(myip)*8)}
+}
+
+// kernelRoute returns the static route in the routing table of its VRF
+func kernelRoute(obj *pa.StaticRoute, tableID uint32) *netlink.Route {
+	return &netlink.Route{
+		Dst:      ipNetOf(obj.Spec.Prefix),
+		Gw:       ipOf(obj.Spec.NextHop),
+		Table:    int(tableID),
+		Protocol: utils.RouteProtocol,
+		Priority: int(obj.Spec.Metric),
+	}
+}
+
+// vrfTable returns the routing table of the VRF device
+func (s *Server) vrfTable(ctx context.Context, vrf *pb.Vrf) (uint32, error) {
+	vrfName := path.Base(vrf.Name)
+	link, err := s.nLink.LinkByName(ctx, vrfName)
+	if err != nil {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", vrfName)
+		return 0, err
+	}
+	vrfdev, ok := link.(*netlink.Vrf)
+	if !ok {
+		err := status.Errorf(codes.FailedPrecondition, "link %s is not a VRF", vrfName)
+		return 0, err
+	}
+	return vrfdev.Table, nil
+}
+
+func (s *Server) netlinkCreateStaticRoute(ctx context.Context, obj *pa.StaticRoute, vrf *pb.Vrf) error {
+	tableID, err := s.vrfTable(ctx, vrf)
+	if err != nil {
+		return err
+	}
+	// Example: ip route add 0.0.0.0/0 via 10.0.1.254 table 1000 proto evpn-gw-br metric 10
+	route := kernelRoute(obj, tableID)
+	log.Printf("Adding route %v", route)
+	if err := s.nLink.RouteAdd(ctx, route); err != nil {
+		fmt.Printf("Failed to add route: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (s *Server) netlinkDeleteStaticRoute(ctx context.Context, obj *pa.StaticRoute, vrf *pb.Vrf) error {
+	tableID, err := s.vrfTable(ctx, vrf)
+	if err != nil {
+		return err
+	}
+	// Example: ip route del 0.0.0.0/0 via 10.0.1.254 table 1000 proto evpn-gw-br metric 10
+	route := kernelRoute(obj, tableID)
+	log.Printf("Deleting route %v", route)
+	// the route may be gone already, for example with the device of its next hop
+	if err := s.nLink.RouteDel(ctx, route); err != nil && !errors.Is(err, unix.ESRCH) {
+		fmt.Printf("Failed to delete route: %v", err)
+		return err
+	}
+	return nil
+}
+
+// netlinkRestoreStaticRoute adds the replaced route again after adding its
+// replacement failed, so the kernel keeps matching the stored route
+func (s *Server) netlinkRestoreStaticRoute(ctx context.Context, obj *pa.StaticRoute, vrf *pb.Vrf) {
+	if err := s.netlinkCreateStaticRoute(ctx, obj, vrf); err != nil {
+		log.Printf("Failed to restore route of %v: %v", obj.Name, err)
+	}
+}
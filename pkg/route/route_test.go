@@ -0,0 +1,719 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package route is the main package of the application
+package route
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pc "github.com/opiproject/opi-api/network/opinetcommon/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils/mocks"
+)
+
+var (
+	testStaticRouteID   = "opi-route8"
+	testStaticRouteName = resourceIDToFullName(testStaticRouteID)
+	testStaticRoute     = pa.StaticRoute{
+		Spec: &pa.StaticRouteSpec{
+			Vrf: testVrfName,
+			Prefix: &pc.IPPrefix{
+				Addr: &pc.IPAddress{
+					Af:     pc.IpAf_IP_AF_INET,
+					V4OrV6: &pc.IPAddress_V4Addr{V4Addr: 0},
+				},
+				Len: 0,
+			},
+			NextHop: &pc.IPAddress{
+				Af:     pc.IpAf_IP_AF_INET,
+				V4OrV6: &pc.IPAddress_V4Addr{V4Addr: 167772670},
+			},
+			Metric: 10,
+		},
+	}
+	testStaticRouteWithStatus = pa.StaticRoute{
+		Name: testStaticRouteName,
+		Spec: testStaticRoute.Spec,
+		Status: &pa.StaticRouteStatus{
+			OperStatus: pa.StaticRouteOperStatus_STATIC_ROUTE_OPER_STATUS_UP,
+		},
+	}
+	// ip route add 0.0.0.0/0 via 10.0.1.254 table 1001 proto evpn-gw-br metric 10
+	testKernelRoute = &netlink.Route{
+		Dst:      &net.IPNet{IP: net.IPv4(0, 0, 0, 0).To4(), Mask: net.CIDRMask(0, 32)},
+		Gw:       net.IPv4(10, 0, 1, 254).To4(),
+		Table:    1001,
+		Protocol: utils.RouteProtocol,
+		Priority: 10,
+	}
+	testVrfLink = &netlink.Vrf{LinkAttrs: netlink.LinkAttrs{Name: testVrfID}, Table: 1001}
+)
+
+// testSpec returns a copy of the spec of the test route changed by modify
+func testSpec(modify func(spec *pa.StaticRouteSpec)) *pa.StaticRoute {
+	route := &pa.StaticRoute{Spec: utils.ProtoClone(testStaticRoute.Spec)}
+	modify(route.Spec)
+	return route
+}
+
+func Test_CreateStaticRoute(t *testing.T) {
+	tests := map[string]struct {
+		id      string
+		in      *pa.StaticRoute
+		out     *pa.StaticRoute
+		errCode codes.Code
+		errMsg  string
+		exist   bool
+		on      func(mockNetlink *mocks.Netlink, errMsg string)
+	}{
+		"illegal resource_id": {
+			id:      "CapitalLettersNotAllowed",
+			in:      &testStaticRoute,
+			out:     nil,
+			errCode: codes.Unknown,
+			errMsg:  fmt.Sprintf("user-settable ID must only contain lowercase, numbers and hyphens (%v)", "got: 'C' in position 0"),
+			exist:   false,
+			on:      nil,
+		},
+		"already exists": {
+			id:      testStaticRouteID,
+			in:      &testStaticRoute,
+			out:     &testStaticRouteWithStatus,
+			errCode: codes.OK,
+			errMsg:  "",
+			exist:   true,
+			on:      nil,
+		},
+		"no required static_route field": {
+			id:      testStaticRouteID,
+			in:      nil,
+			out:     nil,
+			errCode: codes.Unknown,
+			errMsg:  "missing required field: static_route",
+			exist:   false,
+			on:      nil,
+		},
+		"no required vrf field": {
+			id: testStaticRouteID,
+			in: testSpec(func(spec *pa.StaticRouteSpec) {
+				spec.Vrf = ""
+			}),
+			out:     nil,
+			errCode: codes.Unknown,
+			errMsg:  "missing required field: static_route.spec.vrf",
+			exist:   false,
+			on:      nil,
+		},
+		"no required next_hop field": {
+			id: testStaticRouteID,
+			in: testSpec(func(spec *pa.StaticRouteSpec) {
+				spec.NextHop = nil
+			}),
+			out:     nil,
+			errCode: codes.Unknown,
+			errMsg:  "missing required field: static_route.spec.next_hop",
+			exist:   false,
+			on:      nil,
+		},
+		"malformed Vrf name": {
+			id: testStaticRouteID,
+			in: testSpec(func(spec *pa.StaticRouteSpec) {
+				spec.Vrf = "-ABC-DEF"
+			}),
+			out:     nil,
+			errCode: codes.Unknown,
+			errMsg:  fmt.Sprintf("segment '%s': not a valid DNS name", "-ABC-DEF"),
+			exist:   false,
+			on:      nil,
+		},
+		"prefix address of other family": {
+			id: testStaticRouteID,
+			in: testSpec(func(spec *pa.StaticRouteSpec) {
+				spec.Prefix.Addr.V4OrV6 = &pc.IPAddress_V6Addr{V6Addr: net.IPv6zero}
+			}),
+			out:     nil,
+			errCode: codes.InvalidArgument,
+			errMsg:  "prefix address does not match address family IP_AF_INET",
+			exist:   false,
+			on:      nil,
+		},
+		"prefix length out of range": {
+			id: testStaticRouteID,
+			in: testSpec(func(spec *pa.StaticRouteSpec) {
+				spec.Prefix.Len = 33
+			}),
+			out:     nil,
+			errCode: codes.InvalidArgument,
+			errMsg:  "prefix length 33 is out of range 0 to 32",
+			exist:   false,
+			on:      nil,
+		},
+		"prefix with host bits": {
+			id: testStaticRouteID,
+			in: testSpec(func(spec *pa.StaticRouteSpec) {
+				spec.Prefix.Addr.V4OrV6 = &pc.IPAddress_V4Addr{V4Addr: 167772161}
+				spec.Prefix.Len = 24
+			}),
+			out:     nil,
+			errCode: codes.InvalidArgument,
+			errMsg:  "prefix 10.0.0.1/24 has host bits set",
+			exist:   false,
+			on:      nil,
+		},
+		"next hop of other family": {
+			id: testStaticRouteID,
+			in: testSpec(func(spec *pa.StaticRouteSpec) {
+				spec.NextHop = &pc.IPAddress{Af: pc.IpAf_IP_AF_INET6, V4OrV6: &pc.IPAddress_V6Addr{V6Addr: net.ParseIP("fe80::1")}}
+			}),
+			out:     nil,
+			errCode: codes.InvalidArgument,
+			errMsg:  "next hop is not of address family IP_AF_INET of the prefix",
+			exist:   false,
+			on:      nil,
+		},
+		"unspecified next hop": {
+			id: testStaticRouteID,
+			in: testSpec(func(spec *pa.StaticRouteSpec) {
+				spec.NextHop.V4OrV6 = &pc.IPAddress_V4Addr{V4Addr: 0}
+			}),
+			out:     nil,
+			errCode: codes.InvalidArgument,
+			errMsg:  "next hop has to be a valid address",
+			exist:   false,
+			on:      nil,
+		},
+		"address family not routed by VRF": {
+			id: testStaticRouteID,
+			in: testSpec(func(spec *pa.StaticRouteSpec) {
+				spec.Prefix = &pc.IPPrefix{Addr: &pc.IPAddress{Af: pc.IpAf_IP_AF_INET6, V4OrV6: &pc.IPAddress_V6Addr{V6Addr: net.IPv6zero}}}
+				spec.NextHop = &pc.IPAddress{Af: pc.IpAf_IP_AF_INET6, V4OrV6: &pc.IPAddress_V6Addr{V6Addr: net.ParseIP("fe80::1")}}
+			}),
+			out:     nil,
+			errCode: codes.FailedPrecondition,
+			errMsg:  fmt.Sprintf("VRF %v does not route address family IP_AF_INET6", testVrfName),
+			exist:   false,
+			on:      nil,
+		},
+		"missing Vrf name": {
+			id: testStaticRouteID,
+			in: testSpec(func(spec *pa.StaticRouteSpec) {
+				spec.Vrf = "unknown-vrf-id"
+			}),
+			out:     nil,
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %v", "unknown-vrf-id"),
+			exist:   false,
+			on:      nil,
+		},
+		"failed LinkByName call": {
+			id:      testStaticRouteID,
+			in:      &testStaticRoute,
+			out:     nil,
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %v", testVrfID),
+			exist:   false,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(nil, errors.New(errMsg)).Once()
+			},
+		},
+		"link is not a VRF": {
+			id:      testStaticRouteID,
+			in:      &testStaticRoute,
+			out:     nil,
+			errCode: codes.FailedPrecondition,
+			errMsg:  fmt.Sprintf("link %v is not a VRF", testVrfID),
+			exist:   false,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: testVrfID}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(bridge, nil).Once()
+			},
+		},
+		"failed RouteAdd call": {
+			id:      testStaticRouteID,
+			in:      &testStaticRoute,
+			out:     nil,
+			errCode: codes.Unknown,
+			errMsg:  "Failed to call RouteAdd",
+			exist:   false,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(testVrfLink, nil).Once()
+				mockNetlink.EXPECT().RouteAdd(mock.Anything, testKernelRoute).Return(errors.New(errMsg)).Once()
+			},
+		},
+		"successful call": {
+			id:      testStaticRouteID,
+			in:      &testStaticRoute,
+			out:     &testStaticRouteWithStatus,
+			errCode: codes.OK,
+			errMsg:  "",
+			exist:   false,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(testVrfLink, nil).Once()
+				mockNetlink.EXPECT().RouteAdd(mock.Anything, testKernelRoute).Return(nil).Once()
+			},
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pa.NewStaticRouteServiceClient(env.conn)
+
+			if tt.exist {
+				_ = env.opi.store.Set(testStaticRouteName, &testStaticRouteWithStatus)
+			}
+			if tt.on != nil {
+				tt.on(env.mockNetlink, tt.errMsg)
+			}
+			_ = env.opi.store.Set(testVrfName, &testVrf)
+
+			request := &pa.CreateStaticRouteRequest{StaticRoute: utils.ProtoClone(tt.in), StaticRouteId: tt.id}
+			response, err := client.CreateStaticRoute(ctx, request)
+			if !proto.Equal(tt.out, response) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+		})
+	}
+}
+
+func Test_CreateStaticRouteDryRun(t *testing.T) {
+	ctx := context.Background()
+	env := newTestEnv(ctx, t)
+	defer env.Close()
+	client := pa.NewStaticRouteServiceClient(env.conn)
+
+	_ = env.opi.store.Set(testVrfName, &testVrf)
+	env.mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(testVrfLink, nil).Once()
+
+	var header metadata.MD
+	ctx = metadata.AppendToOutgoingContext(ctx, utils.DryRunHeader, "true")
+	request := &pa.CreateStaticRouteRequest{StaticRoute: utils.ProtoClone(&testStaticRoute), StaticRouteId: testStaticRouteID}
+	if _, err := client.CreateStaticRoute(ctx, request, grpc.Header(&header)); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	expected := []string{"ip route add default via 10.0.1.254 table 1001 proto 108 metric 10"}
+	if operations := header.Get(utils.DryRunNetlinkHeader); !reflect.DeepEqual(operations, expected) {
+		t.Error("netlink operations: expected", expected, "received", operations)
+	}
+	// nothing is saved
+	ok, _ := env.opi.store.Get(testStaticRouteName, new(pa.StaticRoute))
+	if ok || len(env.opi.ListHelper) != 0 {
+		t.Error("expected dry run to leave the store untouched")
+	}
+}
+
+func Test_DeleteStaticRoute(t *testing.T) {
+	tests := map[string]struct {
+		in      string
+		out     *emptypb.Empty
+		errCode codes.Code
+		errMsg  string
+		missing bool
+		noVrf   bool
+		on      func(mockNetlink *mocks.Netlink, errMsg string)
+	}{
+		"valid request with unknown key": {
+			in:      "unknown-id",
+			out:     nil,
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %v", resourceIDToFullName("unknown-id")),
+			missing: false,
+			on:      nil,
+		},
+		"unknown key with missing allowed": {
+			in:      "unknown-id",
+			out:     &emptypb.Empty{},
+			errCode: codes.OK,
+			errMsg:  "",
+			missing: true,
+			on:      nil,
+		},
+		"malformed name": {
+			in:      "-ABC-DEF",
+			out:     &emptypb.Empty{},
+			errCode: codes.Unknown,
+			errMsg:  fmt.Sprintf("segment '%s': not a valid DNS name", "-ABC-DEF"),
+			missing: false,
+			on:      nil,
+		},
+		"failed LinkByName call": {
+			in:      testStaticRouteID,
+			out:     nil,
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %v", testVrfID),
+			missing: false,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(nil, errors.New(errMsg)).Once()
+			},
+		},
+		"failed RouteDel call": {
+			in:      testStaticRouteID,
+			out:     nil,
+			errCode: codes.Unknown,
+			errMsg:  "Failed to call RouteDel",
+			missing: false,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(testVrfLink, nil).Once()
+				mockNetlink.EXPECT().RouteDel(mock.Anything, testKernelRoute).Return(errors.New(errMsg)).Once()
+			},
+		},
+		"route already gone": {
+			in:      testStaticRouteID,
+			out:     &emptypb.Empty{},
+			errCode: codes.OK,
+			errMsg:  "",
+			missing: false,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(testVrfLink, nil).Once()
+				mockNetlink.EXPECT().RouteDel(mock.Anything, testKernelRoute).Return(unix.ESRCH).Once()
+			},
+		},
+		"vrf already deleted": {
+			in:      testStaticRouteID,
+			out:     &emptypb.Empty{},
+			errCode: codes.OK,
+			errMsg:  "",
+			missing: false,
+			noVrf:   true,
+			on:      nil,
+		},
+		"successful call": {
+			in:      testStaticRouteID,
+			out:     &emptypb.Empty{},
+			errCode: codes.OK,
+			errMsg:  "",
+			missing: false,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(testVrfLink, nil).Once()
+				mockNetlink.EXPECT().RouteDel(mock.Anything, testKernelRoute).Return(nil).Once()
+			},
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pa.NewStaticRouteServiceClient(env.conn)
+
+			fname1 := resourceIDToFullName(tt.in)
+			_ = env.opi.store.Set(testStaticRouteName, &testStaticRouteWithStatus)
+			if !tt.noVrf {
+				_ = env.opi.store.Set(testVrfName, &testVrf)
+			}
+			if tt.on != nil {
+				tt.on(env.mockNetlink, tt.errMsg)
+			}
+
+			request := &pa.DeleteStaticRouteRequest{Name: fname1, AllowMissing: tt.missing}
+			response, err := client.DeleteStaticRoute(ctx, request)
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+
+			if reflect.TypeOf(response) != reflect.TypeOf(tt.out) {
+				t.Error("response: expected", reflect.TypeOf(tt.out), "received", reflect.TypeOf(response))
+			}
+		})
+	}
+}
+
+func Test_UpdateStaticRoute(t *testing.T) {
+	newNextHop := &pc.IPAddress{
+		Af:     pc.IpAf_IP_AF_INET,
+		V4OrV6: &pc.IPAddress_V4Addr{V4Addr: 167772669},
+	}
+	updated := utils.ProtoClone(&testStaticRouteWithStatus)
+	updated.Spec.NextHop = newNextHop
+	newKernelRoute := *testKernelRoute
+	newKernelRoute.Gw = net.IPv4(10, 0, 1, 253).To4()
+	tests := map[string]struct {
+		mask    *fieldmaskpb.FieldMask
+		in      *pa.StaticRoute
+		out     *pa.StaticRoute
+		errCode codes.Code
+		errMsg  string
+		on      func(mockNetlink *mocks.Netlink, errMsg string)
+	}{
+		"invalid fieldmask": {
+			mask: &fieldmaskpb.FieldMask{Paths: []string{"*", "author"}},
+			in: &pa.StaticRoute{
+				Name: testStaticRouteName,
+				Spec: testStaticRoute.Spec,
+			},
+			out:     nil,
+			errCode: codes.Unknown,
+			errMsg:  fmt.Sprintf("invalid field path: %s", "'*' must not be used with other paths"),
+			on:      nil,
+		},
+		"valid request with unknown key": {
+			mask: nil,
+			in: &pa.StaticRoute{
+				Name: resourceIDToFullName("unknown-id"),
+				Spec: testStaticRoute.Spec,
+			},
+			out:     nil,
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %v", resourceIDToFullName("unknown-id")),
+			on:      nil,
+		},
+		"invalid merged route": {
+			mask: &fieldmaskpb.FieldMask{Paths: []string{"spec.prefix.len"}},
+			in: &pa.StaticRoute{
+				Name: testStaticRouteName,
+				Spec: &pa.StaticRouteSpec{Prefix: &pc.IPPrefix{Len: 40}},
+			},
+			out:     nil,
+			errCode: codes.InvalidArgument,
+			errMsg:  "prefix length 40 is out of range 0 to 32",
+			on:      nil,
+		},
+		"failed RouteAdd call": {
+			mask: &fieldmaskpb.FieldMask{Paths: []string{"spec.next_hop"}},
+			in: &pa.StaticRoute{
+				Name: testStaticRouteName,
+				Spec: &pa.StaticRouteSpec{NextHop: newNextHop},
+			},
+			out:     nil,
+			errCode: codes.Unknown,
+			errMsg:  "Failed to call RouteAdd",
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(testVrfLink, nil).Times(3)
+				mockNetlink.EXPECT().RouteDel(mock.Anything, testKernelRoute).Return(nil).Once()
+				mockNetlink.EXPECT().RouteAdd(mock.Anything, &newKernelRoute).Return(errors.New(errMsg)).Once()
+				// the old route is added again
+				mockNetlink.EXPECT().RouteAdd(mock.Anything, testKernelRoute).Return(nil).Once()
+			},
+		},
+		"unchanged route": {
+			mask: &fieldmaskpb.FieldMask{Paths: []string{"spec.metric"}},
+			in: &pa.StaticRoute{
+				Name: testStaticRouteName,
+				Spec: &pa.StaticRouteSpec{Metric: 10},
+			},
+			out:     &testStaticRouteWithStatus,
+			errCode: codes.OK,
+			errMsg:  "",
+			on:      nil,
+		},
+		"successful call": {
+			mask: &fieldmaskpb.FieldMask{Paths: []string{"spec.next_hop"}},
+			in: &pa.StaticRoute{
+				Name: testStaticRouteName,
+				Spec: &pa.StaticRouteSpec{NextHop: newNextHop},
+			},
+			out:     updated,
+			errCode: codes.OK,
+			errMsg:  "",
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(testVrfLink, nil).Twice()
+				mockNetlink.EXPECT().RouteDel(mock.Anything, testKernelRoute).Return(nil).Once()
+				mockNetlink.EXPECT().RouteAdd(mock.Anything, &newKernelRoute).Return(nil).Once()
+			},
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pa.NewStaticRouteServiceClient(env.conn)
+
+			_ = env.opi.store.Set(testStaticRouteName, &testStaticRouteWithStatus)
+			_ = env.opi.store.Set(testVrfName, &testVrf)
+			if tt.on != nil {
+				tt.on(env.mockNetlink, tt.errMsg)
+			}
+
+			request := &pa.UpdateStaticRouteRequest{StaticRoute: tt.in, UpdateMask: tt.mask}
+			response, err := client.UpdateStaticRoute(ctx, request)
+			if !proto.Equal(tt.out, response) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+		})
+	}
+}
+
+func Test_GetStaticRoute(t *testing.T) {
+	tests := map[string]struct {
+		in      string
+		out     *pa.StaticRoute
+		errCode codes.Code
+		errMsg  string
+	}{
+		"valid request": {
+			in:      testStaticRouteName,
+			out:     &testStaticRouteWithStatus,
+			errCode: codes.OK,
+			errMsg:  "",
+		},
+		"valid request with unknown key": {
+			in:      "unknown-id",
+			out:     nil,
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %v", "unknown-id"),
+		},
+		"malformed name": {
+			in:      "-ABC-DEF",
+			out:     nil,
+			errCode: codes.Unknown,
+			errMsg:  fmt.Sprintf("segment '%s': not a valid DNS name", "-ABC-DEF"),
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pa.NewStaticRouteServiceClient(env.conn)
+
+			_ = env.opi.store.Set(testStaticRouteName, &testStaticRouteWithStatus)
+
+			request := &pa.GetStaticRouteRequest{Name: tt.in}
+			response, err := client.GetStaticRoute(ctx, request)
+			if !proto.Equal(tt.out, response) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+		})
+	}
+}
+
+func Test_ListStaticRoutes(t *testing.T) {
+	tests := map[string]struct {
+		out     []*pa.StaticRoute
+		errCode codes.Code
+		errMsg  string
+		size    int32
+		token   string
+	}{
+		"example test": {
+			out:     []*pa.StaticRoute{&testStaticRouteWithStatus},
+			errCode: codes.OK,
+			errMsg:  "",
+			size:    0,
+			token:   "",
+		},
+		"pagination negative": {
+			out:     nil,
+			errCode: codes.InvalidArgument,
+			errMsg:  "negative PageSize is not allowed",
+			size:    -10,
+			token:   "",
+		},
+		"pagination error": {
+			out:     nil,
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find pagination token %s", "unknown-pagination-token"),
+			size:    0,
+			token:   "unknown-pagination-token",
+		},
+		"pagination offset": {
+			out:     []*pa.StaticRoute{},
+			errCode: codes.OK,
+			errMsg:  "",
+			size:    1,
+			token:   "existing-pagination-token",
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pa.NewStaticRouteServiceClient(env.conn)
+
+			_ = env.opi.store.Set(testStaticRouteName, &testStaticRouteWithStatus)
+			env.opi.ListHelper[testStaticRouteName] = false
+			env.opi.Pagination["existing-pagination-token"] = 1
+
+			request := &pa.ListStaticRoutesRequest{PageSize: tt.size, PageToken: tt.token}
+			response, err := client.ListStaticRoutes(ctx, request)
+			if !utils.EqualProtoSlices(response.GetStaticRoutes(), tt.out) {
+				t.Error("response: expected", tt.out, "received", response.GetStaticRoutes())
+			}
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package route is the main package of the application
+package route
+
+import (
+	"log"
+	"sync"
+
+	"github.com/philippgille/gokv"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+)
+
+// Server represents the Server object
+type Server struct {
+	pa.UnimplementedStaticRouteServiceServer
+	Pagination map[string]int
+	ListHelper map[string]bool
+	nLink      utils.Netlink
+	store      gokv.Store
+	dryRun     bool
+	mu         sync.Mutex
+}
+
+// NewServer creates initialized instance of EVPN server
+func NewServer(store gokv.Store) *Server {
+	nLink := utils.NewNetlinkWrapper()
+	return NewServerWithArgs(nLink, store)
+}
+
+// NewServerWithArgs creates initialized instance of EVPN server
+// with externally created Netlink
+func NewServerWithArgs(nLink utils.Netlink, store gokv.Store) *Server {
+	if nLink == nil {
+		log.Panic("nil for Netlink is not allowed")
+	}
+	if store == nil {
+		log.Panic("nil for Store is not allowed")
+	}
+	return &Server{
+		ListHelper: make(map[string]bool),
+		Pagination: make(map[string]int),
+		nLink:      nLink,
+		store:      store,
+	}
+}
+
+// dryRunServer returns a copy of the server, which records netlink
+// operations instead of executing them and does not write to the store
+func (s *Server) dryRunServer() (*Server, *utils.DryRun) {
+	recorder := utils.NewDryRun(s.nLink, s.store)
+	return &Server{
+		ListHelper: make(map[string]bool),
+		Pagination: make(map[string]int),
+		nLink:      recorder,
+		store:      recorder.Store(),
+		dryRun:     true,
+	}, recorder
+}
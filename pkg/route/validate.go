@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package route is the main package of the application
+package route
+
+import (
+	"net"
+
+	"go.einride.tech/aip/fieldbehavior"
+	"go.einride.tech/aip/fieldmask"
+	"go.einride.tech/aip/resourceid"
+	"go.einride.tech/aip/resourcename"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+	pc "github.com/opiproject/opi-api/network/opinetcommon/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+)
+
+// vrfAddressFamilies are the address families routed by the VRFs,
+// their BGP instances have the ipv4 unicast address family only
+var vrfAddressFamilies = []pc.IpAf{pc.IpAf_IP_AF_INET}
+
+// ValidateCreateStaticRouteRequest checks a create request without executing it
+func (s *Server) ValidateCreateStaticRouteRequest(in *pa.CreateStaticRouteRequest) error {
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		return err
+	}
+	// see https://google.aip.dev/133#user-specified-ids
+	if in.StaticRouteId != "" {
+		if err := resourceid.ValidateUserSettable(in.StaticRouteId); err != nil {
+			return err
+		}
+	}
+	return validateStaticRouteSpec(in.StaticRoute.Spec)
+}
+
+func (s *Server) validateDeleteStaticRouteRequest(in *pa.DeleteStaticRouteRequest) error {
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		return err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	return resourcename.Validate(in.Name)
+}
+
+func (s *Server) validateUpdateStaticRouteRequest(in *pa.UpdateStaticRouteRequest) error {
+	// check required fields, the route is validated after merging the fields to set
+	if in.StaticRoute == nil {
+		return status.Error(codes.InvalidArgument, "missing required field: static_route")
+	}
+	// update_mask = 2
+	if err := fieldmask.Validate(in.UpdateMask, in.StaticRoute); err != nil {
+		return err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	return resourcename.Validate(in.StaticRoute.Name)
+}
+
+func (s *Server) validateGetStaticRouteRequest(in *pa.GetStaticRouteRequest) error {
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		return err
+	}
+	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+	return resourcename.Validate(in.Name)
+}
+
+// validateStaticRouteSpec checks the VRF name and the addresses of a route
+func validateStaticRouteSpec(spec *pa.StaticRouteSpec) error {
+	// Validate that a Vrf resource name conforms to the restrictions outlined in AIP-122.
+	if err := resourcename.Validate(spec.Vrf); err != nil {
+		return err
+	}
+	prefix := ipOf(spec.Prefix.GetAddr())
+	if prefix == nil {
+		return status.Errorf(codes.InvalidArgument, "prefix address does not match address family %v", spec.Prefix.GetAddr().GetAf())
+	}
+	bits := len(prefix) * 8
+	if spec.Prefix.Len < 0 || int(spec.Prefix.Len) > bits {
+		return status.Errorf(codes.InvalidArgument, "prefix length %d is out of range 0 to %d", spec.Prefix.Len, bits)
+	}
+	if !prefix.Equal(prefix.Mask(net.CIDRMask(int(spec.Prefix.Len), bits))) {
+		return status.Errorf(codes.InvalidArgument, "prefix %v has host bits set", ipNetOf(spec.Prefix))
+	}
+	if spec.NextHop.GetAf() != spec.Prefix.Addr.Af {
+		return status.Errorf(codes.InvalidArgument, "next hop is not of address family %v of the prefix", spec.Prefix.Addr.Af)
+	}
+	nextHop := ipOf(spec.NextHop)
+	if nextHop == nil || nextHop.IsUnspecified() {
+		return status.Error(codes.InvalidArgument, "next hop has to be a valid address")
+	}
+	return nil
+}
+
+// checkAddressFamily rejects routes of address families the VRF does not route
+func checkAddressFamily(vrf *pb.Vrf, spec *pa.StaticRouteSpec) error {
+	for _, af := range vrfAddressFamilies {
+		if af == spec.Prefix.Addr.Af {
+			return nil
+		}
+	}
+	return status.Errorf(codes.FailedPrecondition, "VRF %s does not route address family %v", vrf.Name, spec.Prefix.Addr.Af)
+}
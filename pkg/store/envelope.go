@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package store creates the persistence backend selected in configuration
+package store
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// envelopeMagic starts every enveloped record. Field number zero is invalid
+// in protobuf, so a legacy record holding a bare message never starts with it.
+var envelopeMagic = []byte{0x00, 'o', 'p', 'i'}
+
+// envelope is a versioned wrapper around a stored protobuf message, encoded
+// after envelopeMagic with protobuf wire format as:
+//
+//	message Envelope {
+//	  uint32 version = 1;
+//	  string type = 2;
+//	  bytes payload = 3;
+//	}
+type envelope struct {
+	Version uint32
+	Type    string
+	Payload []byte
+}
+
+func (e *envelope) marshal() []byte {
+	b := append([]byte{}, envelopeMagic...)
+	b = protowire.AppendTag(b, 1, protowire.VarintType)
+	b = protowire.AppendVarint(b, uint64(e.Version))
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	b = protowire.AppendString(b, e.Type)
+	b = protowire.AppendTag(b, 3, protowire.BytesType)
+	b = protowire.AppendBytes(b, e.Payload)
+	return b
+}
+
+// unmarshalEnvelope decodes a stored record, records written before
+// envelopes were introduced are returned as version 0 with empty type
+func unmarshalEnvelope(data []byte) (*envelope, error) {
+	if !bytes.HasPrefix(data, envelopeMagic) {
+		return &envelope{Version: 0, Payload: data}, nil
+	}
+	e := &envelope{}
+	b := data[len(envelopeMagic):]
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, fmt.Errorf("malformed envelope: %w", protowire.ParseError(n))
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				return nil, fmt.Errorf("malformed envelope version: %w", protowire.ParseError(n))
+			}
+			e.Version = uint32(v)
+			b = b[n:]
+		case num == 2 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeString(b)
+			if n < 0 {
+				return nil, fmt.Errorf("malformed envelope type: %w", protowire.ParseError(n))
+			}
+			e.Type = v
+			b = b[n:]
+		case num == 3 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return nil, fmt.Errorf("malformed envelope payload: %w", protowire.ParseError(n))
+			}
+			e.Payload = append([]byte{}, v...)
+			b = b[n:]
+		default:
+			// skip unknown fields, so newer envelopes stay readable
+			n := protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return nil, fmt.Errorf("malformed envelope field %d: %w", num, protowire.ParseError(n))
+			}
+			b = b[n:]
+		}
+	}
+	if e.Version == 0 {
+		return nil, errors.New("malformed envelope: missing version")
+	}
+	return e, nil
+}
+
+// rawCodec passes already encoded envelopes through to the backend unchanged
+type rawCodec struct{}
+
+// Marshal returns the bytes as is
+func (c rawCodec) Marshal(v interface{}) ([]byte, error) {
+	data, ok := v.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("raw codec expects []byte and not %T", v)
+	}
+	return data, nil
+}
+
+// Unmarshal copies the bytes into the given *[]byte
+func (c rawCodec) Unmarshal(data []byte, v interface{}) error {
+	out, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("raw codec expects *[]byte and not %T", v)
+	}
+	*out = append([]byte{}, data...)
+	return nil
+}
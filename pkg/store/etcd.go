@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package store creates the persistence backend selected in configuration
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/philippgille/gokv"
+	"github.com/philippgille/gokv/encoding"
+	"github.com/philippgille/gokv/util"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// defaultEtcdTimeout bounds every single etcd request
+const defaultEtcdTimeout = 2 * time.Second
+
+// EtcdOptions are the options for the etcd store
+type EtcdOptions struct {
+	Endpoints []string
+	Timeout   time.Duration
+	Codec     encoding.Codec
+}
+
+// EtcdClient is a gokv.Store implementation for etcd v3
+type EtcdClient struct {
+	c       *clientv3.Client
+	timeout time.Duration
+	codec   encoding.Codec
+}
+
+// build time check that struct implements interface
+var _ gokv.Store = (*EtcdClient)(nil)
+
+// NewEtcdClient creates a new etcd client and checks the connection
+func NewEtcdClient(options EtcdOptions) (*EtcdClient, error) {
+	if len(options.Endpoints) == 0 || options.Endpoints[0] == "" {
+		return nil, errors.New("no etcd endpoints provided")
+	}
+	if options.Timeout == 0 {
+		options.Timeout = defaultEtcdTimeout
+	}
+	if options.Codec == nil {
+		options.Codec = encoding.JSON
+	}
+	c, err := clientv3.New(clientv3.Config{Endpoints: options.Endpoints, DialTimeout: options.Timeout})
+	if err != nil {
+		return nil, err
+	}
+	// clientv3.New does not block, so check the connection explicitly
+	ctx, cancel := context.WithTimeout(context.Background(), options.Timeout)
+	defer cancel()
+	if _, err := c.Status(ctx, options.Endpoints[0]); err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+	return &EtcdClient{c: c, timeout: options.Timeout, codec: options.Codec}, nil
+}
+
+// Set stores the given value for the given key
+func (c *EtcdClient) Set(k string, v interface{}) error {
+	if err := util.CheckKeyAndValue(k, v); err != nil {
+		return err
+	}
+	data, err := c.codec.Marshal(v)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	_, err = c.c.Put(ctx, k, string(data))
+	return err
+}
+
+// Get retrieves the stored value for the given key, returns false if not found
+func (c *EtcdClient) Get(k string, v interface{}) (bool, error) {
+	if err := util.CheckKeyAndValue(k, v); err != nil {
+		return false, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	resp, err := c.c.Get(ctx, k)
+	if err != nil {
+		return false, err
+	}
+	if len(resp.Kvs) == 0 {
+		return false, nil
+	}
+	return true, c.codec.Unmarshal(resp.Kvs[0].Value, v)
+}
+
+// Delete deletes the stored value for the given key, missing key is not an error
+func (c *EtcdClient) Delete(k string) error {
+	if err := util.CheckKey(k); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	_, err := c.c.Delete(ctx, k)
+	return err
+}
+
+// Close closes the client
+func (c *EtcdClient) Close() error {
+	return c.c.Close()
+}
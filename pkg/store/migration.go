@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package store creates the persistence backend selected in configuration
+package store
+
+import (
+	"fmt"
+)
+
+// SchemaVersion is the version every record is upgraded to before use
+const SchemaVersion = uint32(len(migrations))
+
+// Migration upgrades the payload of a record from version From to From+1.
+// It receives the full name of the protobuf message stored in the record.
+type Migration struct {
+	From        uint32
+	Description string
+	Migrate     func(typeName string, payload []byte) ([]byte, error)
+}
+
+// migrations are applied in order, the index of each entry is its From version.
+// Append new migrations at the end and never modify released ones.
+var migrations = [...]Migration{
+	{
+		From:        0,
+		Description: "wrap bare protobuf messages into versioned envelope",
+		Migrate: func(_ string, payload []byte) ([]byte, error) {
+			return payload, nil
+		},
+	},
+}
+
+// migrate upgrades the envelope in place up to SchemaVersion
+func migrate(e *envelope) error {
+	if e.Version > SchemaVersion {
+		return fmt.Errorf("record schema version %d is newer than supported version %d", e.Version, SchemaVersion)
+	}
+	for e.Version < SchemaVersion {
+		m := migrations[e.Version]
+		payload, err := m.Migrate(e.Type, e.Payload)
+		if err != nil {
+			return fmt.Errorf("migration from version %d (%s) failed: %w", m.From, m.Description, err)
+		}
+		e.Payload = payload
+		e.Version++
+	}
+	return nil
+}
@@ -0,0 +1,322 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package store creates the persistence backend selected in configuration
+package store
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/philippgille/gokv/gomap"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+)
+
+// fixtures are records recorded at the given schema version, next to the
+// objects they are expected to decode to at the current schema version
+var fixtures = map[string]struct {
+	key     string
+	version uint32
+	record  string
+	want    string
+	newObj  func() proto.Message
+}{
+	"v0 vrf": {
+		key:     "//network.opiproject.org/vrfs/blue",
+		version: 0,
+		record:  "v0_vrf.pb",
+		want:    "v0_vrf.json",
+		newObj:  func() proto.Message { return new(pb.Vrf) },
+	},
+	"v0 logical bridge": {
+		key:     "//network.opiproject.org/bridges/red",
+		version: 0,
+		record:  "v0_bridge.pb",
+		want:    "v0_bridge.json",
+		newObj:  func() proto.Message { return new(pb.LogicalBridge) },
+	},
+	"v0 bridge port": {
+		key:     "//network.opiproject.org/ports/eth2",
+		version: 0,
+		record:  "v0_port.pb",
+		want:    "v0_port.json",
+		newObj:  func() proto.Message { return new(pb.BridgePort) },
+	},
+	"v0 svi": {
+		key:     "//network.opiproject.org/svis/red",
+		version: 0,
+		record:  "v0_svi.pb",
+		want:    "v0_svi.json",
+		newObj:  func() proto.Message { return new(pb.Svi) },
+	},
+}
+
+func readFixture(t *testing.T, name string) []byte {
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal("Failed to read fixture", err)
+	}
+	return data
+}
+
+func TestStore_MigrateOnRead(t *testing.T) {
+	for name, tt := range fixtures {
+		t.Run(name, func(t *testing.T) {
+			backend := gomap.NewStore(gomap.Options{Codec: rawCodec{}})
+			if err := backend.Set(tt.key, readFixture(t, tt.record)); err != nil {
+				t.Fatal(err)
+			}
+			store, err := NewVersionedStore(backend)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := tt.newObj()
+			if err := protojson.Unmarshal(readFixture(t, tt.want), want); err != nil {
+				t.Fatal(err)
+			}
+			got := tt.newObj()
+			found, err := store.Get(tt.key, got)
+			if err != nil || !found {
+				t.Fatal("Expect found, received", found, err)
+			}
+			if !proto.Equal(want, got) {
+				t.Error("Expect", want, "received", got)
+			}
+
+			// record is written back at current version and indexed
+			var data []byte
+			if _, err := backend.Get(tt.key, &data); err != nil {
+				t.Fatal(err)
+			}
+			e, err := unmarshalEnvelope(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if e.Version != SchemaVersion {
+				t.Error("Expect version", SchemaVersion, "received", e.Version)
+			}
+			if e.Type != string(want.ProtoReflect().Descriptor().FullName()) {
+				t.Error("Expect type", want.ProtoReflect().Descriptor().FullName(), "received", e.Type)
+			}
+			if !reflect.DeepEqual(store.Keys(), []string{tt.key}) {
+				t.Error("Expect indexed key", tt.key, "received", store.Keys())
+			}
+		})
+	}
+}
+
+func TestStore_MigrateAll(t *testing.T) {
+	foreign := map[string][]byte{
+		"other-app/config":                 []byte("some value"),
+		"//network.opiproject.org/garbage": {0xff, 0xff},
+	}
+	// records written before the key index existed, the store has no index
+	path := filepath.Join(t.TempDir(), "test.db")
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucket([]byte(bucketName))
+		if err != nil {
+			return err
+		}
+		for _, tt := range fixtures {
+			e := &envelope{Version: tt.version, Payload: readFixture(t, tt.record)}
+			record := e.Payload
+			if tt.version > 0 {
+				record = e.marshal()
+			}
+			if err := b.Put([]byte(tt.key), record); err != nil {
+				return err
+			}
+		}
+		// keys of other applications are neither indexed nor migrated
+		for k, v := range foreign {
+			if err := b.Put([]byte(k), v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := NewStore(config.DatabaseConfig{Type: config.BoltStore, Path: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(store.Keys()) != len(fixtures) {
+		t.Error("Expect indexed", len(fixtures), "received", store.Keys())
+	}
+	upgraded, err := store.Migrate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if upgraded != len(fixtures) {
+		t.Error("Expect upgraded", len(fixtures), "received", upgraded)
+	}
+	// second run finds nothing to do
+	upgraded, err = store.Migrate()
+	if err != nil || upgraded != 0 {
+		t.Error("Expect nothing to upgrade, received", upgraded, err)
+	}
+	for name, tt := range fixtures {
+		want := tt.newObj()
+		if err := protojson.Unmarshal(readFixture(t, tt.want), want); err != nil {
+			t.Fatal(err)
+		}
+		got := tt.newObj()
+		if _, err := store.Get(tt.key, got); err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(want, got) {
+			t.Error(name, "expect", want, "received", got)
+		}
+	}
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+	db, err = bolt.Open(path, 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(bucketName))
+		for k, v := range foreign {
+			if got := b.Get([]byte(k)); !reflect.DeepEqual(got, v) {
+				t.Error("Expect", k, "untouched", v, "received", got)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the index built on the first start is kept
+	store, err = NewStore(config.DatabaseConfig{Type: config.BoltStore, Path: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = store.Close() }()
+	if len(store.Keys()) != len(fixtures) {
+		t.Error("Expect indexed", len(fixtures), "received", store.Keys())
+	}
+}
+
+func TestStore_Envelope(t *testing.T) {
+	tests := map[string]struct {
+		data      []byte
+		expectErr bool
+		want      *envelope
+	}{
+		"legacy record": {
+			data: []byte{0x0a, 0x01, 'a'},
+			want: &envelope{Version: 0, Payload: []byte{0x0a, 0x01, 'a'}},
+		},
+		"empty legacy record": {
+			data: []byte{},
+			want: &envelope{Version: 0, Payload: []byte{}},
+		},
+		"round trip": {
+			data: (&envelope{Version: 3, Type: "a.B", Payload: []byte{1, 2}}).marshal(),
+			want: &envelope{Version: 3, Type: "a.B", Payload: []byte{1, 2}},
+		},
+		"unknown field is skipped": {
+			data: append((&envelope{Version: 1, Type: "a.B"}).marshal(), 0x20, 0x05),
+			want: &envelope{Version: 1, Type: "a.B", Payload: []byte{}},
+		},
+		"missing version": {
+			data:      append([]byte{}, envelopeMagic...),
+			expectErr: true,
+		},
+		"truncated": {
+			data:      (&envelope{Version: 1, Type: "a.B", Payload: []byte{1, 2}}).marshal()[:10],
+			expectErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := unmarshalEnvelope(tt.data)
+			if (err != nil) != tt.expectErr {
+				t.Fatal("Expect error", tt.expectErr, "received", err)
+			}
+			if !tt.expectErr && !reflect.DeepEqual(got, tt.want) {
+				t.Error("Expect", tt.want, "received", got)
+			}
+		})
+	}
+}
+
+func TestStore_VersionedErrors(t *testing.T) {
+	key := "//network.opiproject.org/vrfs/blue"
+	tests := map[string][]byte{
+		"newer schema version": (&envelope{Version: SchemaVersion + 1, Type: "opi_api.network.evpn_gw.v1alpha1.Vrf"}).marshal(),
+		"different type":       (&envelope{Version: SchemaVersion, Type: "opi_api.network.evpn_gw.v1alpha1.Svi"}).marshal(),
+	}
+	for name, record := range tests {
+		t.Run(name, func(t *testing.T) {
+			backend := gomap.NewStore(gomap.Options{Codec: rawCodec{}})
+			if err := backend.Set(key, record); err != nil {
+				t.Fatal(err)
+			}
+			store, err := NewVersionedStore(backend)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := store.Get(key, new(pb.Vrf)); err == nil {
+				t.Error("Expect error, received nil")
+			}
+		})
+	}
+}
+
+func TestStore_IndexSurvivesReopen(t *testing.T) {
+	cfg := config.DatabaseConfig{Type: config.BoltStore, Path: filepath.Join(t.TempDir(), "test.db")}
+	store, err := NewStore(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, k := range []string{"//network.opiproject.org/vrfs/b", "//network.opiproject.org/vrfs/a", "//network.opiproject.org/vrfs/c"} {
+		if err := store.Set(k, &pb.Vrf{Name: k}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := store.Delete("//network.opiproject.org/vrfs/c"); err != nil {
+		t.Fatal(err)
+	}
+	// records of other keys are not indexed
+	if err := store.Set("opi-evpn-bridge/audit/0", &pb.Vrf{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err = NewStore(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = store.Close() }()
+	want := []string{"//network.opiproject.org/vrfs/a", "//network.opiproject.org/vrfs/b"}
+	if !reflect.DeepEqual(store.Keys(), want) {
+		t.Error("Expect", want, "received", store.Keys())
+	}
+}
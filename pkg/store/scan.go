@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package store creates the persistence backend selected in configuration
+package store
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+	"github.com/philippgille/gokv/bbolt"
+	"github.com/philippgille/gokv/redis"
+	bolt "go.etcd.io/bbolt"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// resourcePrefix starts the keys of all resources, the other keys of a
+// database shared with other applications are not scanned
+const resourcePrefix = "//network.opiproject.org/"
+
+// keyScanner is implemented by backends which can enumerate their keys,
+// it builds the key index of records written before the index existed
+type keyScanner interface {
+	ScanKeys() ([]string, error)
+}
+
+// ScanKeys returns the resource keys in etcd
+func (c *EtcdClient) ScanKeys() ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	resp, err := c.c.Get(ctx, resourcePrefix, clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		keys = append(keys, string(kv.Key))
+	}
+	return keys, nil
+}
+
+// redisStore is the gokv redis client with a second client to scan keys,
+// since gokv hides its own
+type redisStore struct {
+	redis.Client
+	scan *goredis.Client
+}
+
+func newRedisStore(options redis.Options) (*redisStore, error) {
+	client, err := redis.NewClient(options)
+	if err != nil {
+		return nil, err
+	}
+	scan := goredis.NewClient(&goredis.Options{
+		Addr:     options.Address,
+		Password: options.Password,
+		DB:       options.DB,
+	})
+	return &redisStore{Client: client, scan: scan}, nil
+}
+
+// ScanKeys returns the resource keys in the redis database
+func (s *redisStore) ScanKeys() ([]string, error) {
+	var keys []string
+	var cursor uint64
+	for {
+		page, next, err := s.scan.Scan(cursor, resourcePrefix+"*", 0).Result()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, page...)
+		if next == 0 {
+			return keys, nil
+		}
+		cursor = next
+	}
+}
+
+// Close closes both redis clients
+func (s *redisStore) Close() error {
+	err := s.Client.Close()
+	if scanErr := s.scan.Close(); err == nil {
+		err = scanErr
+	}
+	return err
+}
+
+// boltStore is the gokv bbolt store with the keys found in the file before
+// gokv opened it, bbolt locks the file and gokv hides its handle
+type boltStore struct {
+	bbolt.Store
+	keys []string
+}
+
+// boltOpenTimeout bounds the wait for the file lock of the key scan
+const boltOpenTimeout = time.Second
+
+func newBoltStore(options bbolt.Options) (*boltStore, error) {
+	keys, err := scanBolt(options.Path, options.BucketName)
+	if err != nil {
+		return nil, err
+	}
+	store, err := bbolt.NewStore(options)
+	if err != nil {
+		return nil, err
+	}
+	return &boltStore{Store: store, keys: keys}, nil
+}
+
+// scanBolt returns the resource keys of the bucket, none when the file does not exist yet
+func scanBolt(path, bucket string) ([]string, error) {
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: boltOpenTimeout, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = db.Close() }()
+	var keys []string
+	err = db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b == nil {
+			return nil
+		}
+		c := b.Cursor()
+		prefix := []byte(resourcePrefix)
+		for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+			keys = append(keys, string(k))
+		}
+		return nil
+	})
+	return keys, err
+}
+
+// ScanKeys returns the keys found when the store was opened
+func (s *boltStore) ScanKeys() ([]string, error) {
+	return s.keys, nil
+}
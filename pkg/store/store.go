@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package store creates the persistence backend selected in configuration
+package store
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/philippgille/gokv"
+	"github.com/philippgille/gokv/bbolt"
+	"github.com/philippgille/gokv/gomap"
+	"github.com/philippgille/gokv/redis"
+
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+)
+
+// bucketName is the bbolt bucket holding all objects
+const bucketName = "opi-evpn-bridge"
+
+// NewStore creates versioned KV store for persistence on top of the configured backend
+func NewStore(cfg config.DatabaseConfig) (*VersionedStore, error) {
+	backend, err := newBackend(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := NewVersionedStore(backend)
+	if err != nil {
+		_ = backend.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func newBackend(cfg config.DatabaseConfig) (gokv.Store, error) {
+	switch cfg.Type {
+	case config.MemoryStore:
+		return gomap.NewStore(gomap.Options{Codec: rawCodec{}}), nil
+	case config.RedisStore:
+		options := redis.DefaultOptions
+		options.Address = cfg.Address
+		options.Codec = rawCodec{}
+		return newRedisStore(options)
+	case config.BoltStore:
+		options := bbolt.Options{BucketName: bucketName, Path: cfg.Path, Codec: rawCodec{}}
+		return newBoltStore(options)
+	case config.EtcdStore:
+		return NewEtcdClient(EtcdOptions{
+			Endpoints: strings.Split(cfg.Address, ","),
+			Timeout:   defaultEtcdTimeout,
+			Codec:     rawCodec{},
+		})
+	default:
+		return nil, fmt.Errorf("unsupported store type %q", cfg.Type)
+	}
+}
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package store creates the persistence backend selected in configuration
+package store
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/philippgille/gokv"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+)
+
+// newTestStores returns every backend that can run in this environment,
+// redis and etcd are only tested when their address is provided
+func newTestStores(t *testing.T) map[string]config.DatabaseConfig {
+	stores := map[string]config.DatabaseConfig{
+		config.MemoryStore: {Type: config.MemoryStore},
+		config.BoltStore:   {Type: config.BoltStore, Path: filepath.Join(t.TempDir(), "test.db")},
+	}
+	if addr := os.Getenv("OPI_EVPN_TEST_REDIS_ADDR"); addr != "" {
+		stores[config.RedisStore] = config.DatabaseConfig{Type: config.RedisStore, Address: addr}
+	}
+	if addr := os.Getenv("OPI_EVPN_TEST_ETCD_ADDR"); addr != "" {
+		stores[config.EtcdStore] = config.DatabaseConfig{Type: config.EtcdStore, Address: addr}
+	}
+	return stores
+}
+
+func TestStore_Conformance(t *testing.T) {
+	tests := map[string]func(t *testing.T, store gokv.Store){
+		"get missing key": func(t *testing.T, store gokv.Store) {
+			obj := new(pb.Vrf)
+			found, err := store.Get("//network.opiproject.org/vrfs/missing", obj)
+			if err != nil || found {
+				t.Error("Expect not found, received", found, err)
+			}
+		},
+		"set and get": func(t *testing.T, store gokv.Store) {
+			key := "//network.opiproject.org/vrfs/blue"
+			in := &pb.Vrf{Name: key, Spec: &pb.VrfSpec{Vni: proto.Uint32(1000)}}
+			if err := store.Set(key, in); err != nil {
+				t.Fatal("Set failed", err)
+			}
+			out := new(pb.Vrf)
+			found, err := store.Get(key, out)
+			if err != nil || !found {
+				t.Fatal("Expect found, received", found, err)
+			}
+			if !proto.Equal(in, out) {
+				t.Error("Expect", in, "received", out)
+			}
+		},
+		"overwrite": func(t *testing.T, store gokv.Store) {
+			key := "//network.opiproject.org/bridges/red"
+			if err := store.Set(key, &pb.LogicalBridge{Name: key, Spec: &pb.LogicalBridgeSpec{VlanId: 10}}); err != nil {
+				t.Fatal("Set failed", err)
+			}
+			in := &pb.LogicalBridge{Name: key, Spec: &pb.LogicalBridgeSpec{VlanId: 20}}
+			if err := store.Set(key, in); err != nil {
+				t.Fatal("Set failed", err)
+			}
+			out := new(pb.LogicalBridge)
+			if _, err := store.Get(key, out); err != nil {
+				t.Fatal("Get failed", err)
+			}
+			if !proto.Equal(in, out) {
+				t.Error("Expect", in, "received", out)
+			}
+		},
+		"delete": func(t *testing.T, store gokv.Store) {
+			key := "//network.opiproject.org/svis/green"
+			if err := store.Set(key, &pb.Svi{Name: key}); err != nil {
+				t.Fatal("Set failed", err)
+			}
+			if err := store.Delete(key); err != nil {
+				t.Fatal("Delete failed", err)
+			}
+			found, err := store.Get(key, new(pb.Svi))
+			if err != nil || found {
+				t.Error("Expect not found after delete, received", found, err)
+			}
+		},
+		"delete missing key": func(t *testing.T, store gokv.Store) {
+			if err := store.Delete("//network.opiproject.org/ports/missing"); err != nil {
+				t.Error("Expect no error, received", err)
+			}
+		},
+		"empty key": func(t *testing.T, store gokv.Store) {
+			if err := store.Set("", &pb.Vrf{}); err == nil {
+				t.Error("Expect error on Set with empty key")
+			}
+			if _, err := store.Get("", new(pb.Vrf)); err == nil {
+				t.Error("Expect error on Get with empty key")
+			}
+			if err := store.Delete(""); err == nil {
+				t.Error("Expect error on Delete with empty key")
+			}
+		},
+	}
+	for storeType, cfg := range newTestStores(t) {
+		t.Run(storeType, func(t *testing.T) {
+			store, err := NewStore(cfg)
+			if err != nil {
+				t.Fatal("NewStore failed", err)
+			}
+			defer func() {
+				if err := store.Close(); err != nil {
+					t.Error("Close failed", err)
+				}
+			}()
+			for name, tt := range tests {
+				t.Run(name, func(t *testing.T) {
+					tt(t, store)
+				})
+			}
+		})
+	}
+}
+
+func TestStore_NewStore(t *testing.T) {
+	tests := map[string]struct {
+		cfg       config.DatabaseConfig
+		expectErr bool
+	}{
+		"unknown type": {
+			cfg:       config.DatabaseConfig{Type: "mysql"},
+			expectErr: true,
+		},
+		"bolt in missing directory": {
+			cfg:       config.DatabaseConfig{Type: config.BoltStore, Path: filepath.Join(t.TempDir(), "missing", "test.db")},
+			expectErr: true,
+		},
+		"etcd without endpoints": {
+			cfg:       config.DatabaseConfig{Type: config.EtcdStore},
+			expectErr: true,
+		},
+		"memory": {
+			cfg:       config.DatabaseConfig{Type: config.MemoryStore},
+			expectErr: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			store, err := NewStore(tt.cfg)
+			if (err != nil) != tt.expectErr {
+				t.Error("Expect error", tt.expectErr, "received", err)
+			}
+			if err == nil {
+				_ = store.Close()
+			}
+		})
+	}
+}
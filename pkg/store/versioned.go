@@ -0,0 +1,291 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package store creates the persistence backend selected in configuration
+package store
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/philippgille/gokv"
+	"github.com/philippgille/gokv/util"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// indexKey holds the list of all keys, since gokv has no way to enumerate them
+const indexKey = "opi-evpn-bridge/index"
+
+// indexType is the envelope type of the key index record
+const indexType = "opi-evpn-bridge.Index"
+
+// VersionedStore is a gokv.Store for protobuf messages that wraps every record
+// into a versioned envelope, upgrades older records and keeps an index of the
+// resource keys. Other records, like the audit entries, are upgraded on read.
+type VersionedStore struct {
+	backend gokv.Store
+	mu      sync.Mutex
+	keys    map[string]bool
+}
+
+// build time check that struct implements interface
+var _ gokv.Store = (*VersionedStore)(nil)
+
+// NewVersionedStore wraps backend, which must be created with rawCodec
+func NewVersionedStore(backend gokv.Store) (*VersionedStore, error) {
+	s := &VersionedStore{backend: backend, keys: make(map[string]bool)}
+	var data []byte
+	found, err := backend.Get(indexKey, &data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load key index: %w", err)
+	}
+	if found {
+		e, err := unmarshalEnvelope(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load key index: %w", err)
+		}
+		keys, err := unmarshalIndex(e.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load key index: %w", err)
+		}
+		for _, k := range keys {
+			s.keys[k] = true
+		}
+		return s, nil
+	}
+	if err := s.buildIndex(); err != nil {
+		return nil, fmt.Errorf("failed to build key index: %w", err)
+	}
+	return s, nil
+}
+
+// buildIndex indexes the records written before the index existed,
+// it needs a backend that can enumerate its keys
+func (s *VersionedStore) buildIndex() error {
+	scanner, ok := s.backend.(keyScanner)
+	if !ok {
+		return nil
+	}
+	keys, err := scanner.ScanKeys()
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if !strings.HasPrefix(k, resourcePrefix) {
+			continue
+		}
+		// leave values alone which are no records, like other types of redis
+		var data []byte
+		if _, err := s.backend.Get(k, &data); err != nil {
+			log.Printf("Skipping key %s: %v", k, err)
+			continue
+		}
+		if !isRecord(data) {
+			log.Printf("Skipping key %s, which holds no record", k)
+			continue
+		}
+		s.keys[k] = true
+	}
+	if len(s.keys) == 0 {
+		return nil
+	}
+	log.Printf("Indexed %d records written before the key index", len(s.keys))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.saveIndex()
+}
+
+// isRecord reports whether data is an envelope or a bare protobuf message
+func isRecord(data []byte) bool {
+	e, err := unmarshalEnvelope(data)
+	if err != nil {
+		return false
+	}
+	for b := e.Payload; len(b) > 0; {
+		_, _, n := protowire.ConsumeField(b)
+		if n < 0 {
+			return false
+		}
+		b = b[n:]
+	}
+	return true
+}
+
+// Set wraps the protobuf message into an envelope and stores it
+func (s *VersionedStore) Set(k string, v interface{}) error {
+	if err := util.CheckKeyAndValue(k, v); err != nil {
+		return err
+	}
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("value of type %T is not a protobuf message", v)
+	}
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	e := &envelope{Version: SchemaVersion, Type: string(msg.ProtoReflect().Descriptor().FullName()), Payload: payload}
+	return s.put(k, e)
+}
+
+// Get retrieves the record, upgrades it if needed and unmarshals it into the protobuf message
+func (s *VersionedStore) Get(k string, v interface{}) (bool, error) {
+	if err := util.CheckKeyAndValue(k, v); err != nil {
+		return false, err
+	}
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return false, fmt.Errorf("value of type %T is not a protobuf message", v)
+	}
+	var data []byte
+	found, err := s.backend.Get(k, &data)
+	if err != nil || !found {
+		return found, err
+	}
+	e, err := unmarshalEnvelope(data)
+	if err != nil {
+		return false, fmt.Errorf("key %s: %w", k, err)
+	}
+	typeName := string(msg.ProtoReflect().Descriptor().FullName())
+	if e.Type == "" {
+		e.Type = typeName
+	}
+	if e.Type != typeName {
+		return false, fmt.Errorf("key %s holds %s and not %s", k, e.Type, typeName)
+	}
+	if e.Version != SchemaVersion {
+		if err := s.upgrade(k, e); err != nil {
+			return false, err
+		}
+	}
+	return true, proto.Unmarshal(e.Payload, msg)
+}
+
+// Delete deletes the record and removes it from the index
+func (s *VersionedStore) Delete(k string) error {
+	if err := util.CheckKey(k); err != nil {
+		return err
+	}
+	if err := s.backend.Delete(k); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.keys[k] {
+		return nil
+	}
+	delete(s.keys, k)
+	return s.saveIndex()
+}
+
+// Close closes the backend
+func (s *VersionedStore) Close() error {
+	return s.backend.Close()
+}
+
+// Keys returns sorted list of all indexed resource keys
+func (s *VersionedStore) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]string, 0, len(s.keys))
+	for k := range s.keys {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Migrate upgrades all indexed records to SchemaVersion, records written
+// before the index existed are indexed when the store is opened
+func (s *VersionedStore) Migrate() (int, error) {
+	upgraded := 0
+	for _, k := range s.Keys() {
+		var data []byte
+		found, err := s.backend.Get(k, &data)
+		if err != nil {
+			return upgraded, err
+		}
+		if !found {
+			log.Printf("Indexed key %s is missing in store", k)
+			continue
+		}
+		e, err := unmarshalEnvelope(data)
+		if err != nil {
+			return upgraded, fmt.Errorf("key %s: %w", k, err)
+		}
+		if e.Version == SchemaVersion {
+			continue
+		}
+		if err := s.upgrade(k, e); err != nil {
+			return upgraded, err
+		}
+		upgraded++
+	}
+	return upgraded, nil
+}
+
+// upgrade migrates the envelope in place and writes it back
+func (s *VersionedStore) upgrade(k string, e *envelope) error {
+	from := e.Version
+	if err := migrate(e); err != nil {
+		return fmt.Errorf("key %s: %w", k, err)
+	}
+	log.Printf("Upgraded %s from schema version %d to %d", k, from, e.Version)
+	return s.put(k, e)
+}
+
+func (s *VersionedStore) put(k string, e *envelope) error {
+	if err := s.backend.Set(k, e.marshal()); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.keys[k] || !strings.HasPrefix(k, resourcePrefix) {
+		return nil
+	}
+	s.keys[k] = true
+	return s.saveIndex()
+}
+
+// saveIndex must be called with mu held
+func (s *VersionedStore) saveIndex() error {
+	keys := make([]string, 0, len(s.keys))
+	for k := range s.keys {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	e := &envelope{Version: SchemaVersion, Type: indexType, Payload: marshalIndex(keys)}
+	return s.backend.Set(indexKey, e.marshal())
+}
+
+// marshalIndex encodes keys as repeated string field 1
+func marshalIndex(keys []string) []byte {
+	var b []byte
+	for _, k := range keys {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+	}
+	return b
+}
+
+func unmarshalIndex(b []byte) ([]string, error) {
+	var keys []string
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 || num != 1 || typ != protowire.BytesType {
+			return nil, fmt.Errorf("malformed key index at field %d", num)
+		}
+		b = b[n:]
+		k, n := protowire.ConsumeString(b)
+		if n < 0 {
+			return nil, fmt.Errorf("malformed key index: %w", protowire.ParseError(n))
+		}
+		keys = append(keys, k)
+		b = b[n:]
+	}
+	return keys, nil
+}
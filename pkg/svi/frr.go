@@ -9,19 +9,21 @@ import (
 	"fmt"
 
 	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
 )
 
 func (s *Server) frrCreateSviRequest(ctx context.Context, in *pb.CreateSviRequest, vrfName, vlanName string) error {
 	if in.Svi.Spec.EnableBgp {
 		data, err := s.frr.FrrBgpCmd(ctx, fmt.Sprintf(
 			`configure terminal
-			router bgp 65000 vrf %[1]s
+			router bgp %[4]d vrf %[1]s
 			bgp disable-ebgp-connected-route-check" \
 			neighbor %[2]s peer-group" \
 			neighbor %[2]s remote-as %[3]d" \
 			neighbor %[2]s as-override" \
 			neighbor %[2]s soft-reconfiguration inbound" \
-			exit`, vrfName, vlanName, in.Svi.Spec.RemoteAs))
+			exit`, vrfName, vlanName, in.Svi.Spec.RemoteAs, config.GetConfig().Frr.LocalAS))
 		// TODO: see issue #233, add "neighbor update-source" and "bgp listen range" with in.Svi.Spec.GwIpPrefix
 		fmt.Printf("FrrBgpCmd: %v:%v", data, err)
 		if err != nil {
@@ -41,9 +43,9 @@ func (s *Server) frrDeleteSviRequest(ctx context.Context, obj *pb.Svi, vrfName,
 	if obj.Spec.EnableBgp {
 		data, err := s.frr.FrrBgpCmd(ctx, fmt.Sprintf(
 			`configure terminal
-			router bgp 65000 vrf %s
+			router bgp %d vrf %s
 			no neighbor %s peer-group
-			exit`, vrfName, vlanName))
+			exit`, config.GetConfig().Frr.LocalAS, vrfName, vlanName))
 		fmt.Printf("FrrBgpCmd: %v:%v", data, err)
 		if err != nil {
 			return err
@@ -28,9 +28,16 @@ import (
 // CreateSvi executes the creation of the VLAN
 func (s *Server) CreateSvi(ctx context.Context, in *pb.CreateSviRequest) (*pb.Svi, error) {
 	// check input correctness
-	if err := s.validateCreateSviRequest(in); err != nil {
+	if err := s.ValidateCreateSviRequest(in); err != nil {
 		return nil, err
 	}
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.CreateSvi(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
 	// see https://google.aip.dev/133#user-specified-ids
 	resourceID := resourceid.NewSystemGenerated()
 	if in.SviId != "" {
@@ -47,6 +54,7 @@ func (s *Server) CreateSvi(ctx context.Context, in *pb.CreateSviRequest) (*pb.Sv
 	}
 	if ok {
 		log.Printf("Already existing Svi with id %v", in.Svi.Name)
+		utils.SendEtag(ctx, obj)
 		return obj, nil
 	}
 	// now get LogicalBridge object to fetch VID field
@@ -92,6 +100,7 @@ func (s *Server) CreateSvi(ctx context.Context, in *pb.CreateSviRequest) (*pb.Sv
 	if err != nil {
 		return nil, err
 	}
+	utils.SendEtag(ctx, response)
 	return response, nil
 }
 
@@ -101,6 +110,16 @@ func (s *Server) DeleteSvi(ctx context.Context, in *pb.DeleteSviRequest) (*empty
 	if err := s.validateDeleteSviRequest(in); err != nil {
 		return nil, err
 	}
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.DeleteSvi(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
+	// serialize writers, so the etag check and the write are atomic
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// fetch object from the database
 	obj := new(pb.Svi)
 	ok, err := s.store.Get(in.Name, obj)
@@ -115,6 +134,10 @@ func (s *Server) DeleteSvi(ctx context.Context, in *pb.DeleteSviRequest) (*empty
 		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
 		return nil, err
 	}
+	// reject stale etag, see https://google.aip.dev/154
+	if err := utils.CheckEtag(ctx, obj); err != nil {
+		return nil, err
+	}
 	// fetch object from the database
 	bridgeObject := new(pb.LogicalBridge)
 	ok, err = s.store.Get(obj.Spec.LogicalBridge, bridgeObject)
@@ -163,6 +186,16 @@ func (s *Server) UpdateSvi(ctx context.Context, in *pb.UpdateSviRequest) (*pb.Sv
 	if err := s.validateUpdateSviRequest(in); err != nil {
 		return nil, err
 	}
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.UpdateSvi(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
+	// serialize writers, so the etag check and the write are atomic
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// fetch object from the database
 	svi := new(pb.Svi)
 	ok, err := s.store.Get(in.Svi.Name, svi)
@@ -175,6 +208,10 @@ func (s *Server) UpdateSvi(ctx context.Context, in *pb.UpdateSviRequest) (*pb.Sv
 		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Svi.Name)
 		return nil, err
 	}
+	// reject stale etag, see https://google.aip.dev/154
+	if err := utils.CheckEtag(ctx, svi); err != nil {
+		return nil, err
+	}
 	// use netlink to find VlanId from LogicalBridge object
 	bridgeObject := new(pb.LogicalBridge)
 	ok, err = s.store.Get(svi.Spec.LogicalBridge, bridgeObject)
@@ -204,6 +241,7 @@ func (s *Server) UpdateSvi(ctx context.Context, in *pb.UpdateSviRequest) (*pb.Sv
 	if err != nil {
 		return nil, err
 	}
+	utils.SendEtag(ctx, response)
 	return response, nil
 }
 
@@ -224,6 +262,7 @@ func (s *Server) GetSvi(ctx context.Context, in *pb.GetSviRequest) (*pb.Svi, err
 		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
 		return nil, err
 	}
+	utils.SendEtag(ctx, obj)
 	// use netlink to find VlanId from LogicalBridge object
 	bridgeObject := new(pb.LogicalBridge)
 	ok, err = s.store.Get(obj.Spec.LogicalBridge, bridgeObject)
@@ -17,15 +17,18 @@ import (
 
 	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
 
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 func (s *Server) netlinkCreateSvi(ctx context.Context, in *pb.CreateSviRequest, bridgeObject *pb.LogicalBridge, vrf *pb.Vrf) error {
 	// use netlink to find br-tenant
-	bridge, err := s.nLink.LinkByName(ctx, tenantbridgeName)
+	tenantBridge := config.GetConfig().Linux.TenantBridge
+	bridge, err := s.nLink.LinkByName(ctx, tenantBridge)
 	if err != nil {
-		err := status.Errorf(codes.NotFound, "unable to find key %s", tenantbridgeName)
+		err := status.Errorf(codes.NotFound, "unable to find key %s", tenantBridge)
 		return err
 	}
 	vid := uint16(bridgeObject.Spec.VlanId)
@@ -82,9 +85,10 @@ func (s *Server) netlinkCreateSvi(ctx context.Context, in *pb.CreateSviRequest,
 
 func (s *Server) netlinkDeleteSvi(ctx context.Context, _ *pb.DeleteSviRequest, bridgeObject *pb.LogicalBridge, _ *pb.Vrf) error {
 	// use netlink to find br-tenant
-	bridge, err := s.nLink.LinkByName(ctx, tenantbridgeName)
+	tenantBridge := config.GetConfig().Linux.TenantBridge
+	bridge, err := s.nLink.LinkByName(ctx, tenantBridge)
 	if err != nil {
-		err := status.Errorf(codes.NotFound, "unable to find key %s", tenantbridgeName)
+		err := status.Errorf(codes.NotFound, "unable to find key %s", tenantBridge)
 		return err
 	}
 	vid := uint16(bridgeObject.Spec.VlanId)
@@ -6,6 +6,7 @@ package svi
 
 import (
 	"log"
+	"sync"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
@@ -26,6 +27,8 @@ type Server struct {
 	frr        utils.Frr
 	tracer     trace.Tracer
 	store      gokv.Store
+	dryRun     bool
+	mu         sync.Mutex
 }
 
 // NewServer creates initialized instance of EVPN server
@@ -56,3 +59,18 @@ func NewServerWithArgs(nLink utils.Netlink, frr utils.Frr, store gokv.Store) *Se
 		store:      store,
 	}
 }
+
+// dryRunServer returns a copy of the server, which records netlink operations
+// and FRR commands instead of executing them and does not write to the store
+func (s *Server) dryRunServer() (*Server, *utils.DryRun) {
+	recorder := utils.NewDryRun(s.nLink, s.store)
+	return &Server{
+		ListHelper: make(map[string]bool),
+		Pagination: make(map[string]int),
+		nLink:      recorder,
+		frr:        recorder,
+		tracer:     s.tracer,
+		store:      recorder.Store(),
+		dryRun:     true,
+	}, recorder
+}
@@ -16,7 +16,9 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/vishvananda/netlink"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -29,6 +31,10 @@ import (
 	"github.com/opiproject/opi-evpn-bridge/pkg/utils/mocks"
 )
 
+const (
+	tenantbridgeName = "br-tenant"
+)
+
 var (
 	testSviID   = "opi-svi8"
 	testSviName = resourceIDToFullName(testSviID)
@@ -609,6 +615,8 @@ func Test_UpdateSvi(t *testing.T) {
 		errMsg  string
 		start   bool
 		exist   bool
+		etag    string
+		on      func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string)
 	}{
 		"invalid fieldmask": {
 			mask: &fieldmaskpb.FieldMask{Paths: []string{"*", "author"}},
@@ -634,6 +642,38 @@ func Test_UpdateSvi(t *testing.T) {
 			start:   false,
 			exist:   true,
 		},
+		"stale etag": {
+			mask: nil,
+			in: &pb.Svi{
+				Name: testSviName,
+				Spec: spec,
+			},
+			out:     nil,
+			errCode: codes.Aborted,
+			errMsg:  fmt.Sprintf("etag %v does not match the current etag %v of %v, re-read the resource and retry", "0123456789abcdef", utils.Etag(&testSviWithStatus), testSviName),
+			start:   false,
+			exist:   true,
+			etag:    "0123456789abcdef",
+		},
+		"current etag": {
+			mask: nil,
+			in: &pb.Svi{
+				Name: testSviName,
+				Spec: spec,
+			},
+			out:     &testSviWithStatus,
+			errCode: codes.OK,
+			errMsg:  "",
+			start:   false,
+			exist:   true,
+			etag:    utils.Etag(&testSviWithStatus),
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				vlanName := fmt.Sprintf("vlan%d", testLogicalBridge.Spec.VlanId)
+				vlandev := &netlink.Vlan{LinkAttrs: netlink.LinkAttrs{Name: vlanName}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, vlanName).Return(vlandev, nil).Once()
+				mockNetlink.EXPECT().LinkModify(mock.Anything, vlandev).Return(nil).Once()
+			},
+		},
 	}
 
 	// run tests
@@ -645,6 +685,7 @@ func Test_UpdateSvi(t *testing.T) {
 			client := pb.NewSviServiceClient(env.conn)
 
 			if tt.exist {
+				_ = env.opi.store.Set(testLogicalBridgeName, &testLogicalBridgeWithStatus)
 				_ = env.opi.store.Set(testSviName, &testSviWithStatus)
 			}
 			if tt.out != nil {
@@ -652,8 +693,19 @@ func Test_UpdateSvi(t *testing.T) {
 				tt.out.Name = testSviName
 			}
 
+			if tt.on != nil {
+				tt.on(env.mockNetlink, env.mockFrr, tt.errMsg)
+			}
+			if tt.etag != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, utils.EtagHeader, tt.etag)
+			}
+
+			var header metadata.MD
 			request := &pb.UpdateSviRequest{Svi: tt.in, UpdateMask: tt.mask}
-			response, err := client.UpdateSvi(ctx, request)
+			response, err := client.UpdateSvi(ctx, request, grpc.Header(&header))
+			if etags := header.Get(utils.EtagHeader); tt.errCode == codes.OK && (len(etags) == 0 || etags[0] != utils.Etag(tt.out)) {
+				t.Error("etag: expected", utils.Etag(tt.out), "received", header.Get(utils.EtagHeader))
+			}
 			if !proto.Equal(tt.out, response) {
 				t.Error("response: expected", tt.out, "received", response)
 			}
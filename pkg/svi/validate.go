@@ -13,7 +13,8 @@ import (
 	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
 )
 
-func (s *Server) validateCreateSviRequest(in *pb.CreateSviRequest) error {
+// ValidateCreateSviRequest checks a create request without executing it
+func (s *Server) ValidateCreateSviRequest(in *pb.CreateSviRequest) error {
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		return err
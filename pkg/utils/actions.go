@@ -0,0 +1,403 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package utils has some utility functions and interfaces
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// Actions collects the netlink operations and FRR commands executed
+// while serving a single request
+type Actions struct {
+	mu      sync.Mutex
+	netlink []string
+	frr     []string
+}
+
+type actionsKey struct{}
+
+// WithActions returns a context collecting the actions executed through a Recorder
+func WithActions(ctx context.Context) (context.Context, *Actions) {
+	actions := &Actions{}
+	return context.WithValue(ctx, actionsKey{}, actions), actions
+}
+
+// NetlinkOperations returns the executed netlink operations in ip command syntax
+func (a *Actions) NetlinkOperations() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]string{}, a.netlink...)
+}
+
+// FrrCommands returns the executed FRR commands, one line per command
+func (a *Actions) FrrCommands() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]string{}, a.frr...)
+}
+
+// Recorder executes netlink operations and FRR commands and adds the
+// successful ones to the Actions of the request context
+type Recorder struct {
+	Netlink
+	Frr
+}
+
+// NewRecorder creates Recorder executing through nLink and frr
+func NewRecorder(nLink Netlink, frr Frr) *Recorder {
+	return &Recorder{Netlink: nLink, Frr: frr}
+}
+
+// build time check that struct implements interface
+var _ Netlink = (*Recorder)(nil)
+var _ Frr = (*Recorder)(nil)
+
+func (r *Recorder) record(ctx context.Context, err error, op string) error {
+	if actions, ok := ctx.Value(actionsKey{}).(*Actions); ok && err == nil {
+		actions.mu.Lock()
+		actions.netlink = append(actions.netlink, op)
+		actions.mu.Unlock()
+	}
+	return err
+}
+
+func (r *Recorder) recordFrr(ctx context.Context, err error, command string, port int) error {
+	if actions, ok := ctx.Value(actionsKey{}).(*Actions); ok && err == nil {
+		actions.mu.Lock()
+		actions.frr = append(actions.frr, frrLines(command, port)...)
+		actions.mu.Unlock()
+	}
+	return err
+}
+
+// LinkModify executes and records netlink.LinkModify
+func (r *Recorder) LinkModify(ctx context.Context, link netlink.Link) error {
+	return r.record(ctx, r.Netlink.LinkModify(ctx, link), linkModifyOp(link))
+}
+
+// LinkSetHardwareAddr executes and records netlink.LinkSetHardwareAddr
+func (r *Recorder) LinkSetHardwareAddr(ctx context.Context, link netlink.Link, hwaddr net.HardwareAddr) error {
+	return r.record(ctx, r.Netlink.LinkSetHardwareAddr(ctx, link, hwaddr), linkSetHardwareAddrOp(link, hwaddr))
+}
+
+// AddrAdd executes and records netlink.AddrAdd
+func (r *Recorder) AddrAdd(ctx context.Context, link netlink.Link, addr *netlink.Addr) error {
+	return r.record(ctx, r.Netlink.AddrAdd(ctx, link, addr), addrAddOp(link, addr))
+}
+
+// AddrDel executes and records netlink.AddrDel
+func (r *Recorder) AddrDel(ctx context.Context, link netlink.Link, addr *netlink.Addr) error {
+	return r.record(ctx, r.Netlink.AddrDel(ctx, link, addr), addrDelOp(link, addr))
+}
+
+// LinkAdd executes and records netlink.LinkAdd
+func (r *Recorder) LinkAdd(ctx context.Context, link netlink.Link) error {
+	return r.record(ctx, r.Netlink.LinkAdd(ctx, link), linkAddOp(link))
+}
+
+// LinkDel executes and records netlink.LinkDel
+func (r *Recorder) LinkDel(ctx context.Context, link netlink.Link) error {
+	return r.record(ctx, r.Netlink.LinkDel(ctx, link), linkDelOp(link))
+}
+
+// LinkSetUp executes and records netlink.LinkSetUp
+func (r *Recorder) LinkSetUp(ctx context.Context, link netlink.Link) error {
+	return r.record(ctx, r.Netlink.LinkSetUp(ctx, link), linkSetUpOp(link))
+}
+
+// LinkSetDown executes and records netlink.LinkSetDown
+func (r *Recorder) LinkSetDown(ctx context.Context, link netlink.Link) error {
+	return r.record(ctx, r.Netlink.LinkSetDown(ctx, link), linkSetDownOp(link))
+}
+
+// LinkSetMaster executes and records netlink.LinkSetMaster
+func (r *Recorder) LinkSetMaster(ctx context.Context, link, master netlink.Link) error {
+	return r.record(ctx, r.Netlink.LinkSetMaster(ctx, link, master), linkSetMasterOp(link, master))
+}
+
+// LinkSetNoMaster executes and records netlink.LinkSetNoMaster
+func (r *Recorder) LinkSetNoMaster(ctx context.Context, link netlink.Link) error {
+	return r.record(ctx, r.Netlink.LinkSetNoMaster(ctx, link), linkSetNoMasterOp(link))
+}
+
+// LinkSetBondSlave executes and records netlink.LinkSetBondSlave
+func (r *Recorder) LinkSetBondSlave(ctx context.Context, link netlink.Link, master *netlink.Bond) error {
+	return r.record(ctx, r.Netlink.LinkSetBondSlave(ctx, link, master), linkSetMasterOp(link, master))
+}
+
+// LinkSetNetns executes and records the move of a link into a network namespace
+func (r *Recorder) LinkSetNetns(ctx context.Context, link netlink.Link, ns string) error {
+	return r.record(ctx, r.Netlink.LinkSetNetns(ctx, link, ns), linkSetNetnsOp(link, ns))
+}
+
+// BridgeVlanAdd executes and records netlink.BridgeVlanAdd
+func (r *Recorder) BridgeVlanAdd(ctx context.Context, link netlink.Link, vid uint16, pvid, untagged, self, master bool) error {
+	err := r.Netlink.BridgeVlanAdd(ctx, link, vid, pvid, untagged, self, master)
+	return r.record(ctx, err, bridgeVlanAddOp(link, vid, pvid, untagged, self, master))
+}
+
+// BridgeVlanDel executes and records netlink.BridgeVlanDel
+func (r *Recorder) BridgeVlanDel(ctx context.Context, link netlink.Link, vid uint16, pvid, untagged, self, master bool) error {
+	err := r.Netlink.BridgeVlanDel(ctx, link, vid, pvid, untagged, self, master)
+	return r.record(ctx, err, bridgeVlanDelOp(link, vid, pvid, untagged, self, master))
+}
+
+// LinkSetBrNeighSuppress executes and records the change of the ARP and ND suppression flag
+func (r *Recorder) LinkSetBrNeighSuppress(ctx context.Context, link netlink.Link, on bool) error {
+	return r.record(ctx, r.Netlink.LinkSetBrNeighSuppress(ctx, link, on), linkSetBrNeighSuppressOp(link, on))
+}
+
+// LinkSetBrVlanTunnel executes and records the change of the vlan_tunnel flag
+func (r *Recorder) LinkSetBrVlanTunnel(ctx context.Context, link netlink.Link, on bool) error {
+	return r.record(ctx, r.Netlink.LinkSetBrVlanTunnel(ctx, link, on), linkSetBrVlanTunnelOp(link, on))
+}
+
+// BridgeVlanTunnelAdd executes and records the mapping of a VLAN to a tunnel id
+func (r *Recorder) BridgeVlanTunnelAdd(ctx context.Context, link netlink.Link, vid uint16, tunid uint32) error {
+	return r.record(ctx, r.Netlink.BridgeVlanTunnelAdd(ctx, link, vid, tunid), bridgeVlanTunnelAddOp(link, vid, tunid))
+}
+
+// BridgeVlanTunnelDel executes and records the removal of the mapping of a VLAN to a tunnel id
+func (r *Recorder) BridgeVlanTunnelDel(ctx context.Context, link netlink.Link, vid uint16, tunid uint32) error {
+	return r.record(ctx, r.Netlink.BridgeVlanTunnelDel(ctx, link, vid, tunid), bridgeVlanTunnelDelOp(link, vid, tunid))
+}
+
+// RouteAdd executes and records netlink.RouteAdd
+func (r *Recorder) RouteAdd(ctx context.Context, route *netlink.Route) error {
+	return r.record(ctx, r.Netlink.RouteAdd(ctx, route), routeAddOp(route))
+}
+
+// RouteDel executes and records netlink.RouteDel
+func (r *Recorder) RouteDel(ctx context.Context, route *netlink.Route) error {
+	return r.record(ctx, r.Netlink.RouteDel(ctx, route), routeDelOp(route))
+}
+
+// TelnetDialAndCommunicate executes and records the command lines sent to the FRR daemon on port
+func (r *Recorder) TelnetDialAndCommunicate(ctx context.Context, command string, port int) (string, error) {
+	output, err := r.Frr.TelnetDialAndCommunicate(ctx, command, port)
+	return output, r.recordFrr(ctx, err, command, port)
+}
+
+// FrrZebraCmd executes and records the command for Zebra
+func (r *Recorder) FrrZebraCmd(ctx context.Context, command string) (string, error) {
+	output, err := r.Frr.FrrZebraCmd(ctx, command)
+	return output, r.recordFrr(ctx, err, command, zebra)
+}
+
+// FrrBgpCmd executes and records the command for Bgp
+func (r *Recorder) FrrBgpCmd(ctx context.Context, command string) (string, error) {
+	output, err := r.Frr.FrrBgpCmd(ctx, command)
+	return output, r.recordFrr(ctx, err, command, bgpd)
+}
+
+// the functions below describe netlink operations in ip command syntax
+
+func linkModifyOp(link netlink.Link) string {
+	return fmt.Sprintf("ip link set %v", strings.TrimSpace(link.Attrs().Name+" "+describeLink(link)))
+}
+
+func linkSetHardwareAddrOp(link netlink.Link, hwaddr net.HardwareAddr) string {
+	return fmt.Sprintf("ip link set %v address %v", link.Attrs().Name, hwaddr)
+}
+
+func addrAddOp(link netlink.Link, addr *netlink.Addr) string {
+	return fmt.Sprintf("ip address add %v dev %v", addr.IPNet, link.Attrs().Name)
+}
+
+func addrDelOp(link netlink.Link, addr *netlink.Addr) string {
+	return fmt.Sprintf("ip address del %v dev %v", addr.IPNet, link.Attrs().Name)
+}
+
+func linkAddOp(link netlink.Link) string {
+	return fmt.Sprintf("ip link add %v type %v", link.Attrs().Name, strings.TrimSpace(link.Type()+" "+describeLink(link)))
+}
+
+func linkDelOp(link netlink.Link) string {
+	return fmt.Sprintf("ip link del %v", link.Attrs().Name)
+}
+
+func linkSetUpOp(link netlink.Link) string {
+	return fmt.Sprintf("ip link set %v up", link.Attrs().Name)
+}
+
+func linkSetDownOp(link netlink.Link) string {
+	return fmt.Sprintf("ip link set %v down", link.Attrs().Name)
+}
+
+func linkSetMasterOp(link, master netlink.Link) string {
+	return fmt.Sprintf("ip link set %v master %v", link.Attrs().Name, master.Attrs().Name)
+}
+
+func linkSetNoMasterOp(link netlink.Link) string {
+	return fmt.Sprintf("ip link set %v nomaster", link.Attrs().Name)
+}
+
+func linkSetNetnsOp(link netlink.Link, ns string) string {
+	return fmt.Sprintf("ip link set %v netns %v", link.Attrs().Name, ns)
+}
+
+func bridgeVlanAddOp(link netlink.Link, vid uint16, pvid, untagged, self, master bool) string {
+	return fmt.Sprintf("bridge vlan add dev %v vid %d%v", link.Attrs().Name, vid, vlanFlags(pvid, untagged, self, master))
+}
+
+func bridgeVlanDelOp(link netlink.Link, vid uint16, pvid, untagged, self, master bool) string {
+	return fmt.Sprintf("bridge vlan del dev %v vid %d%v", link.Attrs().Name, vid, vlanFlags(pvid, untagged, self, master))
+}
+
+func linkSetBrNeighSuppressOp(link netlink.Link, on bool) string {
+	return fmt.Sprintf("bridge link set dev %v neigh_suppress %v", link.Attrs().Name, onOff(on))
+}
+
+func linkSetBrVlanTunnelOp(link netlink.Link, on bool) string {
+	return fmt.Sprintf("bridge link set dev %v vlan_tunnel %v", link.Attrs().Name, onOff(on))
+}
+
+func bridgeVlanTunnelAddOp(link netlink.Link, vid uint16, tunid uint32) string {
+	return fmt.Sprintf("bridge vlan add dev %v vid %d tunnel_info id %d", link.Attrs().Name, vid, tunid)
+}
+
+func bridgeVlanTunnelDelOp(link netlink.Link, vid uint16, tunid uint32) string {
+	return fmt.Sprintf("bridge vlan del dev %v vid %d tunnel_info id %d", link.Attrs().Name, vid, tunid)
+}
+
+func routeAddOp(route *netlink.Route) string {
+	return fmt.Sprintf("%v add %v", routeCommand(route), describeRoute(route))
+}
+
+func routeDelOp(route *netlink.Route) string {
+	return fmt.Sprintf("%v del %v", routeCommand(route), describeRoute(route))
+}
+
+// routeCommand returns the ip command for the address family of the route
+func routeCommand(route *netlink.Route) string {
+	if route.Dst != nil && route.Dst.IP.To4() == nil {
+		return "ip -6 route"
+	}
+	return "ip route"
+}
+
+func onOff(on bool) string {
+	if on {
+		return "on"
+	}
+	return "off"
+}
+
+// describeLink returns the type specific attributes of the link in ip command syntax
+func describeLink(link netlink.Link) string {
+	switch l := link.(type) {
+	case *netlink.Vrf:
+		return fmt.Sprintf("table %d", l.Table)
+	case *netlink.Vxlan:
+		desc := fmt.Sprintf("id %d local %v dstport %d", l.VxlanId, l.SrcAddr, l.Port)
+		if l.FlowBased {
+			desc = fmt.Sprintf("local %v dstport %d external", l.SrcAddr, l.Port)
+		}
+		if !l.Learning {
+			desc += " nolearning"
+		}
+		return desc
+	case *netlink.Vlan:
+		return fmt.Sprintf("id %d link-index %d", l.VlanId, l.Attrs().ParentIndex)
+	case *netlink.Veth:
+		return fmt.Sprintf("peer name %v", l.PeerName)
+	case *netlink.Macvlan:
+		return fmt.Sprintf("mode %v link-index %d", macvlanModeName(l.Mode), l.Attrs().ParentIndex)
+	case *netlink.Bond:
+		desc := fmt.Sprintf("mode %v", l.Mode)
+		if l.LacpRate >= 0 {
+			desc += fmt.Sprintf(" lacp_rate %v", l.LacpRate)
+		}
+		if l.XmitHashPolicy >= 0 {
+			desc += fmt.Sprintf(" xmit_hash_policy %v", l.XmitHashPolicy)
+		}
+		if l.MinLinks >= 0 {
+			desc += fmt.Sprintf(" min_links %d", l.MinLinks)
+		}
+		return desc
+	}
+	return ""
+}
+
+// macvlanModeName returns the mode of a macvlan in ip command syntax
+func macvlanModeName(mode netlink.MacvlanMode) string {
+	switch mode {
+	case netlink.MACVLAN_MODE_PRIVATE:
+		return "private"
+	case netlink.MACVLAN_MODE_VEPA:
+		return "vepa"
+	case netlink.MACVLAN_MODE_BRIDGE:
+		return "bridge"
+	case netlink.MACVLAN_MODE_PASSTHRU:
+		return "passthru"
+	case netlink.MACVLAN_MODE_SOURCE:
+		return "source"
+	}
+	return "default"
+}
+
+// describeRoute returns the route in ip command syntax
+func describeRoute(route *netlink.Route) string {
+	desc := "default"
+	if route.Dst != nil {
+		if ones, _ := route.Dst.Mask.Size(); ones != 0 {
+			desc = route.Dst.String()
+		}
+	}
+	if route.Type == unix.RTN_THROW {
+		desc = "throw " + desc
+	}
+	if route.Gw != nil {
+		desc += fmt.Sprintf(" via %v", route.Gw)
+	}
+	if route.LinkIndex != 0 {
+		desc += fmt.Sprintf(" dev-index %d", route.LinkIndex)
+	}
+	if route.Table != 0 {
+		desc += fmt.Sprintf(" table %d", route.Table)
+	}
+	if route.Protocol != 0 {
+		desc += fmt.Sprintf(" proto %d", route.Protocol)
+	}
+	if route.Priority != 0 {
+		desc += fmt.Sprintf(" metric %d", route.Priority)
+	}
+	return desc
+}
+
+func vlanFlags(pvid, untagged, self, master bool) string {
+	flags := ""
+	for _, f := range []struct {
+		set  bool
+		name string
+	}{{pvid, "pvid"}, {untagged, "untagged"}, {self, "self"}, {master, "master"}} {
+		if f.set {
+			flags += " " + f.name
+		}
+	}
+	return flags
+}
+
+// frrLines splits the command into lines prefixed by the FRR daemon on port
+func frrLines(command string, port int) []string {
+	daemon := map[int]string{zebra: "zebra", bgpd: "bgpd"}[port]
+	if daemon == "" {
+		daemon = fmt.Sprintf("port %d", port)
+	}
+	var lines []string
+	for _, line := range strings.Split(command, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, daemon+": "+line)
+		}
+	}
+	return lines
+}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package utils has some utility functions and interfaces
+package utils
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+)
+
+// BatchStep is a single request of a batch. Invalid holds the error found
+// while validating the batch, Apply executes a valid request and returns
+// the function undoing it, or nil when there is nothing to undo.
+type BatchStep struct {
+	Name    string
+	Invalid error
+	Apply   func(ctx context.Context) (undo func(ctx context.Context) error, err error)
+}
+
+// RunBatch applies the valid steps in order, sharing connections through
+// a Session, and returns the outcome of every step. With allOrNothing,
+// nothing is applied when a step is invalid, and the applied steps are
+// undone in reverse order when a step fails, then the error of the failed
+// step is returned.
+func RunBatch(ctx context.Context, steps []BatchStep, allOrNothing bool) ([]*pa.BatchResult, error) {
+	if allOrNothing {
+		for i, step := range steps {
+			if step.Invalid != nil {
+				st := status.Convert(step.Invalid)
+				return nil, status.Errorf(st.Code(), "request %d (%s) is invalid: %s", i, step.Name, st.Message())
+			}
+		}
+	}
+	ctx, session := WithSession(ctx)
+	defer session.Close()
+	var undos []func(ctx context.Context) error
+	results := make([]*pa.BatchResult, len(steps))
+	for i, step := range steps {
+		err := step.Invalid
+		if err == nil {
+			var undo func(ctx context.Context) error
+			undo, err = step.Apply(ctx)
+			if err == nil && undo != nil {
+				undos = append(undos, undo)
+			}
+		}
+		if err != nil && allOrNothing {
+			st := status.Convert(err)
+			msg := fmt.Sprintf("request %d (%s) failed, undid %d applied requests: %s", i, step.Name, len(undos), st.Message())
+			for j := len(undos) - 1; j >= 0; j-- {
+				if undoErr := undos[j](ctx); undoErr != nil {
+					log.Printf("Failed to undo request of batch: %v", undoErr)
+					msg = fmt.Sprintf("request %d (%s) failed, undoing applied requests failed too (%v): %s",
+						i, step.Name, undoErr, st.Message())
+				}
+			}
+			return nil, status.Error(st.Code(), msg)
+		}
+		results[i] = &pa.BatchResult{Name: step.Name}
+		if err != nil {
+			st := status.Convert(err)
+			results[i].Code = int32(st.Code())
+			results[i].Error = st.Message()
+		}
+	}
+	return results, nil
+}
+
+// CheckBatchDuplicate fails with InvalidArgument when name was already seen
+// in the batch, otherwise it adds name to seen
+func CheckBatchDuplicate(seen map[string]bool, name string) error {
+	if seen[name] {
+		return status.Errorf(codes.InvalidArgument, "%s is used by more than one request of the batch", name)
+	}
+	seen[name] = true
+	return nil
+}
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package utils has some utility functions and interfaces
+package utils
+
+import (
+	"fmt"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+// bridge port attributes missing in the netlink package, see if_link.h
+const (
+	iflaBrportMcastFlood    = 27
+	iflaBrportVlanTunnel    = 29
+	iflaBrportBcastFlood    = 30
+	iflaBrportNeighSuppress = 32
+)
+
+// BridgePortFlags are the flags of a link enslaved to a bridge, including
+// the ones netlink.Protinfo does not cover
+type BridgePortFlags struct {
+	netlink.Protinfo
+	McastFlood    bool
+	BcastFlood    bool
+	NeighSuppress bool
+	VlanTunnel    bool
+}
+
+// setBridgePortFlag sets a flag of a bridge port, like `bridge link set dev <link> <flag> on|off`
+func setBridgePortFlag(h *netlink.Handle, link netlink.Link, attr uint16, on bool) error {
+	index, err := linkIndex(h, link)
+	if err != nil {
+		return err
+	}
+	req := nl.NewNetlinkRequest(unix.RTM_SETLINK, unix.NLM_F_ACK)
+	msg := nl.NewIfInfomsg(unix.AF_BRIDGE)
+	msg.Index = int32(index)
+	req.AddData(msg)
+	value := []byte{0}
+	if on {
+		value[0] = 1
+	}
+	protinfo := nl.NewRtAttr(unix.IFLA_PROTINFO|unix.NLA_F_NESTED, nil)
+	protinfo.AddRtAttr(int(attr), value)
+	req.AddData(protinfo)
+	_, err = req.Execute(unix.NETLINK_ROUTE, 0)
+	return err
+}
+
+// bridgePortFlags reads the flags of a bridge port, like `bridge -d link show dev <link>`
+func bridgePortFlags(h *netlink.Handle, link netlink.Link) (*BridgePortFlags, error) {
+	index, err := linkIndex(h, link)
+	if err != nil {
+		return nil, err
+	}
+	req := nl.NewNetlinkRequest(unix.RTM_GETLINK, unix.NLM_F_DUMP)
+	req.AddData(nl.NewIfInfomsg(unix.AF_BRIDGE))
+	msgs, err := req.Execute(unix.NETLINK_ROUTE, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range msgs {
+		ans := nl.DeserializeIfInfomsg(m)
+		if int(ans.Index) != index {
+			continue
+		}
+		attrs, err := nl.ParseRouteAttr(m[ans.Len():])
+		if err != nil {
+			return nil, err
+		}
+		for _, attr := range attrs {
+			if attr.Attr.Type != unix.IFLA_PROTINFO|unix.NLA_F_NESTED {
+				continue
+			}
+			infos, err := nl.ParseRouteAttr(attr.Value)
+			if err != nil {
+				return nil, err
+			}
+			flags := &BridgePortFlags{}
+			for _, info := range infos {
+				if len(info.Value) == 0 {
+					continue
+				}
+				on := info.Value[0] != 0
+				switch info.Attr.Type {
+				case nl.IFLA_BRPORT_MODE:
+					flags.Hairpin = on
+				case nl.IFLA_BRPORT_GUARD:
+					flags.Guard = on
+				case nl.IFLA_BRPORT_FAST_LEAVE:
+					flags.FastLeave = on
+				case nl.IFLA_BRPORT_PROTECT:
+					flags.RootBlock = on
+				case nl.IFLA_BRPORT_LEARNING:
+					flags.Learning = on
+				case nl.IFLA_BRPORT_UNICAST_FLOOD:
+					flags.Flood = on
+				case nl.IFLA_BRPORT_PROXYARP:
+					flags.ProxyArp = on
+				case nl.IFLA_BRPORT_PROXYARP_WIFI:
+					flags.ProxyArpWiFi = on
+				case iflaBrportMcastFlood:
+					flags.McastFlood = on
+				case iflaBrportBcastFlood:
+					flags.BcastFlood = on
+				case iflaBrportNeighSuppress:
+					flags.NeighSuppress = on
+				case iflaBrportVlanTunnel:
+					flags.VlanTunnel = on
+				}
+			}
+			return flags, nil
+		}
+	}
+	return nil, fmt.Errorf("link %v is not a bridge port", link.Attrs().Name)
+}
+
+// linkIndex returns the index of link, looking it up by name when it is not set
+func linkIndex(h *netlink.Handle, link netlink.Link) (int, error) {
+	if index := link.Attrs().Index; index != 0 {
+		return index, nil
+	}
+	found, err := h.LinkByName(link.Attrs().Name)
+	if err != nil {
+		return 0, err
+	}
+	return found.Attrs().Index, nil
+}
@@ -0,0 +1,329 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package utils has some utility functions and interfaces
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/philippgille/gokv"
+	"github.com/vishvananda/netlink"
+	"github.com/ziutek/telnet"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// request and response metadata keys of the dry run mode
+const (
+	// DryRunHeader set to true in the request metadata turns a mutating call
+	// into a dry run, which validates the request and resolves references
+	// without changing the system
+	DryRunHeader = "x-opi-dry-run"
+	// DryRunNetlinkHeader lists the netlink operations a dry run would execute
+	DryRunNetlinkHeader = "x-opi-dry-run-netlink"
+	// DryRunFrrHeader lists the FRR commands a dry run would execute
+	DryRunFrrHeader = "x-opi-dry-run-frr"
+)
+
+// IsDryRun tells whether the request metadata asks for a dry run
+func IsDryRun(ctx context.Context) bool {
+	for _, value := range metadata.ValueFromIncomingContext(ctx, DryRunHeader) {
+		if strings.EqualFold(value, "true") || value == "1" {
+			return true
+		}
+	}
+	return false
+}
+
+// DryRun records netlink operations and FRR commands instead of executing them.
+// Lookups go to the real netlink, so missing devices and name conflicts are
+// reported like in a real run.
+type DryRun struct {
+	nLink   Netlink
+	store   gokv.Store
+	mu      sync.Mutex
+	links   map[string]netlink.Link
+	netlink []string
+	frr     []string
+}
+
+// NewDryRun creates DryRun resolving lookups through nLink and store
+func NewDryRun(nLink Netlink, store gokv.Store) *DryRun {
+	return &DryRun{nLink: nLink, store: store, links: make(map[string]netlink.Link)}
+}
+
+// build time check that struct implements interface
+var _ Netlink = (*DryRun)(nil)
+var _ Frr = (*DryRun)(nil)
+
+// NetlinkOperations returns the recorded netlink operations in ip command syntax
+func (d *DryRun) NetlinkOperations() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]string{}, d.netlink...)
+}
+
+// FrrCommands returns the recorded FRR commands, one line per command
+func (d *DryRun) FrrCommands() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]string{}, d.frr...)
+}
+
+// SendHeader returns the recorded operations in the response metadata
+func (d *DryRun) SendHeader(ctx context.Context) {
+	md := metadata.MD{}
+	md.Set(DryRunHeader, "true")
+	md.Set(DryRunNetlinkHeader, d.NetlinkOperations()...)
+	md.Set(DryRunFrrHeader, d.FrrCommands()...)
+	if err := grpc.SetHeader(ctx, md); err != nil {
+		log.Printf("Failed to send dry run operations: %v", err)
+	}
+}
+
+// Store returns a store reading through to the real one and dropping all writes
+func (d *DryRun) Store() gokv.Store {
+	return dryRunStore{store: d.store}
+}
+
+func (d *DryRun) record(op string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	log.Printf("Dry run: %v", op)
+	d.netlink = append(d.netlink, op)
+}
+
+// LinkByName returns links added during the dry run or looks them up in netlink
+func (d *DryRun) LinkByName(ctx context.Context, name string) (netlink.Link, error) {
+	d.mu.Lock()
+	link, ok := d.links[name]
+	d.mu.Unlock()
+	if ok {
+		return link, nil
+	}
+	return d.nLink.LinkByName(ctx, name)
+}
+
+// LinkModify records netlink.LinkModify
+func (d *DryRun) LinkModify(_ context.Context, link netlink.Link) error {
+	d.record(linkModifyOp(link))
+	return nil
+}
+
+// LinkSetHardwareAddr records netlink.LinkSetHardwareAddr
+func (d *DryRun) LinkSetHardwareAddr(_ context.Context, link netlink.Link, hwaddr net.HardwareAddr) error {
+	d.record(linkSetHardwareAddrOp(link, hwaddr))
+	return nil
+}
+
+// AddrAdd records netlink.AddrAdd
+func (d *DryRun) AddrAdd(_ context.Context, link netlink.Link, addr *netlink.Addr) error {
+	d.record(addrAddOp(link, addr))
+	return nil
+}
+
+// AddrDel records netlink.AddrDel
+func (d *DryRun) AddrDel(_ context.Context, link netlink.Link, addr *netlink.Addr) error {
+	d.record(addrDelOp(link, addr))
+	return nil
+}
+
+// LinkAdd records netlink.LinkAdd, it fails like netlink if the link already exists
+func (d *DryRun) LinkAdd(ctx context.Context, link netlink.Link) error {
+	name := link.Attrs().Name
+	if _, err := d.LinkByName(ctx, name); err == nil {
+		return fmt.Errorf("link %v already exists", name)
+	}
+	d.mu.Lock()
+	d.links[name] = link
+	// a veth pair adds both ends
+	if veth, ok := link.(*netlink.Veth); ok && veth.PeerName != "" {
+		d.links[veth.PeerName] = &netlink.Veth{LinkAttrs: netlink.LinkAttrs{Name: veth.PeerName}, PeerName: name}
+	}
+	d.mu.Unlock()
+	d.record(linkAddOp(link))
+	return nil
+}
+
+// LinkDel records netlink.LinkDel
+func (d *DryRun) LinkDel(_ context.Context, link netlink.Link) error {
+	d.mu.Lock()
+	delete(d.links, link.Attrs().Name)
+	d.mu.Unlock()
+	d.record(linkDelOp(link))
+	return nil
+}
+
+// LinkSetUp records netlink.LinkSetUp
+func (d *DryRun) LinkSetUp(_ context.Context, link netlink.Link) error {
+	d.record(linkSetUpOp(link))
+	return nil
+}
+
+// LinkSetDown records netlink.LinkSetDown
+func (d *DryRun) LinkSetDown(_ context.Context, link netlink.Link) error {
+	d.record(linkSetDownOp(link))
+	return nil
+}
+
+// LinkSetMaster records netlink.LinkSetMaster
+func (d *DryRun) LinkSetMaster(_ context.Context, link, master netlink.Link) error {
+	d.record(linkSetMasterOp(link, master))
+	return nil
+}
+
+// LinkSetNoMaster records netlink.LinkSetNoMaster
+func (d *DryRun) LinkSetNoMaster(_ context.Context, link netlink.Link) error {
+	d.record(linkSetNoMasterOp(link))
+	return nil
+}
+
+// LinkSetBondSlave records netlink.LinkSetBondSlave
+func (d *DryRun) LinkSetBondSlave(_ context.Context, link netlink.Link, master *netlink.Bond) error {
+	d.record(linkSetMasterOp(link, master))
+	return nil
+}
+
+// LinkSetNetns records the move of a link into a network namespace,
+// the link is gone from the namespace of the bridge afterwards
+func (d *DryRun) LinkSetNetns(_ context.Context, link netlink.Link, ns string) error {
+	d.mu.Lock()
+	delete(d.links, link.Attrs().Name)
+	d.mu.Unlock()
+	d.record(linkSetNetnsOp(link, ns))
+	return nil
+}
+
+// BridgeVlanAdd records netlink.BridgeVlanAdd
+func (d *DryRun) BridgeVlanAdd(_ context.Context, link netlink.Link, vid uint16, pvid, untagged, self, master bool) error {
+	d.record(bridgeVlanAddOp(link, vid, pvid, untagged, self, master))
+	return nil
+}
+
+// BridgeVlanDel records netlink.BridgeVlanDel
+func (d *DryRun) BridgeVlanDel(_ context.Context, link netlink.Link, vid uint16, pvid, untagged, self, master bool) error {
+	d.record(bridgeVlanDelOp(link, vid, pvid, untagged, self, master))
+	return nil
+}
+
+// LinkSetBrNeighSuppress records the change of the ARP and ND suppression flag
+func (d *DryRun) LinkSetBrNeighSuppress(_ context.Context, link netlink.Link, on bool) error {
+	d.record(linkSetBrNeighSuppressOp(link, on))
+	return nil
+}
+
+// LinkSetBrVlanTunnel records the change of the vlan_tunnel flag
+func (d *DryRun) LinkSetBrVlanTunnel(_ context.Context, link netlink.Link, on bool) error {
+	d.record(linkSetBrVlanTunnelOp(link, on))
+	return nil
+}
+
+// BridgeVlanTunnelAdd records the mapping of a VLAN to a tunnel id
+func (d *DryRun) BridgeVlanTunnelAdd(_ context.Context, link netlink.Link, vid uint16, tunid uint32) error {
+	d.record(bridgeVlanTunnelAddOp(link, vid, tunid))
+	return nil
+}
+
+// BridgeVlanTunnelDel records the removal of the mapping of a VLAN to a tunnel id
+func (d *DryRun) BridgeVlanTunnelDel(_ context.Context, link netlink.Link, vid uint16, tunid uint32) error {
+	d.record(bridgeVlanTunnelDelOp(link, vid, tunid))
+	return nil
+}
+
+// RouteAdd records netlink.RouteAdd
+func (d *DryRun) RouteAdd(_ context.Context, route *netlink.Route) error {
+	d.record(routeAddOp(route))
+	return nil
+}
+
+// RouteDel records netlink.RouteDel
+func (d *DryRun) RouteDel(_ context.Context, route *netlink.Route) error {
+	d.record(routeDelOp(route))
+	return nil
+}
+
+// LinkGetBrPortFlags returns no flags for links added during the dry run
+// or looks them up in netlink
+func (d *DryRun) LinkGetBrPortFlags(ctx context.Context, link netlink.Link) (*BridgePortFlags, error) {
+	d.mu.Lock()
+	_, ok := d.links[link.Attrs().Name]
+	d.mu.Unlock()
+	if ok {
+		return &BridgePortFlags{}, nil
+	}
+	return d.nLink.LinkGetBrPortFlags(ctx, link)
+}
+
+// LinkSubscribe is not supported, since a dry run never waits for changes
+func (d *DryRun) LinkSubscribe(_ context.Context, _ chan<- netlink.LinkUpdate, _ <-chan struct{}) error {
+	return errors.New("dry run does not subscribe to netlink")
+}
+
+// TelnetDialAndCommunicate records the command lines sent to the FRR daemon on port
+func (d *DryRun) TelnetDialAndCommunicate(_ context.Context, command string, port int) (string, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, line := range frrLines(command, port) {
+		log.Printf("Dry run: %v", line)
+		d.frr = append(d.frr, line)
+	}
+	return "", nil
+}
+
+// FrrZebraCmd records the command for Zebra
+func (d *DryRun) FrrZebraCmd(ctx context.Context, command string) (string, error) {
+	return d.TelnetDialAndCommunicate(ctx, command, zebra)
+}
+
+// FrrBgpCmd records the command for Bgp
+func (d *DryRun) FrrBgpCmd(ctx context.Context, command string) (string, error) {
+	return d.TelnetDialAndCommunicate(ctx, command, bgpd)
+}
+
+// Password is not used, since dry run never connects to FRR
+func (d *DryRun) Password(_ *telnet.Conn, _ string) error {
+	return errors.New("dry run does not connect to FRR")
+}
+
+// EnterPrivileged is not used, since dry run never connects to FRR
+func (d *DryRun) EnterPrivileged(_ *telnet.Conn) error {
+	return errors.New("dry run does not connect to FRR")
+}
+
+// ExitPrivileged is not used, since dry run never connects to FRR
+func (d *DryRun) ExitPrivileged(_ *telnet.Conn) error {
+	return errors.New("dry run does not connect to FRR")
+}
+
+// dryRunStore reads from the real store and drops writes
+type dryRunStore struct {
+	store gokv.Store
+}
+
+func (s dryRunStore) Set(_ string, _ interface{}) error {
+	return nil
+}
+
+func (s dryRunStore) Get(k string, v interface{}) (bool, error) {
+	return s.store.Get(k, v)
+}
+
+func (s dryRunStore) Delete(_ string) error {
+	return nil
+}
+
+func (s dryRunStore) Close() error {
+	return nil
+}
+
+func (s dryRunStore) Keys() []string {
+	return StoreKeys(s.store)
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package utils has some utility functions and interfaces
+package utils
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// EtagHeader carries the etag of a resource in the response metadata of
+// Create, Get and Update and in the request metadata of Update and Delete,
+// since the resources have no etag field, see https://google.aip.dev/154
+const EtagHeader = "etag"
+
+// Etag returns the checksum of the stored object, which changes on every write
+// that changes the object
+func Etag(obj proto.Message) string {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(obj)
+	if err != nil {
+		log.Printf("Failed to marshal %v for etag: %v", obj, err)
+	}
+	h := fnv.New64a()
+	_, _ = h.Write(data)
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+// SendEtag returns the etag of the stored object in the response metadata
+func SendEtag(ctx context.Context, obj proto.Message) {
+	if err := grpc.SetHeader(ctx, metadata.Pairs(EtagHeader, Etag(obj))); err != nil {
+		log.Printf("Failed to send etag: %v", err)
+	}
+}
+
+// CheckEtag fails with Aborted when the request carries an etag
+// which differs from the etag of the stored object
+func CheckEtag(ctx context.Context, obj proto.Message) error {
+	values := metadata.ValueFromIncomingContext(ctx, EtagHeader)
+	if len(values) == 0 || values[0] == "" {
+		return nil
+	}
+	if etag := Etag(obj); values[0] != etag {
+		return status.Errorf(codes.Aborted, "etag %s does not match the current etag %s of %s, re-read the resource and retry",
+			values[0], etag, resourceName(obj))
+	}
+	return nil
+}
+
+// resourceName returns the name field of the resource, if it has one
+func resourceName(obj proto.Message) string {
+	if named, ok := obj.(interface{ GetName() string }); ok {
+		return named.GetName()
+	}
+	return ""
+}
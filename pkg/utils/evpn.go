@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package utils has some utility functions and interfaces
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+)
+
+// ValidateEvpnOptions checks the format of the route distinguisher and route targets
+func ValidateEvpnOptions(options *pa.EvpnOptions) error {
+	if options == nil {
+		return nil
+	}
+	if options.Rd != "" && !validRouteValue(options.Rd) {
+		return status.Errorf(codes.InvalidArgument, "evpn.rd value (%s) has to be ASN:NN or IPv4:NN", options.Rd)
+	}
+	if err := validateRouteTargets("evpn.import_rts", options.ImportRts); err != nil {
+		return err
+	}
+	return validateRouteTargets("evpn.export_rts", options.ExportRts)
+}
+
+func validateRouteTargets(field string, rts []string) error {
+	seen := make(map[string]bool)
+	for _, rt := range rts {
+		if !validRouteValue(rt) {
+			return status.Errorf(codes.InvalidArgument, "%s value (%s) has to be ASN:NN or IPv4:NN", field, rt)
+		}
+		if seen[rt] {
+			return status.Errorf(codes.InvalidArgument, "%s value (%s) is set more than once", field, rt)
+		}
+		seen[rt] = true
+	}
+	return nil
+}
+
+// validRouteValue tells whether value is a route distinguisher or route target
+// FRR accepts, a 2 byte ASN with a 4 byte number, a 4 byte ASN or an IPv4
+// address with a 2 byte number
+func validRouteValue(value string) bool {
+	admin, number, ok := strings.Cut(value, ":")
+	if !ok {
+		return false
+	}
+	nn, err := strconv.ParseUint(number, 10, 32)
+	if err != nil {
+		return false
+	}
+	if ip := net.ParseIP(admin); ip != nil {
+		return ip.To4() != nil && nn <= math.MaxUint16
+	}
+	asn, err := strconv.ParseUint(admin, 10, 32)
+	if err != nil {
+		return false
+	}
+	return asn <= math.MaxUint16 || nn <= math.MaxUint16
+}
+
+// EvpnCommands returns the FRR commands of the "address-family l2vpn evpn"
+// or "vni" block, which change the options of a VNI from oldOptions to options
+func EvpnCommands(oldOptions, options *pa.EvpnOptions) []string {
+	var commands []string
+	if oldOptions.GetRd() != options.GetRd() {
+		if oldOptions.GetRd() != "" {
+			commands = append(commands, "no rd "+oldOptions.GetRd())
+		}
+		if options.GetRd() != "" {
+			commands = append(commands, "rd "+options.GetRd())
+		}
+	}
+	commands = append(commands, routeTargetCommands("import", oldOptions.GetImportRts(), options.GetImportRts())...)
+	commands = append(commands, routeTargetCommands("export", oldOptions.GetExportRts(), options.GetExportRts())...)
+	return commands
+}
+
+func routeTargetCommands(direction string, oldRts, rts []string) []string {
+	var commands []string
+	for _, rt := range oldRts {
+		if !containsString(rts, rt) {
+			commands = append(commands, fmt.Sprintf("no route-target %s %s", direction, rt))
+		}
+	}
+	for _, rt := range rts {
+		if !containsString(oldRts, rt) {
+			commands = append(commands, fmt.Sprintf("route-target %s %s", direction, rt))
+		}
+	}
+	return commands
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// EvpnShowCommand returns the FRR command, which shows the options of a VNI
+func EvpnShowCommand(vni uint32) string {
+	return fmt.Sprintf("show bgp l2vpn evpn vni %d json", vni)
+}
+
+// EvpnOptionsOf returns the options of a VNI in the output of EvpnShowCommand
+func EvpnOptionsOf(output string) (*pa.EvpnOptions, error) {
+	start, end := strings.Index(output, "{"), strings.LastIndex(output, "}")
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("no JSON object in %q", output)
+	}
+	var vni struct {
+		Rd        string   `json:"rd"`
+		ImportRts []string `json:"importRts"`
+		ExportRts []string `json:"exportRts"`
+	}
+	if err := json.Unmarshal([]byte(output[start:end+1]), &vni); err != nil {
+		return nil, err
+	}
+	return &pa.EvpnOptions{Rd: vni.Rd, ImportRts: vni.ImportRts, ExportRts: vni.ExportRts}, nil
+}
@@ -19,10 +19,10 @@ import (
 )
 
 const (
-	network        = "tcp"
-	password       = "opi"
-	defaultAddress = "localhost"
-	timeout        = 10 * time.Second
+	network         = "tcp"
+	defaultPassword = "opi"
+	defaultAddress  = "localhost"
+	timeout         = 10 * time.Second
 )
 
 // Ports defined here https://docs.frrouting.org/en/latest/setup.html#servicess
@@ -58,19 +58,20 @@ type Frr interface {
 
 // FrrWrapper wrapper for Frr package
 type FrrWrapper struct {
-	address string
-	tracer  trace.Tracer
+	address  string
+	password string
+	tracer   trace.Tracer
 }
 
-// NewFrrWrapper creates initialized instance of FrrWrapper with default address
+// NewFrrWrapper creates initialized instance of FrrWrapper with default address and password
 func NewFrrWrapper() *FrrWrapper {
-	return NewFrrWrapperWithArgs(defaultAddress)
+	return NewFrrWrapperWithArgs(defaultAddress, defaultPassword)
 }
 
 // NewFrrWrapperWithArgs creates initialized instance of FrrWrapper
-func NewFrrWrapperWithArgs(address string) *FrrWrapper {
+func NewFrrWrapperWithArgs(address, password string) *FrrWrapper {
 	// default tracer name is good for now
-	return &FrrWrapper{address: address, tracer: otel.Tracer("")}
+	return &FrrWrapper{address: address, password: password, tracer: otel.Tracer("")}
 }
 
 // build time check that struct implements interface
@@ -82,7 +83,7 @@ func (n *FrrWrapper) Password(conn *telnet.Conn, delim string) error {
 	if err != nil {
 		return err
 	}
-	_, err = conn.Write([]byte(password + "\n"))
+	_, err = conn.Write([]byte(n.password + "\n"))
 	if err != nil {
 		return err
 	}
@@ -152,29 +153,53 @@ func (n *FrrWrapper) TelnetDialAndCommunicate(ctx context.Context, command strin
 		)
 	}
 
-	// new connection every time
-	conn, err := telnet.DialTimeout(network, fmt.Sprintf("%s:%d", n.address, port), timeout)
+	session := sessionFrom(ctx)
+	if session == nil {
+		// new connection every time
+		conn, err := n.dial(port)
+		if err != nil {
+			return "", err
+		}
+		defer func(t *telnet.Conn) { _ = t.Close() }(conn)
+		return n.MultiLineCmd(conn, command)
+	}
+	address := fmt.Sprintf("%s:%d", n.address, port)
+	conn, err := session.frrConn(address, func() (*telnet.Conn, error) { return n.dial(port) })
 	if err != nil {
 		return "", err
 	}
-	defer func(t *telnet.Conn) { _ = t.Close() }(conn)
-
-	conn.SetUnixWriteMode(true)
-
 	err = conn.SetWriteDeadline(time.Now().Add(timeout))
 	if err != nil {
+		session.dropFrrConn(address)
 		return "", err
 	}
-
-	err = n.Password(conn, ">")
+	// return to privileged mode, in case a previous command of the session did not
+	data, err := n.MultiLineCmd(conn, "end\n"+command)
 	if err != nil {
-		return "", err
+		session.dropFrrConn(address)
 	}
+	return data, err
+}
 
-	err = n.EnterPrivileged(conn)
+// dial connects to the daemon at port and enters privileged mode
+func (n *FrrWrapper) dial(port int) (*telnet.Conn, error) {
+	conn, err := telnet.DialTimeout(network, fmt.Sprintf("%s:%d", n.address, port), timeout)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return n.MultiLineCmd(conn, command)
+	conn.SetUnixWriteMode(true)
+
+	err = conn.SetWriteDeadline(time.Now().Add(timeout))
+	if err == nil {
+		err = n.Password(conn, ">")
+	}
+	if err == nil {
+		err = n.EnterPrivileged(conn)
+	}
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return conn, nil
 }
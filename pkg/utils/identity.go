@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package utils has some utility functions and interfaces
+package utils
+
+import (
+	"context"
+	"crypto/x509"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// UserHeader names the user on whose behalf a client calls. It is not
+// authenticated and only informs the audit log, see PeerSubject.
+const UserHeader = "x-opi-user"
+
+// PeerCertificate returns the verified client certificate,
+// or nil for connections without mutual TLS
+func PeerCertificate(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return info.State.VerifiedChains[0][0]
+}
+
+// PeerSubject returns the subject of the verified client certificate,
+// or an empty string for connections without mutual TLS
+func PeerSubject(ctx context.Context) string {
+	if cert := PeerCertificate(ctx); cert != nil {
+		return cert.Subject.String()
+	}
+	return ""
+}
+
+// PeerAddress returns the network address of the client
+func PeerAddress(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
+
+// ClaimedUser returns the user named by the client in the request metadata
+func ClaimedUser(ctx context.Context) string {
+	if values := metadata.ValueFromIncomingContext(ctx, UserHeader); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
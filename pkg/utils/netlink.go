@@ -7,14 +7,21 @@ package utils
 import (
 	"context"
 	"net"
+	"path/filepath"
+	"strings"
 
 	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
 
+// RouteProtocol marks the routes installed by the bridge, named
+// evpn-gw-br by an entry in /etc/iproute2/rt_protos
+const RouteProtocol = netlink.RouteProtocol(108)
+
 // Netlink represents limited subset of functions from netlink package
 type Netlink interface {
 	LinkByName(context.Context, string) (netlink.Link, error)
@@ -28,11 +35,22 @@ type Netlink interface {
 	LinkSetDown(context.Context, netlink.Link) error
 	LinkSetMaster(context.Context, netlink.Link, netlink.Link) error
 	LinkSetNoMaster(context.Context, netlink.Link) error
+	LinkSetBondSlave(context.Context, netlink.Link, *netlink.Bond) error
+	LinkSetNetns(context.Context, netlink.Link, string) error
 	BridgeVlanAdd(context.Context, netlink.Link, uint16, bool, bool, bool, bool) error
 	BridgeVlanDel(context.Context, netlink.Link, uint16, bool, bool, bool, bool) error
+	LinkSetBrNeighSuppress(context.Context, netlink.Link, bool) error
+	LinkGetBrPortFlags(context.Context, netlink.Link) (*BridgePortFlags, error)
+	LinkSetBrVlanTunnel(context.Context, netlink.Link, bool) error
+	BridgeVlanTunnelAdd(context.Context, netlink.Link, uint16, uint32) error
+	BridgeVlanTunnelDel(context.Context, netlink.Link, uint16, uint32) error
+	RouteAdd(context.Context, *netlink.Route) error
+	RouteDel(context.Context, *netlink.Route) error
+	LinkSubscribe(context.Context, chan<- netlink.LinkUpdate, <-chan struct{}) error
 }
 
-// NetlinkWrapper wrapper for netlink package
+// NetlinkWrapper wrapper for netlink package, operations share the
+// netlink socket of the Session of their context, if there is one
 type NetlinkWrapper struct {
 	tracer trace.Tracer
 }
@@ -50,7 +68,7 @@ func (n *NetlinkWrapper) LinkByName(ctx context.Context, name string) (netlink.L
 	_, childSpan := n.tracer.Start(ctx, "netlink.LinkByName")
 	childSpan.SetAttributes(attribute.String("link.name", name))
 	defer childSpan.End()
-	return netlink.LinkByName(name)
+	return sessionFrom(ctx).netlinkHandle().LinkByName(name)
 }
 
 // LinkModify is a wrapper for netlink.LinkModify
@@ -58,7 +76,7 @@ func (n *NetlinkWrapper) LinkModify(ctx context.Context, link netlink.Link) erro
 	_, childSpan := n.tracer.Start(ctx, "netlink.LinkModify")
 	childSpan.SetAttributes(attribute.String("link.name", link.Attrs().Name))
 	defer childSpan.End()
-	return netlink.LinkModify(link)
+	return sessionFrom(ctx).netlinkHandle().LinkModify(link)
 }
 
 // LinkSetHardwareAddr is a wrapper for netlink.LinkSetHardwareAddr
@@ -66,7 +84,7 @@ func (n *NetlinkWrapper) LinkSetHardwareAddr(ctx context.Context, link netlink.L
 	_, childSpan := n.tracer.Start(ctx, "netlink.LinkSetHardwareAddr")
 	childSpan.SetAttributes(attribute.String("link.name", link.Attrs().Name))
 	defer childSpan.End()
-	return netlink.LinkSetHardwareAddr(link, hwaddr)
+	return sessionFrom(ctx).netlinkHandle().LinkSetHardwareAddr(link, hwaddr)
 }
 
 // AddrAdd is a wrapper for netlink.AddrAdd
@@ -74,7 +92,7 @@ func (n *NetlinkWrapper) AddrAdd(ctx context.Context, link netlink.Link, addr *n
 	_, childSpan := n.tracer.Start(ctx, "netlink.AddrAdd")
 	childSpan.SetAttributes(attribute.String("link.name", link.Attrs().Name))
 	defer childSpan.End()
-	return netlink.AddrAdd(link, addr)
+	return sessionFrom(ctx).netlinkHandle().AddrAdd(link, addr)
 }
 
 // AddrDel is a wrapper for netlink.AddrDel
@@ -82,7 +100,7 @@ func (n *NetlinkWrapper) AddrDel(ctx context.Context, link netlink.Link, addr *n
 	_, childSpan := n.tracer.Start(ctx, "netlink.AddrDel")
 	childSpan.SetAttributes(attribute.String("link.name", link.Attrs().Name))
 	defer childSpan.End()
-	return netlink.AddrDel(link, addr)
+	return sessionFrom(ctx).netlinkHandle().AddrDel(link, addr)
 }
 
 // LinkAdd is a wrapper for netlink.LinkAdd
@@ -90,7 +108,7 @@ func (n *NetlinkWrapper) LinkAdd(ctx context.Context, link netlink.Link) error {
 	_, childSpan := n.tracer.Start(ctx, "netlink.LinkAdd")
 	childSpan.SetAttributes(attribute.String("link.name", link.Attrs().Name))
 	defer childSpan.End()
-	return netlink.LinkAdd(link)
+	return sessionFrom(ctx).netlinkHandle().LinkAdd(link)
 }
 
 // LinkDel is a wrapper for netlink.LinkDel
@@ -98,7 +116,7 @@ func (n *NetlinkWrapper) LinkDel(ctx context.Context, link netlink.Link) error {
 	_, childSpan := n.tracer.Start(ctx, "netlink.LinkDel")
 	childSpan.SetAttributes(attribute.String("link.name", link.Attrs().Name))
 	defer childSpan.End()
-	return netlink.LinkDel(link)
+	return sessionFrom(ctx).netlinkHandle().LinkDel(link)
 }
 
 // LinkSetUp is a wrapper for netlink.LinkSetUp
@@ -106,7 +124,7 @@ func (n *NetlinkWrapper) LinkSetUp(ctx context.Context, link netlink.Link) error
 	_, childSpan := n.tracer.Start(ctx, "netlink.LinkSetUp")
 	childSpan.SetAttributes(attribute.String("link.name", link.Attrs().Name))
 	defer childSpan.End()
-	return netlink.LinkSetUp(link)
+	return sessionFrom(ctx).netlinkHandle().LinkSetUp(link)
 }
 
 // LinkSetDown is a wrapper for netlink.LinkSetDown
@@ -114,7 +132,7 @@ func (n *NetlinkWrapper) LinkSetDown(ctx context.Context, link netlink.Link) err
 	_, childSpan := n.tracer.Start(ctx, "netlink.LinkSetDown")
 	childSpan.SetAttributes(attribute.String("link.name", link.Attrs().Name))
 	defer childSpan.End()
-	return netlink.LinkSetDown(link)
+	return sessionFrom(ctx).netlinkHandle().LinkSetDown(link)
 }
 
 // LinkSetMaster is a wrapper for netlink.LinkSetMaster
@@ -122,7 +140,7 @@ func (n *NetlinkWrapper) LinkSetMaster(ctx context.Context, link, master netlink
 	_, childSpan := n.tracer.Start(ctx, "netlink.LinkSetMaster")
 	childSpan.SetAttributes(attribute.String("link.name", link.Attrs().Name))
 	defer childSpan.End()
-	return netlink.LinkSetMaster(link, master)
+	return sessionFrom(ctx).netlinkHandle().LinkSetMaster(link, master)
 }
 
 // LinkSetNoMaster is a wrapper for netlink.LinkSetNoMaster
@@ -130,7 +148,33 @@ func (n *NetlinkWrapper) LinkSetNoMaster(ctx context.Context, link netlink.Link)
 	_, childSpan := n.tracer.Start(ctx, "netlink.LinkSetNoMaster")
 	childSpan.SetAttributes(attribute.String("link.name", link.Attrs().Name))
 	defer childSpan.End()
-	return netlink.LinkSetNoMaster(link)
+	return sessionFrom(ctx).netlinkHandle().LinkSetNoMaster(link)
+}
+
+// LinkSetBondSlave is a wrapper for netlink.LinkSetBondSlave, which
+// enslaves through an ioctl and not the netlink socket of the Session
+func (n *NetlinkWrapper) LinkSetBondSlave(ctx context.Context, link netlink.Link, master *netlink.Bond) error {
+	_, childSpan := n.tracer.Start(ctx, "netlink.LinkSetBondSlave")
+	childSpan.SetAttributes(attribute.String("link.name", link.Attrs().Name))
+	defer childSpan.End()
+	return netlink.LinkSetBondSlave(link, master)
+}
+
+// LinkSetNetns is a wrapper for netlink.LinkSetNsFd, it moves the link into
+// the network namespace of a name below /var/run/netns or of a path
+func (n *NetlinkWrapper) LinkSetNetns(ctx context.Context, link netlink.Link, ns string) error {
+	_, childSpan := n.tracer.Start(ctx, "netlink.LinkSetNsFd")
+	childSpan.SetAttributes(attribute.String("link.name", link.Attrs().Name), attribute.String("netns", ns))
+	defer childSpan.End()
+	if !strings.Contains(ns, "/") {
+		ns = filepath.Join("/var/run/netns", ns)
+	}
+	fd, err := unix.Open(ns, unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	defer unix.Close(fd)
+	return sessionFrom(ctx).netlinkHandle().LinkSetNsFd(link, fd)
 }
 
 // BridgeVlanAdd is a wrapper for netlink.BridgeVlanAdd
@@ -138,7 +182,7 @@ func (n *NetlinkWrapper) BridgeVlanAdd(ctx context.Context, link netlink.Link, v
 	_, childSpan := n.tracer.Start(ctx, "netlink.BridgeVlanAdd")
 	childSpan.SetAttributes(attribute.String("link.name", link.Attrs().Name))
 	defer childSpan.End()
-	return netlink.BridgeVlanAdd(link, vid, pvid, untagged, self, master)
+	return sessionFrom(ctx).netlinkHandle().BridgeVlanAdd(link, vid, pvid, untagged, self, master)
 }
 
 // BridgeVlanDel is a wrapper for netlink.BridgeVlanDel
@@ -146,5 +190,73 @@ func (n *NetlinkWrapper) BridgeVlanDel(ctx context.Context, link netlink.Link, v
 	_, childSpan := n.tracer.Start(ctx, "netlink.BridgeVlanDel")
 	childSpan.SetAttributes(attribute.String("link.name", link.Attrs().Name))
 	defer childSpan.End()
-	return netlink.BridgeVlanDel(link, vid, pvid, untagged, self, master)
+	return sessionFrom(ctx).netlinkHandle().BridgeVlanDel(link, vid, pvid, untagged, self, master)
+}
+
+// LinkSetBrNeighSuppress turns ARP and ND suppression of a bridge port on or off,
+// the netlink package has no function for it, so it sends the message itself
+// on the handle of the session
+func (n *NetlinkWrapper) LinkSetBrNeighSuppress(ctx context.Context, link netlink.Link, on bool) error {
+	_, childSpan := n.tracer.Start(ctx, "netlink.LinkSetBrNeighSuppress")
+	childSpan.SetAttributes(attribute.String("link.name", link.Attrs().Name), attribute.Bool("neigh_suppress", on))
+	defer childSpan.End()
+	return setBridgePortFlag(sessionFrom(ctx).netlinkHandle(), link, iflaBrportNeighSuppress, on)
+}
+
+// LinkGetBrPortFlags reads the flags of a bridge port, like netlink.LinkGetProtinfo
+// but including the flags it does not parse
+func (n *NetlinkWrapper) LinkGetBrPortFlags(ctx context.Context, link netlink.Link) (*BridgePortFlags, error) {
+	_, childSpan := n.tracer.Start(ctx, "netlink.LinkGetBrPortFlags")
+	childSpan.SetAttributes(attribute.String("link.name", link.Attrs().Name))
+	defer childSpan.End()
+	return bridgePortFlags(sessionFrom(ctx).netlinkHandle(), link)
+}
+
+// LinkSetBrVlanTunnel turns the VLAN to tunnel id mapping of a bridge port on or off,
+// the netlink package has no function for it
+func (n *NetlinkWrapper) LinkSetBrVlanTunnel(ctx context.Context, link netlink.Link, on bool) error {
+	_, childSpan := n.tracer.Start(ctx, "netlink.LinkSetBrVlanTunnel")
+	childSpan.SetAttributes(attribute.String("link.name", link.Attrs().Name), attribute.Bool("vlan_tunnel", on))
+	defer childSpan.End()
+	return setBridgePortFlag(sessionFrom(ctx).netlinkHandle(), link, iflaBrportVlanTunnel, on)
+}
+
+// BridgeVlanTunnelAdd maps a VLAN of a bridge port to a tunnel id,
+// the netlink package has no function for it
+func (n *NetlinkWrapper) BridgeVlanTunnelAdd(ctx context.Context, link netlink.Link, vid uint16, tunid uint32) error {
+	_, childSpan := n.tracer.Start(ctx, "netlink.BridgeVlanTunnelAdd")
+	childSpan.SetAttributes(attribute.String("link.name", link.Attrs().Name), attribute.Int("vlan.id", int(vid)), attribute.Int("tunnel.id", int(tunid)))
+	defer childSpan.End()
+	return bridgeVlanTunnelModify(sessionFrom(ctx).netlinkHandle(), unix.RTM_SETLINK, link, vid, tunid)
+}
+
+// BridgeVlanTunnelDel removes the mapping of a VLAN of a bridge port to a tunnel id
+func (n *NetlinkWrapper) BridgeVlanTunnelDel(ctx context.Context, link netlink.Link, vid uint16, tunid uint32) error {
+	_, childSpan := n.tracer.Start(ctx, "netlink.BridgeVlanTunnelDel")
+	childSpan.SetAttributes(attribute.String("link.name", link.Attrs().Name), attribute.Int("vlan.id", int(vid)), attribute.Int("tunnel.id", int(tunid)))
+	defer childSpan.End()
+	return bridgeVlanTunnelModify(sessionFrom(ctx).netlinkHandle(), unix.RTM_DELLINK, link, vid, tunid)
+}
+
+// RouteAdd is a wrapper for netlink.RouteAdd
+func (n *NetlinkWrapper) RouteAdd(ctx context.Context, route *netlink.Route) error {
+	_, childSpan := n.tracer.Start(ctx, "netlink.RouteAdd")
+	childSpan.SetAttributes(attribute.String("route.dst", route.Dst.String()), attribute.Int("route.table", route.Table))
+	defer childSpan.End()
+	return sessionFrom(ctx).netlinkHandle().RouteAdd(route)
+}
+
+// RouteDel is a wrapper for netlink.RouteDel
+func (n *NetlinkWrapper) RouteDel(ctx context.Context, route *netlink.Route) error {
+	_, childSpan := n.tracer.Start(ctx, "netlink.RouteDel")
+	childSpan.SetAttributes(attribute.String("route.dst", route.Dst.String()), attribute.Int("route.table", route.Table))
+	defer childSpan.End()
+	return sessionFrom(ctx).netlinkHandle().RouteDel(route)
+}
+
+// LinkSubscribe is a wrapper for netlink.LinkSubscribe
+func (n *NetlinkWrapper) LinkSubscribe(ctx context.Context, ch chan<- netlink.LinkUpdate, done <-chan struct{}) error {
+	_, childSpan := n.tracer.Start(ctx, "netlink.LinkSubscribe")
+	defer childSpan.End()
+	return netlink.LinkSubscribe(ch, done)
 }
@@ -9,20 +9,20 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
 )
 
 // ExtractPagination fetches pagination from the database, calculate size and offset
 func ExtractPagination(pageSize int32, pageToken string, pagination map[string]int) (size int, offset int, err error) {
-	const (
-		maxPageSize     = 250
-		defaultPageSize = 50
-	)
+	maxPageSize := config.GetConfig().Pagination.MaxPageSize
+	defaultPageSize := config.GetConfig().Pagination.DefaultPageSize
 	switch {
 	case pageSize < 0:
 		return -1, -1, status.Error(codes.InvalidArgument, "negative PageSize is not allowed")
 	case pageSize == 0:
 		size = defaultPageSize
-	case pageSize > maxPageSize:
+	case int(pageSize) > maxPageSize:
 		size = maxPageSize
 	default:
 		size = int(pageSize)
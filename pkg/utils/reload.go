@@ -0,0 +1,236 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package utils has some utility functions and interfaces
+package utils
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// CertReloader serves the certificate, key and CA of TLSConfig and loads
+// them again when the files change, so certificates rotate without restart
+type CertReloader struct {
+	config          TLSConfig
+	loadX509KeyPair func(string, string) (tls.Certificate, error)
+	readFile        func(string) ([]byte, error)
+	stat            func(string) (os.FileInfo, error)
+
+	mu    sync.RWMutex
+	cert  *tls.Certificate
+	pool  *x509.CertPool
+	files map[string]time.Time
+}
+
+// NewCertReloader loads the files of config, an empty CA path disables
+// client certificates
+func NewCertReloader(config TLSConfig) (*CertReloader, error) {
+	return newCertReloader(config, tls.LoadX509KeyPair, os.ReadFile, os.Stat)
+}
+
+func newCertReloader(config TLSConfig,
+	loadX509KeyPair func(string, string) (tls.Certificate, error),
+	readFile func(string) ([]byte, error),
+	stat func(string) (os.FileInfo, error),
+) (*CertReloader, error) {
+	r := &CertReloader{
+		config:          config,
+		loadX509KeyPair: loadX509KeyPair,
+		readFile:        readFile,
+		stat:            stat,
+	}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Watch checks the files every interval until ctx is done and reloads them
+// after a change. Files which fail to load, e.g. while they are replaced one
+// by one, keep the previous certificates in use and are retried.
+func (r *CertReloader) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !r.changed() {
+				continue
+			}
+			if err := r.reload(); err != nil {
+				log.Printf("Failed to reload TLS certificates, keeping the previous ones: %v", err)
+				continue
+			}
+			log.Printf("Reloaded TLS certificate %v", r.config.ServerCertPath)
+		}
+	}
+}
+
+// ServerOption returns a server option serving the current certificates
+func (r *CertReloader) ServerOption() grpc.ServerOption {
+	return grpc.Creds(credentials.NewTLS(r.serverTLSConfig([]string{"h2"})))
+}
+
+// ServerTLSConfig returns an HTTPS server configuration serving the current
+// certificates, it requires client certificates when a CA is configured
+func (r *CertReloader) ServerTLSConfig() *tls.Config {
+	return r.serverTLSConfig([]string{"h2", "http/1.1"})
+}
+
+// serverTLSConfig builds the configuration per connection, which has to
+// repeat the ALPN protocols, since they are not taken from the outer one
+func (r *CertReloader) serverTLSConfig(nextProtos []string) *tls.Config {
+	return &tls.Config{
+		NextProtos: nextProtos,
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+			c := &tls.Config{
+				Certificates: []tls.Certificate{*r.cert},
+				NextProtos:   nextProtos,
+				MinVersion:   tls.VersionTLS12,
+				CipherSuites: []uint16{
+					tls.TLS_AES_256_GCM_SHA384,
+					tls.TLS_AES_128_GCM_SHA256,
+					tls.TLS_CHACHA20_POLY1305_SHA256,
+					tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+					tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+					tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+					tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+				},
+			}
+			if r.pool != nil {
+				c.ClientAuth = tls.RequireAndVerifyClientCert
+				c.ClientCAs = r.pool
+			}
+			return c, nil
+		},
+	}
+}
+
+// ClientTLSConfig returns a configuration for clients of the server serving
+// the certificates of r, like the HTTP gateway. The client presents the
+// current certificate of identity, and the server is verified against the
+// current CA and the name returned by ServerName.
+func (r *CertReloader) ClientTLSConfig(identity *CertReloader) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: r.ServerName(),
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			identity.mu.RLock()
+			defer identity.mu.RUnlock()
+			return identity.cert, nil
+		},
+		// the standard verification uses a fixed CA pool,
+		// the server is verified against the current one below
+		InsecureSkipVerify: true, //nolint:gosec
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) == 0 {
+				return errors.New("server presented no certificate")
+			}
+			r.mu.RLock()
+			pool := r.pool
+			r.mu.RUnlock()
+			opts := x509.VerifyOptions{
+				Roots:         pool,
+				DNSName:       r.ServerName(),
+				Intermediates: x509.NewCertPool(),
+			}
+			for _, cert := range cs.PeerCertificates[1:] {
+				opts.Intermediates.AddCert(cert)
+			}
+			_, err := cs.PeerCertificates[0].Verify(opts)
+			return err
+		},
+	}
+}
+
+// ServerName returns the first DNS name or IP address of the current
+// certificate, or localhost when it has neither
+func (r *CertReloader) ServerName() string {
+	cert, err := r.Leaf()
+	if err != nil {
+		return "localhost"
+	}
+	if len(cert.DNSNames) > 0 {
+		return cert.DNSNames[0]
+	}
+	if len(cert.IPAddresses) > 0 {
+		return cert.IPAddresses[0].String()
+	}
+	return "localhost"
+}
+
+// Leaf returns the parsed current certificate
+func (r *CertReloader) Leaf() (*x509.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return x509.ParseCertificate(r.cert.Certificate[0])
+}
+
+// reload loads all files and replaces the certificates only if all of them are valid
+func (r *CertReloader) reload() error {
+	files := r.modTimes()
+	cert, err := r.loadX509KeyPair(r.config.ServerCertPath, r.config.ServerKeyPath)
+	if err != nil {
+		return err
+	}
+	var pool *x509.CertPool
+	if r.config.CaCertPath != "" {
+		log.Println("Loading client ca certificate:", r.config.CaCertPath)
+		caCert, err := r.readFile(r.config.CaCertPath)
+		if err != nil {
+			return fmt.Errorf("failed to read certificate: %v. error: %v", r.config.CaCertPath, err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return fmt.Errorf("failed to add client CA's certificate: %v", r.config.CaCertPath)
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert = &cert
+	r.pool = pool
+	r.files = files
+	return nil
+}
+
+// changed tells whether any file was modified since the last successful load
+func (r *CertReloader) changed() bool {
+	files := r.modTimes()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for path, modTime := range files {
+		if !r.files[path].Equal(modTime) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *CertReloader) modTimes() map[string]time.Time {
+	files := make(map[string]time.Time)
+	for _, path := range []string{r.config.ServerCertPath, r.config.ServerKeyPath, r.config.CaCertPath} {
+		if path == "" {
+			continue
+		}
+		if info, err := r.stat(path); err == nil {
+			files[path] = info.ModTime()
+		}
+	}
+	return files
+}
@@ -0,0 +1,257 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package utils has some utility functions and interfaces
+package utils
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// testCA issues certificates for the reload tests
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue writes a certificate and key for name, usable as server and client, to dir
+func (ca *testCA) issue(t *testing.T, dir, name string, serial int64) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPath := filepath.Join(dir, name+".crt")
+	keyPath := filepath.Join(dir, name+".key")
+	writeFile(t, certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	writeFile(t, keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+	return certPath, keyPath
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// make sure the change is visible even on file systems with coarse timestamps
+	later := time.Now().Add(time.Duration(len(data)) * time.Millisecond)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// handshake connects a client of clientCerts to a server of serverCerts and
+// returns the serial number of the certificate the server presented, or the
+// handshake error of either side
+func handshake(t *testing.T, serverCerts, clientCerts *CertReloader) (int64, error) {
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverCerts.ServerTLSConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer conn.Close()
+		serverErr <- conn.(*tls.Conn).Handshake()
+	}()
+	conn, err := tls.Dial("tcp", listener.Addr().String(), serverCerts.ClientTLSConfig(clientCerts))
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	if err := <-serverErr; err != nil {
+		return 0, err
+	}
+	return conn.ConnectionState().PeerCertificates[0].SerialNumber.Int64(), nil
+}
+
+func TestCertReloader_Reload(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	caPath := filepath.Join(dir, "ca.crt")
+	writeFile(t, caPath, ca.pem)
+	serverCert, serverKey := ca.issue(t, dir, "server", 2)
+	clientCert, clientKey := ca.issue(t, dir, "client", 3)
+
+	serverCerts, err := NewCertReloader(TLSConfig{ServerCertPath: serverCert, ServerKeyPath: serverKey, CaCertPath: caPath})
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientCerts, err := NewCertReloader(TLSConfig{ServerCertPath: clientCert, ServerKeyPath: clientKey, CaCertPath: caPath})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if serial, err := handshake(t, serverCerts, clientCerts); err != nil || serial != 2 {
+		t.Fatal("Expect server certificate 2, received", serial, err)
+	}
+	// the server is verified against the address in its certificate
+	if name := serverCerts.ClientTLSConfig(clientCerts).ServerName; name != "127.0.0.1" {
+		t.Error("Expect server name 127.0.0.1, received", name)
+	}
+	if serverCerts.changed() {
+		t.Error("Expect no change before rotation")
+	}
+
+	// rotate the server certificate
+	ca.issue(t, dir, "server", 4)
+	if !serverCerts.changed() {
+		t.Fatal("Expect change after rotation")
+	}
+	if err := serverCerts.reload(); err != nil {
+		t.Fatal(err)
+	}
+	if serial, err := handshake(t, serverCerts, clientCerts); err != nil || serial != 4 {
+		t.Error("Expect rotated server certificate 4, received", serial, err)
+	}
+
+	// a broken file keeps the previous certificate
+	writeFile(t, serverKey, []byte("garbage"))
+	if err := serverCerts.reload(); err == nil {
+		t.Error("Expect error for broken key")
+	}
+	if serial, err := handshake(t, serverCerts, clientCerts); err != nil || serial != 4 {
+		t.Error("Expect previous server certificate 4, received", serial, err)
+	}
+
+	// a client of another CA is rejected
+	otherCA := newTestCA(t)
+	otherCert, otherKey := otherCA.issue(t, t.TempDir(), "other", 5)
+	otherCerts, err := NewCertReloader(TLSConfig{ServerCertPath: otherCert, ServerKeyPath: otherKey, CaCertPath: caPath})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := handshake(t, serverCerts, otherCerts); err == nil {
+		t.Error("Expect client of another CA to be rejected")
+	}
+}
+
+func TestServer_ParseGatewayTLSFiles(t *testing.T) {
+	tests := map[string]struct {
+		tlsStr    string
+		expectErr bool
+		caCert    string
+	}{
+		"cert and key": {
+			tlsStr:    "a:b",
+			expectErr: false,
+			caCert:    "",
+		},
+		"cert, key and CA": {
+			tlsStr:    "a:b:c",
+			expectErr: false,
+			caCert:    "c",
+		},
+		"only cert": {
+			tlsStr:    "a",
+			expectErr: true,
+		},
+		"empty key": {
+			tlsStr:    "a:",
+			expectErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			config, err := ParseGatewayTLSFiles(tt.tlsStr)
+			if (err != nil) != tt.expectErr {
+				t.Error("Expect error", tt.expectErr, "received", err)
+			}
+			if !tt.expectErr && config.CaCertPath != tt.caCert {
+				t.Error("Expect", tt.caCert, "received", config.CaCertPath)
+			}
+		})
+	}
+}
+
+func TestServer_ParseClientTLSFiles(t *testing.T) {
+	tests := map[string]struct {
+		tlsStr    string
+		expectErr bool
+	}{
+		"cert and key": {
+			tlsStr:    "a:b",
+			expectErr: false,
+		},
+		"cert, key and CA": {
+			tlsStr:    "a:b:c",
+			expectErr: true,
+		},
+		"empty cert": {
+			tlsStr:    ":b",
+			expectErr: true,
+		},
+		"empty key": {
+			tlsStr:    "a:",
+			expectErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			config, err := ParseClientTLSFiles(tt.tlsStr)
+			if (err != nil) != tt.expectErr {
+				t.Error("Expect error", tt.expectErr, "received", err)
+			}
+			if !tt.expectErr && (config.ServerCertPath != "a" || config.ServerKeyPath != "b") {
+				t.Error("Expect a and b, received", config)
+			}
+		})
+	}
+}
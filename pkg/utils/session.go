@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package utils has some utility functions and interfaces
+package utils
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/vishvananda/netlink"
+	"github.com/ziutek/telnet"
+)
+
+// Session shares a netlink socket and FRR telnet connections between all
+// operations executed with its context, instead of opening new ones for
+// every operation. It is meant for batches of changes.
+type Session struct {
+	mu     sync.Mutex
+	handle *netlink.Handle
+	conns  map[string]*telnet.Conn
+}
+
+type sessionKey struct{}
+
+// WithSession returns a context sharing connections through the returned
+// Session, which has to be closed when the batch is done
+func WithSession(ctx context.Context) (context.Context, *Session) {
+	session := &Session{conns: make(map[string]*telnet.Conn)}
+	return context.WithValue(ctx, sessionKey{}, session), session
+}
+
+// sessionFrom returns the Session of ctx or nil
+func sessionFrom(ctx context.Context) *Session {
+	session, _ := ctx.Value(sessionKey{}).(*Session)
+	return session
+}
+
+// netlinkHandle returns the shared handle, opened on first use. Without
+// session, or when the socket can not be opened, it returns a handle which
+// opens a socket per operation, like the netlink package functions.
+func (s *Session) netlinkHandle() *netlink.Handle {
+	if s == nil {
+		return &netlink.Handle{}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.handle == nil {
+		handle, err := netlink.NewHandle()
+		if err != nil {
+			log.Printf("Failed to open shared netlink socket, using one per operation: %v", err)
+			return &netlink.Handle{}
+		}
+		s.handle = handle
+	}
+	return s.handle
+}
+
+// frrConn returns the shared connection to address, opened by dial on first use
+func (s *Session) frrConn(address string, dial func() (*telnet.Conn, error)) (*telnet.Conn, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if conn, ok := s.conns[address]; ok {
+		return conn, nil
+	}
+	conn, err := dial()
+	if err != nil {
+		return nil, err
+	}
+	s.conns[address] = conn
+	return conn, nil
+}
+
+// dropFrrConn closes a shared connection which failed, so the next command reconnects
+func (s *Session) dropFrrConn(address string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if conn, ok := s.conns[address]; ok {
+		_ = conn.Close()
+		delete(s.conns, address)
+	}
+}
+
+// Close releases the shared netlink socket and FRR connections
+func (s *Session) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.handle != nil {
+		s.handle.Delete()
+		s.handle = nil
+	}
+	for address, conn := range s.conns {
+		_ = conn.Close()
+		delete(s.conns, address)
+	}
+}
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package utils has some utility functions and interfaces
+package utils
+
+import (
+	"github.com/philippgille/gokv"
+)
+
+// keyedStore is implemented by stores able to enumerate their keys, like the store of the daemon
+type keyedStore interface {
+	Keys() []string
+}
+
+// StoreKeys returns all keys of the store, none if the store is not able
+// to enumerate them
+func StoreKeys(store gokv.Store) []string {
+	if s, ok := store.(keyedStore); ok {
+		return s.Keys()
+	}
+	return nil
+}
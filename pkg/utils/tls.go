@@ -9,7 +9,6 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
-	"log"
 	"os"
 	"strings"
 
@@ -56,6 +55,47 @@ func ParseTLSFiles(tlsFiles string) (TLSConfig, error) {
 	return tls, nil
 }
 
+// ParseGatewayTLSFiles parses a string containing server certificate and
+// server key, optionally followed by a CA certificate to require and verify
+// client certificates, separated by `:`
+func ParseGatewayTLSFiles(tlsFiles string) (TLSConfig, error) {
+	files := strings.Split(tlsFiles, ":")
+	if len(files) == 3 {
+		return ParseTLSFiles(tlsFiles)
+	}
+	if len(files) != 2 {
+		return TLSConfig{}, errors.New("wrong number of path entries provided." +
+			"Expect <server cert>:<server key>[:<ca cert>] are provided separated by `:`")
+	}
+	const emptyPathErr = "empty %s path is not allowed"
+	if files[0] == "" {
+		return TLSConfig{}, fmt.Errorf(emptyPathErr, "server cert")
+	}
+	if files[1] == "" {
+		return TLSConfig{}, fmt.Errorf(emptyPathErr, "server key")
+	}
+	return TLSConfig{ServerCertPath: files[0], ServerKeyPath: files[1]}, nil
+}
+
+// ParseClientTLSFiles parses a string containing client certificate and
+// client key separated by `:`, they are returned as the certificate and key
+// of TLSConfig
+func ParseClientTLSFiles(tlsFiles string) (TLSConfig, error) {
+	files := strings.Split(tlsFiles, ":")
+	if len(files) != 2 {
+		return TLSConfig{}, errors.New("wrong number of path entries provided." +
+			"Expect <client cert>:<client key> are provided separated by `:`")
+	}
+	const emptyPathErr = "empty %s path is not allowed"
+	if files[0] == "" {
+		return TLSConfig{}, fmt.Errorf(emptyPathErr, "client cert")
+	}
+	if files[1] == "" {
+		return TLSConfig{}, fmt.Errorf(emptyPathErr, "client key")
+	}
+	return TLSConfig{ServerCertPath: files[0], ServerKeyPath: files[1]}, nil
+}
+
 // SetupTLSCredentials returns a service options to enable TLS for gRPC server
 func SetupTLSCredentials(config TLSConfig) (grpc.ServerOption, error) {
 	return setupTLSCredentials(config, tls.LoadX509KeyPair, os.ReadFile)
@@ -65,37 +105,42 @@ func setupTLSCredentials(config TLSConfig,
 	loadX509KeyPair func(string, string) (tls.Certificate, error),
 	readFile func(string) ([]byte, error),
 ) (grpc.ServerOption, error) {
-	serverCert, err := loadX509KeyPair(config.ServerCertPath, config.ServerKeyPath)
+	r, err := newCertReloader(config, loadX509KeyPair, readFile, os.Stat)
+	if err != nil {
+		return nil, err
+	}
+	return r.ServerOption(), nil
+}
+
+// SetupTLSClientCredentials returns a dial option to connect to a TLS enabled
+// gRPC server, server cert and key of the config are used as client identity
+func SetupTLSClientCredentials(config TLSConfig) (grpc.DialOption, error) {
+	return setupTLSClientCredentials(config, tls.LoadX509KeyPair, os.ReadFile)
+}
+
+func setupTLSClientCredentials(config TLSConfig,
+	loadX509KeyPair func(string, string) (tls.Certificate, error),
+	readFile func(string) ([]byte, error),
+) (grpc.DialOption, error) {
+	clientCert, err := loadX509KeyPair(config.ServerCertPath, config.ServerKeyPath)
 	if err != nil {
 		return nil, err
 	}
 
 	c := &tls.Config{
-		Certificates: []tls.Certificate{serverCert},
-		ClientAuth:   tls.RequireAndVerifyClientCert,
+		Certificates: []tls.Certificate{clientCert},
 		MinVersion:   tls.VersionTLS12,
-		CipherSuites: []uint16{
-			tls.TLS_AES_256_GCM_SHA384,
-			tls.TLS_AES_128_GCM_SHA256,
-			tls.TLS_CHACHA20_POLY1305_SHA256,
-			tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
-			tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
-			tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
-			tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
-		},
+		RootCAs:      x509.NewCertPool(),
 	}
 
-	c.ClientCAs = x509.NewCertPool()
-	log.Println("Loading client ca certificate:", config.CaCertPath)
-
-	clientCaCert, err := readFile(config.CaCertPath)
+	caCert, err := readFile(config.CaCertPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read certificate: %v. error: %v", config.CaCertPath, err)
 	}
 
-	if !c.ClientCAs.AppendCertsFromPEM(clientCaCert) {
-		return nil, fmt.Errorf("failed to add client CA's certificate: %v", config.CaCertPath)
+	if !c.RootCAs.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("failed to add server CA's certificate: %v", config.CaCertPath)
 	}
 
-	return grpc.Creds(credentials.NewTLS(c)), nil
+	return grpc.WithTransportCredentials(credentials.NewTLS(c)), nil
 }
@@ -175,3 +175,64 @@ func TestServer_SetupTLSCredentials(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_SetupTLSClientCredentials(t *testing.T) {
+	tests := map[string]struct {
+		config      TLSConfig
+		expectErr   bool
+		loadKeyErr  error
+		readFileErr error
+		validCaCert bool
+	}{
+		"failed to load key pair": {
+			expectErr:   true,
+			loadKeyErr:  errors.New("Key load failed"),
+			readFileErr: nil,
+			validCaCert: true,
+		},
+		"failed to read file": {
+			expectErr:   true,
+			loadKeyErr:  nil,
+			readFileErr: errors.New("Failed to read file"),
+			validCaCert: true,
+		},
+		"invalid CA certificate": {
+			expectErr:   true,
+			loadKeyErr:  nil,
+			readFileErr: nil,
+			validCaCert: false,
+		},
+		"valid CA certificate": {
+			expectErr:   false,
+			loadKeyErr:  nil,
+			readFileErr: nil,
+			validCaCert: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			caCert := make([]byte, len(validCa))
+			copy(caCert, validCa)
+			if !tt.validCaCert {
+				caCert[0] = caCert[0] - 1
+			}
+
+			out, err := setupTLSClientCredentials(TLSConfig{
+				ServerCertPath: "a",
+				ServerKeyPath:  "b",
+				CaCertPath:     "c",
+			}, func(s1, s2 string) (tls.Certificate, error) {
+				return tls.Certificate{}, tt.loadKeyErr
+			}, func(s string) ([]byte, error) {
+				return caCert, tt.readFileErr
+			})
+
+			if (err != nil) != tt.expectErr {
+				t.Error("Expect error", tt.expectErr, "received", err)
+			}
+			if !tt.expectErr && out == nil {
+				t.Error("Expect not nil dial option, received nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package utils has some utility functions and interfaces
+package utils
+
+import (
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+// bridge vlan tunnel attributes missing in the netlink package, see if_bridge.h
+const (
+	iflaBridgeVlanTunnelInfo = 3
+	iflaBridgeVlanTunnelID   = 1
+	iflaBridgeVlanTunnelVid  = 2
+)
+
+// bridgeVlanTunnelModify maps vid to the tunnel id tunid on a port with vlan_tunnel on,
+// like `bridge vlan add|del dev <link> vid <vid> tunnel_info id <tunid>`
+func bridgeVlanTunnelModify(h *netlink.Handle, cmd int, link netlink.Link, vid uint16, tunid uint32) error {
+	index, err := linkIndex(h, link)
+	if err != nil {
+		return err
+	}
+	req := nl.NewNetlinkRequest(cmd, unix.NLM_F_ACK)
+	msg := nl.NewIfInfomsg(unix.AF_BRIDGE)
+	msg.Index = int32(index)
+	req.AddData(msg)
+	spec := nl.NewRtAttr(unix.IFLA_AF_SPEC, nil)
+	info := spec.AddRtAttr(iflaBridgeVlanTunnelInfo|unix.NLA_F_NESTED, nil)
+	info.AddRtAttr(iflaBridgeVlanTunnelID, nl.Uint32Attr(tunid))
+	info.AddRtAttr(iflaBridgeVlanTunnelVid, nl.Uint16Attr(vid))
+	req.AddData(spec)
+	_, err = req.Execute(unix.NETLINK_ROUTE, 0)
+	return err
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package utils has some utility functions and interfaces
+package utils
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+)
+
+// vxlanTosInherit is the TOS value which copies the TOS of the inner packet
+const vxlanTosInherit = 1
+
+// NewVxlan returns the VXLAN device for vni with the given options,
+// unset options take the defaults of the config
+func NewVxlan(name string, vni uint32, local net.IP, options *pa.VxlanOptions) *netlink.Vxlan {
+	// Example: ip link add vni100 type vxlan local 10.0.0.4 dstport 4789 id 100 nolearning
+	vxlan := &netlink.Vxlan{
+		LinkAttrs: netlink.LinkAttrs{Name: name},
+		VxlanId:   int(vni),
+		Port:      config.GetConfig().Linux.VxlanPort,
+		Learning:  options.GetLearning(),
+		SrcAddr:   local,
+	}
+	if options == nil {
+		return vxlan
+	}
+	if options.DstPort != nil {
+		vxlan.Port = int(options.GetDstPort())
+	}
+	vxlan.TTL = int(options.Ttl)
+	vxlan.TOS = int(options.Tos)
+	if options.TosInherit {
+		vxlan.TOS = vxlanTosInherit
+	}
+	vxlan.UDPCSum = options.UdpCsum
+	vxlan.PortLow = int(options.SrcPortLow)
+	vxlan.PortHigh = int(options.SrcPortHigh)
+	return vxlan
+}
+
+// L2VxlanName returns the name of the VXLAN device carrying the VNI of a LogicalBridge,
+// which is the shared device in the single VXLAN device mode
+func L2VxlanName(vni uint32) string {
+	if linux := config.GetConfig().Linux; linux.VxlanMode == config.SingleVxlan {
+		return linux.VxlanDevice
+	}
+	return fmt.Sprintf("vni%d", vni)
+}
+
+// VxlanOptionsOf returns the options in effect on a VXLAN device,
+// or nil when link is not a VXLAN device
+func VxlanOptionsOf(link netlink.Link) *pa.VxlanOptions {
+	vxlan, ok := link.(*netlink.Vxlan)
+	if !ok {
+		return nil
+	}
+	options := &pa.VxlanOptions{
+		DstPort:     proto.Uint32(uint32(vxlan.Port)),
+		Ttl:         uint32(vxlan.TTL),
+		Tos:         uint32(vxlan.TOS),
+		UdpCsum:     vxlan.UDPCSum,
+		SrcPortLow:  uint32(vxlan.PortLow),
+		SrcPortHigh: uint32(vxlan.PortHigh),
+		Learning:    vxlan.Learning,
+	}
+	if vxlan.TOS == vxlanTosInherit {
+		options.Tos = 0
+		options.TosInherit = true
+	}
+	return options
+}
+
+// ValidateVxlanOptions checks the ranges of the VXLAN options
+func ValidateVxlanOptions(options *pa.VxlanOptions) error {
+	if options == nil {
+		return nil
+	}
+	if options.DstPort != nil && (options.GetDstPort() == 0 || options.GetDstPort() > 65535) {
+		return status.Errorf(codes.InvalidArgument, "vxlan.dst_port value (%d) have to be between 1 and 65535", options.GetDstPort())
+	}
+	if options.Ttl > 255 {
+		return status.Errorf(codes.InvalidArgument, "vxlan.ttl value (%d) have to be between 0 and 255", options.Ttl)
+	}
+	if options.Tos > 255 {
+		return status.Errorf(codes.InvalidArgument, "vxlan.tos value (%d) have to be between 0 and 255", options.Tos)
+	}
+	if options.TosInherit && options.Tos != 0 {
+		return status.Errorf(codes.InvalidArgument, "vxlan.tos (%d) can not be set together with vxlan.tos_inherit", options.Tos)
+	}
+	if options.Tos == vxlanTosInherit {
+		return status.Errorf(codes.InvalidArgument, "vxlan.tos value (%d) is reserved, use vxlan.tos_inherit", options.Tos)
+	}
+	low, high := options.SrcPortLow, options.SrcPortHigh
+	if (low == 0) != (high == 0) || low > high || high > 65535 {
+		return status.Errorf(codes.InvalidArgument, "vxlan.src_port_low (%d) and vxlan.src_port_high (%d) have to be a range within 1 and 65535", low, high)
+	}
+	return nil
+}
@@ -13,14 +13,15 @@ import (
 	"sort"
 	"testing"
 
-	"github.com/philippgille/gokv/gomap"
 	"go.einride.tech/aip/resourcename"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/test/bufconn"
 
 	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
-	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+	"github.com/opiproject/opi-evpn-bridge/pkg/store"
 	"github.com/opiproject/opi-evpn-bridge/pkg/utils/mocks"
 )
 
@@ -66,11 +67,15 @@ func (e *testEnv) Close() {
 }
 
 func newTestEnv(ctx context.Context, t *testing.T) *testEnv {
-	store := gomap.NewStore(gomap.Options{Codec: utils.ProtoCodec{}})
+	// the store of the daemon, which is able to enumerate its keys
+	kvStore, err := store.NewStore(config.DatabaseConfig{Type: config.MemoryStore})
+	if err != nil {
+		t.Fatal(err)
+	}
 	env := &testEnv{}
 	env.mockNetlink = mocks.NewNetlink(t)
 	env.mockFrr = mocks.NewFrr(t)
-	env.opi = NewServerWithArgs(env.mockNetlink, env.mockFrr, store)
+	env.opi = NewServerWithArgs(env.mockNetlink, env.mockFrr, kvStore)
 	conn, err := grpc.DialContext(ctx,
 		"",
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -87,6 +92,7 @@ func dialer(opi *Server) func(context.Context, string) (net.Conn, error) {
 	server := grpc.NewServer()
 
 	pb.RegisterVrfServiceServer(server, opi)
+	pa.RegisterVrfOptionsServiceServer(server, opi)
 
 	go func() {
 		if err := server.Serve(listener); err != nil {
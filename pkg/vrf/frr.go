@@ -8,8 +8,14 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"strings"
 
 	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
 )
 
 func (s *Server) frrCreateVrfRequest(ctx context.Context, in *pb.CreateVrfRequest) error {
@@ -27,10 +33,15 @@ func (s *Server) frrCreateVrfRequest(ctx context.Context, in *pb.CreateVrfReques
 		}
 	}
 	if in.Vrf.Spec.Vni != nil {
+		// set the route distinguisher and route targets from the options of the VRF
+		options, err := s.loadOptions(optionsName(in.Vrf.Name))
+		if err != nil {
+			return err
+		}
 		// TODO: add "bgp router-id <vrf-loopback>" based on in.Vrf.Spec.LoopbackIpPrefix.Addr.GetV4Addr()
 		data, err := s.frr.FrrBgpCmd(ctx, fmt.Sprintf(
 			`configure terminal
-			router bgp 65000 vrf %s
+			router bgp %d vrf %s
 			no bgp log-neighbor-changes
 			bgp ebgp-requires-policy
 			no bgp default show-hostname
@@ -44,9 +55,9 @@ func (s *Server) frrCreateVrfRequest(ctx context.Context, in *pb.CreateVrfReques
 				maximum-paths ibgp 1
 				exit-address-family
 			address-family l2vpn evpn
-				advertise ipv4 unicast
+				advertise ipv4 unicast%s
 				exit-address-family
-			exit`, vrfName))
+			exit`, config.GetConfig().Frr.LocalAS, vrfName, frrLines(utils.EvpnCommands(nil, options.Spec.GetEvpn()))))
 		fmt.Printf("FrrBgpCmd: %v:%v", data, err)
 		if err != nil {
 			return err
@@ -66,8 +77,8 @@ func (s *Server) frrDeleteVrfRequest(ctx context.Context, obj *pb.Vrf) error {
 	if obj.Spec.Vni != nil {
 		data, err := s.frr.FrrBgpCmd(ctx, fmt.Sprintf(
 			`configure terminal
-			no router bgp 65000 vrf %s
-			exit`, vrfName))
+			no router bgp %d vrf %s
+			exit`, config.GetConfig().Frr.LocalAS, vrfName))
 		fmt.Printf("FrrBgpCmd: %v:%v", data, err)
 		if err != nil {
 			return err
@@ -85,3 +96,40 @@ func (s *Server) frrDeleteVrfRequest(ctx context.Context, obj *pb.Vrf) error {
 	}
 	return nil
 }
+
+// frrUpdateEvpn changes the route distinguisher and route targets of the L3 VNI of a VRF
+func (s *Server) frrUpdateEvpn(ctx context.Context, vrf *pb.Vrf, oldEvpn, evpn *pa.EvpnOptions) error {
+	commands := utils.EvpnCommands(oldEvpn, evpn)
+	if len(commands) == 0 {
+		return nil
+	}
+	data, err := s.frr.FrrBgpCmd(ctx, fmt.Sprintf(
+		`configure terminal
+			router bgp %d vrf %s
+			address-family l2vpn evpn%s
+				exit-address-family
+			exit`, config.GetConfig().Frr.LocalAS, path.Base(vrf.Name), frrLines(commands)))
+	fmt.Printf("FrrBgpCmd: %v:%v", data, err)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// frrReadEvpn reads the route distinguisher and route targets of the L3 VNI in effect
+func (s *Server) frrReadEvpn(ctx context.Context, vni uint32) (*pa.EvpnOptions, error) {
+	data, err := s.frr.FrrBgpCmd(ctx, utils.EvpnShowCommand(vni))
+	if err != nil {
+		return nil, err
+	}
+	return utils.EvpnOptionsOf(data)
+}
+
+// frrLines returns the commands as lines of a block of a command template
+func frrLines(commands []string) string {
+	var lines strings.Builder
+	for _, command := range commands {
+		fmt.Fprintf(&lines, "\n\t\t\t\t%s", command)
+	}
+	return lines.String()
+}
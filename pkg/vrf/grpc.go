@@ -29,9 +29,16 @@ import (
 // CreateVrf executes the creation of the VRF
 func (s *Server) CreateVrf(ctx context.Context, in *pb.CreateVrfRequest) (*pb.Vrf, error) {
 	// check input correctness
-	if err := s.validateCreateVrfRequest(in); err != nil {
+	if err := s.ValidateCreateVrfRequest(in); err != nil {
 		return nil, err
 	}
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.CreateVrf(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
 	// see https://google.aip.dev/133#user-specified-ids
 	resourceID := resourceid.NewSystemGenerated()
 	if in.VrfId != "" {
@@ -48,8 +55,19 @@ func (s *Server) CreateVrf(ctx context.Context, in *pb.CreateVrfRequest) (*pb.Vr
 	}
 	if ok {
 		log.Printf("Already existing Vrf with id %v", in.Vrf.Name)
+		utils.SendEtag(ctx, obj)
 		return obj, nil
 	}
+	// the L3 VNI device can not share the port of the shared VXLAN device
+	if in.Vrf.Spec.Vni != nil {
+		options, err := s.loadOptions(optionsName(in.Vrf.Name))
+		if err != nil {
+			return nil, err
+		}
+		if err := checkL3VxlanPort(options); err != nil {
+			return nil, err
+		}
+	}
 	// TODO: consider choosing random table ID
 	tableID := uint32(1000)
 	if in.Vrf.Spec.Vni != nil {
@@ -79,6 +97,7 @@ func (s *Server) CreateVrf(ctx context.Context, in *pb.CreateVrfRequest) (*pb.Vr
 	if err != nil {
 		return nil, err
 	}
+	utils.SendEtag(ctx, response)
 	return response, nil
 }
 
@@ -88,6 +107,16 @@ func (s *Server) DeleteVrf(ctx context.Context, in *pb.DeleteVrfRequest) (*empty
 	if err := s.validateDeleteVrfRequest(in); err != nil {
 		return nil, err
 	}
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.DeleteVrf(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
+	// serialize writers, so the etag check and the write are atomic
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// fetch object from the database
 	obj := new(pb.Vrf)
 	ok, err := s.store.Get(in.Name, obj)
@@ -102,12 +131,29 @@ func (s *Server) DeleteVrf(ctx context.Context, in *pb.DeleteVrfRequest) (*empty
 		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
 		return nil, err
 	}
+	// reject stale etag, see https://google.aip.dev/154
+	if err := utils.CheckEtag(ctx, obj); err != nil {
+		return nil, err
+	}
+	if err := s.checkStaticRoutes(obj); err != nil {
+		return nil, err
+	}
+	// remove the leaks which reference the VRF, they come back when the delete fails
+	importers, err := s.deleteLeaksFrom(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
 	// configure netlink
 	if err := s.netlinkDeleteVrf(ctx, obj); err != nil {
+		s.restoreLeaksFrom(ctx, obj, importers)
 		return nil, err
 	}
 	// delete from FRR
 	if err := s.frrDeleteVrfRequest(ctx, obj); err != nil {
+		s.restoreLeaksFrom(ctx, obj, importers)
+		return nil, err
+	}
+	if err := s.deleteLeaksInto(obj); err != nil {
 		return nil, err
 	}
 	// remove from the Database
@@ -125,6 +171,16 @@ func (s *Server) UpdateVrf(ctx context.Context, in *pb.UpdateVrfRequest) (*pb.Vr
 	if err := s.validateUpdateVrfRequest(in); err != nil {
 		return nil, err
 	}
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.UpdateVrf(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
+	// serialize writers, so the etag check and the write are atomic
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	// fetch object from the database
 	obj := new(pb.Vrf)
 	ok, err := s.store.Get(in.Vrf.Name, obj)
@@ -137,6 +193,10 @@ func (s *Server) UpdateVrf(ctx context.Context, in *pb.UpdateVrfRequest) (*pb.Vr
 		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Vrf.Name)
 		return nil, err
 	}
+	// reject stale etag, see https://google.aip.dev/154
+	if err := utils.CheckEtag(ctx, obj); err != nil {
+		return nil, err
+	}
 	resourceID := path.Base(obj.Name)
 	iface, err := s.nLink.LinkByName(ctx, resourceID)
 	if err != nil {
@@ -150,11 +210,13 @@ func (s *Server) UpdateVrf(ctx context.Context, in *pb.UpdateVrfRequest) (*pb.Vr
 		return nil, err
 	}
 	response := utils.ProtoClone(in.Vrf)
-	response.Status = &pb.VrfStatus{LocalAs: 4}
+	// the routing table and router MAC were set up on create and stay
+	response.Status = &pb.VrfStatus{LocalAs: 4, RoutingTable: obj.Status.GetRoutingTable(), Rmac: obj.Status.GetRmac()}
 	err = s.store.Set(in.Vrf.Name, response)
 	if err != nil {
 		return nil, err
 	}
+	utils.SendEtag(ctx, response)
 	return response, nil
 }
 
@@ -175,6 +237,7 @@ func (s *Server) GetVrf(ctx context.Context, in *pb.GetVrfRequest) (*pb.Vrf, err
 		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Name)
 		return nil, err
 	}
+	utils.SendEtag(ctx, obj)
 	resourceID := path.Base(obj.Name)
 	_, err = s.nLink.LinkByName(ctx, resourceID)
 	if err != nil {
@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package vrf is the main package of the application
+package vrf
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+
+	"go.einride.tech/aip/resourcename"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+)
+
+// validateImportVrfs checks the names of the VRFs leaked into a VRF
+func validateImportVrfs(options *pa.VrfOptions) error {
+	vrfName := strings.TrimSuffix(options.Name, "/options")
+	seen := make(map[string]bool)
+	for _, name := range options.Spec.GetImportVrfs() {
+		// Validate that a resource name conforms to the restrictions outlined in AIP-122.
+		if err := resourcename.Validate(name); err != nil {
+			return err
+		}
+		if name == vrfName {
+			return status.Errorf(codes.InvalidArgument, "VRF %s can not import from itself", name)
+		}
+		if seen[name] {
+			return status.Errorf(codes.InvalidArgument, "VRF %s is imported more than once", name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// diffImportVrfs returns the VRFs added to and removed from the imports
+func diffImportVrfs(oldVrfs, newVrfs []string) (added, removed []string) {
+	for _, name := range newVrfs {
+		if !contains(oldVrfs, name) {
+			added = append(added, name)
+		}
+	}
+	for _, name := range oldVrfs {
+		if !contains(newVrfs, name) {
+			removed = append(removed, name)
+		}
+	}
+	return added, removed
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func without(names []string, name string) []string {
+	var rest []string
+	for _, n := range names {
+		if n != name {
+			rest = append(rest, n)
+		}
+	}
+	return rest
+}
+
+// checkImportVrfs checks that the VRF and the VRFs it imports have BGP instances,
+// the leaks are between existing VRFs only, so deleting a VRF can remove them
+func (s *Server) checkImportVrfs(vrf *pb.Vrf, options *pa.VrfOptions, added []string) error {
+	if len(added) == 0 {
+		return nil
+	}
+	vrfName := strings.TrimSuffix(options.Name, "/options")
+	if vrf == nil {
+		err := status.Errorf(codes.FailedPrecondition, "VRF %s has to exist to import routes", vrfName)
+		return err
+	}
+	if vrf.Spec.Vni == nil {
+		err := status.Errorf(codes.FailedPrecondition, "VRF %s has no BGP instance", vrfName)
+		return err
+	}
+	for _, name := range added {
+		imported, err := s.optionsVrf(optionsName(name))
+		if err != nil {
+			return err
+		}
+		if imported == nil {
+			err := status.Errorf(codes.NotFound, "unable to find key %s", name)
+			return err
+		}
+		if imported.Spec.Vni == nil {
+			err := status.Errorf(codes.FailedPrecondition, "VRF %s has no BGP instance", name)
+			return err
+		}
+	}
+	return nil
+}
+
+// frrImportVrfs adds and removes the VRFs leaked into the BGP instance of a VRF
+func (s *Server) frrImportVrfs(ctx context.Context, vrf *pb.Vrf, added, removed []string) error {
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+	var imports []string
+	for _, name := range added {
+		imports = append(imports, "import vrf "+path.Base(name))
+	}
+	for _, name := range removed {
+		imports = append(imports, "no import vrf "+path.Base(name))
+	}
+	data, err := s.frr.FrrBgpCmd(ctx, fmt.Sprintf(
+		`configure terminal
+			router bgp %d vrf %s
+			address-family ipv4 unicast%s
+				exit-address-family
+			exit`, config.GetConfig().Frr.LocalAS, path.Base(vrf.Name), frrLines(imports)))
+	fmt.Printf("FrrBgpCmd: %v:%v", data, err)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// deleteLeaksFrom removes the leaks of a VRF into other VRFs before it is
+// deleted, since FRR does not delete a BGP instance other instances import
+// from. It returns the VRFs which imported from it for restoreLeaksFrom.
+func (s *Server) deleteLeaksFrom(ctx context.Context, obj *pb.Vrf) ([]string, error) {
+	var importers []string
+	// scan the store, the VRFs created before a restart are not in ListHelper
+	for _, key := range utils.StoreKeys(s.store) {
+		if key == obj.Name || !strings.HasPrefix(key, "//network.opiproject.org/vrfs/") || strings.HasSuffix(key, "/options") {
+			continue
+		}
+		options, err := s.loadOptions(optionsName(key))
+		if err != nil {
+			s.restoreLeaksFrom(ctx, obj, importers)
+			return nil, err
+		}
+		if !contains(options.Spec.ImportVrfs, obj.Name) {
+			continue
+		}
+		vrf, err := s.optionsVrf(options.Name)
+		if err != nil {
+			s.restoreLeaksFrom(ctx, obj, importers)
+			return nil, err
+		}
+		if vrf != nil {
+			if err := s.frrImportVrfs(ctx, vrf, nil, []string{obj.Name}); err != nil {
+				s.restoreLeaksFrom(ctx, obj, importers)
+				return nil, err
+			}
+		}
+		options.Spec.ImportVrfs = without(options.Spec.ImportVrfs, obj.Name)
+		if err := s.store.Set(options.Name, options); err != nil {
+			s.restoreLeaksFrom(ctx, obj, importers)
+			return nil, err
+		}
+		importers = append(importers, key)
+	}
+	return importers, nil
+}
+
+// restoreLeaksFrom adds the leaks removed by deleteLeaksFrom back to the
+// importing VRFs, when the VRF could not be deleted after all
+func (s *Server) restoreLeaksFrom(ctx context.Context, obj *pb.Vrf, importers []string) {
+	for _, name := range importers {
+		options, err := s.loadOptions(optionsName(name))
+		if err != nil {
+			log.Printf("Failed to restore leak of %s into %s: %v", obj.Name, name, err)
+			continue
+		}
+		vrf, err := s.optionsVrf(options.Name)
+		if err != nil {
+			log.Printf("Failed to restore leak of %s into %s: %v", obj.Name, name, err)
+			continue
+		}
+		if vrf != nil {
+			if err := s.frrImportVrfs(ctx, vrf, []string{obj.Name}, nil); err != nil {
+				log.Printf("Failed to restore leak of %s into %s: %v", obj.Name, name, err)
+				continue
+			}
+		}
+		options.Spec.ImportVrfs = append(options.Spec.ImportVrfs, obj.Name)
+		if err := s.store.Set(options.Name, options); err != nil {
+			log.Printf("Failed to restore leak of %s into %s: %v", obj.Name, name, err)
+		}
+	}
+}
+
+// deleteLeaksInto forgets the imports of a deleted VRF,
+// they went away with its BGP instance
+func (s *Server) deleteLeaksInto(obj *pb.Vrf) error {
+	options, err := s.loadOptions(optionsName(obj.Name))
+	if err != nil {
+		return err
+	}
+	if len(options.Spec.ImportVrfs) == 0 {
+		return nil
+	}
+	options.Spec.ImportVrfs = nil
+	return s.store.Set(options.Name, options)
+}
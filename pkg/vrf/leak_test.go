@@ -0,0 +1,267 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package vrf is the main package of the application
+package vrf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/vishvananda/netlink"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils/mocks"
+)
+
+var (
+	testSharedVrfName = resourceIDToFullName("shared")
+	testSharedVrf     = pb.Vrf{
+		Name: testSharedVrfName,
+		Spec: &pb.VrfSpec{
+			Vni:          proto.Uint32(2000),
+			VtepIpPrefix: testVrf.Spec.VtepIpPrefix,
+		},
+		Status: &pb.VrfStatus{
+			LocalAs: 4,
+		},
+	}
+)
+
+// frrCommand matches an FRR command containing the line
+func frrCommand(line string) interface{} {
+	return mock.MatchedBy(func(command string) bool {
+		return strings.Contains(command, "\t"+line+"\n")
+	})
+}
+
+func Test_UpdateVrfOptionsImportVrfs(t *testing.T) {
+	tests := map[string]struct {
+		mask    *fieldmaskpb.FieldMask
+		in      []string
+		stored  []string
+		out     *pa.VrfOptions
+		errCode codes.Code
+		errMsg  string
+		exist   bool
+		shared  *pb.Vrf
+		on      func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string)
+	}{
+		"invalid vrf name": {
+			in:      []string{"-ABC-DEF"},
+			errCode: codes.Unknown,
+			errMsg:  fmt.Sprintf("segment '%s': not a valid DNS name", "-ABC-DEF"),
+		},
+		"import from itself": {
+			in:      []string{testVrfName},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("VRF %s can not import from itself", testVrfName),
+		},
+		"import twice": {
+			in:      []string{testSharedVrfName, testSharedVrfName},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("VRF %s is imported more than once", testSharedVrfName),
+		},
+		"vrf does not exist": {
+			in:      []string{testSharedVrfName},
+			errCode: codes.FailedPrecondition,
+			errMsg:  fmt.Sprintf("VRF %s has to exist to import routes", testVrfName),
+			shared:  &testSharedVrf,
+		},
+		"imported vrf does not exist": {
+			in:      []string{testSharedVrfName},
+			errCode: codes.NotFound,
+			errMsg:  fmt.Sprintf("unable to find key %s", testSharedVrfName),
+			exist:   true,
+		},
+		"imported vrf without BGP instance": {
+			in:      []string{testSharedVrfName},
+			errCode: codes.FailedPrecondition,
+			errMsg:  fmt.Sprintf("VRF %s has no BGP instance", testSharedVrfName),
+			exist:   true,
+			shared:  &pb.Vrf{Name: testSharedVrfName, Spec: &pb.VrfSpec{}},
+		},
+		"failed FrrBgpCmd call": {
+			in:      []string{testSharedVrfName},
+			errCode: codes.Unknown,
+			errMsg:  "Failed to run FrrBgpCmd",
+			exist:   true,
+			shared:  &testSharedVrf,
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				mockFrr.EXPECT().FrrBgpCmd(mock.Anything, frrCommand("import vrf shared")).Return("", errors.New(errMsg)).Once()
+			},
+		},
+		"import shared vrf": {
+			in: []string{testSharedVrfName},
+			out: &pa.VrfOptions{
+				Name:   testOptionsName,
+				Spec:   &pa.VrfOptionsSpec{ImportVrfs: []string{testSharedVrfName}},
+				Status: &pa.VrfOptionsStatus{Vxlan: testVxlanStatus, Evpn: testEvpnStatus},
+			},
+			errCode: codes.OK,
+			exist:   true,
+			shared:  &testSharedVrf,
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				mockFrr.EXPECT().FrrBgpCmd(mock.Anything, frrCommand("import vrf shared")).Return("", nil).Once()
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni1000").Return(testVxlan, nil).Once()
+				mockFrr.EXPECT().FrrBgpCmd(mock.Anything, utils.EvpnShowCommand(1000)).Return(testEvpnShow, nil).Once()
+			},
+		},
+		"remove import": {
+			mask:   &fieldmaskpb.FieldMask{Paths: []string{"spec.import_vrfs"}},
+			stored: []string{testSharedVrfName},
+			out: &pa.VrfOptions{
+				Name:   testOptionsName,
+				Spec:   &pa.VrfOptionsSpec{},
+				Status: &pa.VrfOptionsStatus{Vxlan: testVxlanStatus, Evpn: testEvpnStatus},
+			},
+			errCode: codes.OK,
+			exist:   true,
+			shared:  &testSharedVrf,
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				mockFrr.EXPECT().FrrBgpCmd(mock.Anything, frrCommand("no import vrf shared")).Return("", nil).Once()
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni1000").Return(testVxlan, nil).Once()
+				mockFrr.EXPECT().FrrBgpCmd(mock.Anything, utils.EvpnShowCommand(1000)).Return(testEvpnShow, nil).Once()
+			},
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pa.NewVrfOptionsServiceClient(env.conn)
+
+			if tt.exist {
+				_ = env.opi.store.Set(testVrfName, &testVrfWithStatus)
+			}
+			if tt.shared != nil {
+				_ = env.opi.store.Set(testSharedVrfName, tt.shared)
+			}
+			if tt.stored != nil {
+				_ = env.opi.store.Set(testOptionsName, &pa.VrfOptions{Name: testOptionsName, Spec: &pa.VrfOptionsSpec{ImportVrfs: tt.stored}})
+			}
+			if tt.on != nil {
+				tt.on(env.mockNetlink, env.mockFrr, tt.errMsg)
+			}
+
+			in := &pa.VrfOptions{Name: testOptionsName, Spec: &pa.VrfOptionsSpec{ImportVrfs: tt.in}}
+			request := &pa.UpdateVrfOptionsRequest{VrfOptions: in, UpdateMask: tt.mask}
+			response, err := client.UpdateVrfOptions(ctx, request)
+			if !proto.Equal(tt.out, response) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+		})
+	}
+}
+
+func Test_DeleteVrfLeaks(t *testing.T) {
+	ctx := context.Background()
+	env := newTestEnv(ctx, t)
+	defer env.Close()
+	client := pb.NewVrfServiceClient(env.conn)
+
+	// the tenant VRF and the shared VRF import each other, ListHelper is
+	// empty like after a restart, the leaks are found in the store
+	_ = env.opi.store.Set(testVrfName, &testVrfWithStatus)
+	_ = env.opi.store.Set(testSharedVrfName, &testSharedVrf)
+	_ = env.opi.store.Set(testOptionsName, &pa.VrfOptions{Name: testOptionsName, Spec: &pa.VrfOptionsSpec{ImportVrfs: []string{testSharedVrfName}}})
+	sharedOptionsName := optionsName(testSharedVrfName)
+	_ = env.opi.store.Set(sharedOptionsName, &pa.VrfOptions{Name: sharedOptionsName, Spec: &pa.VrfOptionsSpec{ImportVrfs: []string{testVrfName}}})
+
+	// the shared VRF stops importing from the tenant VRF first
+	env.mockFrr.EXPECT().FrrBgpCmd(mock.Anything, frrCommand("no import vrf opi-vrf8")).Return("", nil).Once()
+	vxlan := &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: "vni1000"}, VxlanId: 1000, Port: 4789, SrcAddr: net.IP{10, 0, 0, 2}}
+	env.mockNetlink.EXPECT().LinkByName(mock.Anything, "vni1000").Return(vxlan, nil).Once()
+	env.mockNetlink.EXPECT().LinkSetDown(mock.Anything, vxlan).Return(nil).Once()
+	env.mockNetlink.EXPECT().LinkDel(mock.Anything, vxlan).Return(nil).Once()
+	bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: "br1000"}}
+	env.mockNetlink.EXPECT().LinkByName(mock.Anything, "br1000").Return(bridge, nil).Once()
+	env.mockNetlink.EXPECT().LinkSetDown(mock.Anything, bridge).Return(nil).Once()
+	env.mockNetlink.EXPECT().LinkDel(mock.Anything, bridge).Return(nil).Once()
+	vrf := &netlink.Vrf{LinkAttrs: netlink.LinkAttrs{Name: testVrfID}, Table: 1001}
+	env.mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(vrf, nil).Once()
+	for _, route := range throwRoutes(1001) {
+		env.mockNetlink.EXPECT().RouteDel(mock.Anything, route).Return(nil).Once()
+	}
+	env.mockNetlink.EXPECT().LinkSetDown(mock.Anything, vrf).Return(nil).Once()
+	env.mockNetlink.EXPECT().LinkDel(mock.Anything, vrf).Return(nil).Once()
+	env.mockFrr.EXPECT().FrrBgpCmd(mock.Anything, mock.Anything).Return("", nil).Once()
+	env.mockFrr.EXPECT().FrrZebraCmd(mock.Anything, mock.Anything).Return("", nil).Once()
+
+	if _, err := client.DeleteVrf(ctx, &pb.DeleteVrfRequest{Name: testVrfName}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{testOptionsName, sharedOptionsName} {
+		options := new(pa.VrfOptions)
+		if _, err := env.opi.store.Get(name, options); err != nil {
+			t.Fatal(err)
+		}
+		if len(options.Spec.GetImportVrfs()) != 0 {
+			t.Error("Expect no leaks left in", name, "received", options.Spec.GetImportVrfs())
+		}
+	}
+}
+
+func Test_DeleteVrfLeaksRestored(t *testing.T) {
+	ctx := context.Background()
+	env := newTestEnv(ctx, t)
+	defer env.Close()
+	client := pb.NewVrfServiceClient(env.conn)
+
+	// the shared VRF imports from the tenant VRF
+	_ = env.opi.store.Set(testVrfName, &testVrfWithStatus)
+	_ = env.opi.store.Set(testSharedVrfName, &testSharedVrf)
+	sharedOptionsName := optionsName(testSharedVrfName)
+	_ = env.opi.store.Set(sharedOptionsName, &pa.VrfOptions{Name: sharedOptionsName, Spec: &pa.VrfOptionsSpec{ImportVrfs: []string{testVrfName}}})
+
+	// the leak is removed, the tenant VRF can not be deleted and the leak comes back
+	env.mockFrr.EXPECT().FrrBgpCmd(mock.Anything, frrCommand("no import vrf opi-vrf8")).Return("", nil).Once()
+	env.mockNetlink.EXPECT().LinkByName(mock.Anything, "vni1000").Return(nil, errors.New("Failed to call LinkByName")).Once()
+	env.mockFrr.EXPECT().FrrBgpCmd(mock.Anything, frrCommand("import vrf opi-vrf8")).Return("", nil).Once()
+
+	_, err := client.DeleteVrf(ctx, &pb.DeleteVrfRequest{Name: testVrfName})
+	if status.Code(err) != codes.NotFound {
+		t.Error("Expect NotFound, received", err)
+	}
+
+	options := new(pa.VrfOptions)
+	if _, err := env.opi.store.Get(sharedOptionsName, options); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(options.Spec.GetImportVrfs(), []string{testVrfName}) {
+		t.Error("Expect leak restored in", sharedOptionsName, "received", options.Spec.GetImportVrfs())
+	}
+	ok, err := env.opi.store.Get(testVrfName, new(pb.Vrf))
+	if err != nil || !ok {
+		t.Error("Expect VRF kept in store, received", ok, err)
+	}
+}
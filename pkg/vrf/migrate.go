@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package vrf is the main package of the application
+package vrf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+)
+
+// MigrateThrowRoutes adds the throw routes missing in the routing tables of
+// the stored VRFs named in names, like the IPv6 one of VRFs created by an
+// older version. It returns the number of added routes.
+func (s *Server) MigrateThrowRoutes(ctx context.Context, names []string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	added := 0
+	for _, name := range names {
+		// skip other resources and the options singletons
+		if !strings.HasPrefix(name, "//network.opiproject.org/vrfs/") || strings.HasSuffix(name, "/options") {
+			continue
+		}
+		vrf := new(pb.Vrf)
+		ok, err := s.store.Get(name, vrf)
+		if err != nil {
+			fmt.Printf("Failed to interact with store: %v", err)
+			return added, err
+		}
+		if !ok {
+			continue
+		}
+		// only VRFs whose device exists, the table is taken from it
+		link, err := s.nLink.LinkByName(ctx, path.Base(vrf.Name))
+		if err != nil {
+			continue
+		}
+		dev, ok := link.(*netlink.Vrf)
+		if !ok {
+			continue
+		}
+		for _, route := range throwRoutes(dev.Table) {
+			if err := s.nLink.RouteAdd(ctx, route); err != nil {
+				if errors.Is(err, unix.EEXIST) {
+					continue
+				}
+				fmt.Printf("Failed to add default route to VRF table: %v", err)
+				return added, err
+			}
+			log.Printf("Added route %v of %v", route, vrf.Name)
+			added++
+		}
+	}
+	return added, nil
+}
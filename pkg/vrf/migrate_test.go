@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package vrf is the main package of the application
+package vrf
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils/mocks"
+)
+
+func Test_MigrateThrowRoutes(t *testing.T) {
+	vrf := &netlink.Vrf{LinkAttrs: netlink.LinkAttrs{Name: testVrfID}, Table: 1001}
+	routes := throwRoutes(1001)
+	tests := map[string]struct {
+		added  int
+		errMsg string
+		on     func(mockNetlink *mocks.Netlink, errMsg string)
+	}{
+		"adds the missing IPv6 route": {
+			added: 1,
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(vrf, nil).Once()
+				mockNetlink.EXPECT().RouteAdd(mock.Anything, routes[0]).Return(unix.EEXIST).Once()
+				mockNetlink.EXPECT().RouteAdd(mock.Anything, routes[1]).Return(nil).Once()
+			},
+		},
+		"routes exist already": {
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(vrf, nil).Once()
+				for _, route := range routes {
+					mockNetlink.EXPECT().RouteAdd(mock.Anything, route).Return(unix.EEXIST).Once()
+				}
+			},
+		},
+		"vrf device does not exist": {
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(nil, errors.New("Link not found")).Once()
+			},
+		},
+		"failed RouteAdd call": {
+			errMsg: "Failed to call RouteAdd",
+			on: func(mockNetlink *mocks.Netlink, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(vrf, nil).Once()
+				mockNetlink.EXPECT().RouteAdd(mock.Anything, routes[0]).Return(unix.EEXIST).Once()
+				mockNetlink.EXPECT().RouteAdd(mock.Anything, routes[1]).Return(errors.New(errMsg)).Once()
+			},
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+
+			_ = env.opi.store.Set(testVrfName, &testVrfWithStatus)
+			tt.on(env.mockNetlink, tt.errMsg)
+
+			names := []string{testVrfName, optionsName(testVrfName), "//network.opiproject.org/bridges/blue"}
+			added, err := env.opi.MigrateThrowRoutes(ctx, names)
+			if added != tt.added {
+				t.Error("added: expected", tt.added, "received", added)
+			}
+			if (err == nil && tt.errMsg != "") || (err != nil && err.Error() != tt.errMsg) {
+				t.Error("error: expected", tt.errMsg, "received", err)
+			}
+		})
+	}
+}
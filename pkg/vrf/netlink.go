@@ -8,19 +8,48 @@ package vrf
 import (
 	"context"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"log"
 	"net"
 	"path"
+	"strings"
 
 	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
 
 	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
 
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// throwPriority is the metric of the default route, which ends the lookup
+// in the routing table of a VRF, so all other routes take precedence
+const throwPriority = 9999
+
+// throwRoutes returns the low-prio IPv4 and IPv6 default routes of the routing
+// table of a VRF. Otherwise a miss leads to lookup in the next higher table
+func throwRoutes(tableID uint32) []*netlink.Route {
+	return []*netlink.Route{
+		throwRoute(tableID, &net.IPNet{IP: net.IPv4zero, Mask: net.CIDRMask(0, 32)}),
+		throwRoute(tableID, &net.IPNet{IP: net.IPv6zero, Mask: net.CIDRMask(0, 128)}),
+	}
+}
+
+func throwRoute(tableID uint32, dst *net.IPNet) *netlink.Route {
+	return &netlink.Route{
+		Dst:      dst,
+		Type:     unix.RTN_THROW,
+		Table:    int(tableID),
+		Protocol: utils.RouteProtocol,
+		Priority: throwPriority,
+	}
+}
+
 func (s *Server) netlinkCreateVrf(ctx context.Context, in *pb.CreateVrfRequest, tableID uint32, mac []byte) error {
 	vrfName := path.Base(in.Vrf.Name)
 	// Example: ip link add blue type vrf table 1000
@@ -45,8 +74,16 @@ func (s *Server) netlinkCreateVrf(ctx context.Context, in *pb.CreateVrfRequest,
 			return err
 		}
 	}
-	// TODO: Add low-prio default route. Otherwise a miss leads to lookup in the next higher table
-	// Example: ip route add throw default table <routing-table-number> proto evpn-gw-br metric 9999
+	// Example: ip route add throw default table 1000 proto evpn-gw-br metric 9999
+	// Example: ip -6 route add throw default table 1000 proto evpn-gw-br metric 9999
+	// VRFs can share a table, the routes exist already then
+	for _, route := range throwRoutes(tableID) {
+		log.Printf("Adding route %v", route)
+		if err := s.nLink.RouteAdd(ctx, route); err != nil && !errors.Is(err, unix.EEXIST) {
+			fmt.Printf("Failed to add default route to VRF table: %v", err)
+			return err
+		}
+	}
 
 	// create bridge and vxlan only if VNI value is not empty
 	if in.Vrf.Spec.Vni != nil {
@@ -73,25 +110,12 @@ func (s *Server) netlinkCreateVrf(ctx context.Context, in *pb.CreateVrfRequest,
 			fmt.Printf("Failed to up Bridge link: %v", err)
 			return err
 		}
-		// Example: ip link add vni100 type vxlan local 10.0.0.4 dstport 4789 id 100 nolearning
-		vxlanName := fmt.Sprintf("vni%d", *in.Vrf.Spec.Vni)
-		myip := make(net.IP, 4)
-		binary.BigEndian.PutUint32(myip, in.Vrf.Spec.VtepIpPrefix.Addr.GetV4Addr())
-		// TODO: take Port from proto instead of hard-coded
-		vxlan := &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: vxlanName}, VxlanId: int(*in.Vrf.Spec.Vni), Port: 4789, Learning: false, SrcAddr: myip}
-		log.Printf("Creating VXLAN %v", vxlan)
-		if err := s.nLink.LinkAdd(ctx, vxlan); err != nil {
-			fmt.Printf("Failed to create Vxlan link: %v", err)
-			return err
-		}
-		// Example: ip link set vni100 master br100 addrgenmode none
-		if err := s.nLink.LinkSetMaster(ctx, vxlan, bridge); err != nil {
-			fmt.Printf("Failed to add Vxlan to bridge: %v", err)
+		// create vxlan with the parameters from the options of the VRF
+		options, err := s.loadOptions(optionsName(in.Vrf.Name))
+		if err != nil {
 			return err
 		}
-		// Example: ip link set vni100 up
-		if err := s.nLink.LinkSetUp(ctx, vxlan); err != nil {
-			fmt.Printf("Failed to up Vxlan link: %v", err)
+		if err := s.netlinkCreateVxlan(ctx, in.Vrf, bridge, options); err != nil {
 			return err
 		}
 	}
@@ -101,22 +125,7 @@ func (s *Server) netlinkCreateVrf(ctx context.Context, in *pb.CreateVrfRequest,
 func (s *Server) netlinkDeleteVrf(ctx context.Context, obj *pb.Vrf) error {
 	// delete bridge and vxlan only if VNI value is not empty
 	if obj.Spec.Vni != nil {
-		// use netlink to find VXLAN device
-		vxlanName := fmt.Sprintf("vni%d", *obj.Spec.Vni)
-		vxlandev, err := s.nLink.LinkByName(ctx, vxlanName)
-		log.Printf("Deleting VXLAN %v", vxlandev)
-		if err != nil {
-			err := status.Errorf(codes.NotFound, "unable to find key %s", vxlanName)
-			return err
-		}
-		// bring link down
-		if err := s.nLink.LinkSetDown(ctx, vxlandev); err != nil {
-			fmt.Printf("Failed to up link: %v", err)
-			return err
-		}
-		// use netlink to delete VXLAN device
-		if err := s.nLink.LinkDel(ctx, vxlandev); err != nil {
-			fmt.Printf("Failed to delete link: %v", err)
+		if err := s.netlinkDeleteVxlan(ctx, obj); err != nil {
 			return err
 		}
 		// use netlink to find BRIDGE device
@@ -146,6 +155,25 @@ func (s *Server) netlinkDeleteVrf(ctx context.Context, obj *pb.Vrf) error {
 		err := status.Errorf(codes.NotFound, "unable to find key %s", vrfName)
 		return err
 	}
+	// routes without device stay in the table after the VRF is deleted,
+	// unless another VRF still uses the table
+	// Example: ip route del throw default table 1000 proto evpn-gw-br metric 9999
+	if dev, ok := vrf.(*netlink.Vrf); ok {
+		shared, err := s.tableShared(obj.Name, dev.Table)
+		if err != nil {
+			return err
+		}
+		for _, route := range throwRoutes(dev.Table) {
+			if shared {
+				log.Printf("Keeping route %v used by other VRFs", route)
+				continue
+			}
+			if err := s.nLink.RouteDel(ctx, route); err != nil && !errors.Is(err, unix.ESRCH) {
+				fmt.Printf("Failed to delete default route from VRF table: %v", err)
+				return err
+			}
+		}
+	}
 	// bring link down
 	if err := s.nLink.LinkSetDown(ctx, vrf); err != nil {
 		fmt.Printf("Failed to up link: %v", err)
@@ -158,3 +186,71 @@ func (s *Server) netlinkDeleteVrf(ctx context.Context, obj *pb.Vrf) error {
 	}
 	return nil
 }
+
+// tableShared reports whether another stored VRF uses the routing table
+func (s *Server) tableShared(name string, tableID uint32) (bool, error) {
+	// scan the store, the VRFs created before a restart are not in ListHelper
+	for _, key := range utils.StoreKeys(s.store) {
+		if key == name || !strings.HasPrefix(key, "//network.opiproject.org/vrfs/") || strings.HasSuffix(key, "/options") {
+			continue
+		}
+		vrf := new(pb.Vrf)
+		ok, err := s.store.Get(key, vrf)
+		if err != nil {
+			fmt.Printf("Failed to interact with store: %v", err)
+			return false, err
+		}
+		if ok && vrf.Status.GetRoutingTable() == tableID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// netlinkCreateVxlan creates the L3 VXLAN device of the VRF and adds it to the bridge of the VRF
+func (s *Server) netlinkCreateVxlan(ctx context.Context, obj *pb.Vrf, bridge netlink.Link, options *pa.VrfOptions) error {
+	// Example: ip link add vni100 type vxlan local 10.0.0.4 dstport 4789 id 100 nolearning
+	vxlanName := fmt.Sprintf("vni%d", *obj.Spec.Vni)
+	myip := make(net.IP, 4)
+	binary.BigEndian.PutUint32(myip, obj.Spec.VtepIpPrefix.GetAddr().GetV4Addr())
+	vxlan := utils.NewVxlan(vxlanName, *obj.Spec.Vni, myip, options.Spec.GetVxlan())
+	log.Printf("Creating VXLAN %v", vxlan)
+	if err := s.nLink.LinkAdd(ctx, vxlan); err != nil {
+		fmt.Printf("Failed to create Vxlan link: %v", err)
+		return err
+	}
+	// Example: ip link set vni100 master br100 addrgenmode none
+	if err := s.nLink.LinkSetMaster(ctx, vxlan, bridge); err != nil {
+		fmt.Printf("Failed to add Vxlan to bridge: %v", err)
+		return err
+	}
+	// Example: ip link set vni100 up
+	if err := s.nLink.LinkSetUp(ctx, vxlan); err != nil {
+		fmt.Printf("Failed to up Vxlan link: %v", err)
+		return err
+	}
+	return nil
+}
+
+// netlinkDeleteVxlan deletes the L3 VXLAN device of the VRF
+func (s *Server) netlinkDeleteVxlan(ctx context.Context, obj *pb.Vrf) error {
+	// use netlink to find VXLAN device
+	vxlanName := fmt.Sprintf("vni%d", *obj.Spec.Vni)
+	vxlandev, err := s.nLink.LinkByName(ctx, vxlanName)
+	log.Printf("Deleting VXLAN %v", vxlandev)
+	if err != nil {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", vxlanName)
+		return err
+	}
+	// bring link down
+	if err := s.nLink.LinkSetDown(ctx, vxlandev); err != nil {
+		fmt.Printf("Failed to up link: %v", err)
+		return err
+	}
+	// use netlink to delete VXLAN device
+	if err := s.nLink.LinkDel(ctx, vxlandev); err != nil {
+		fmt.Printf("Failed to delete link: %v", err)
+		return err
+	}
+	return nil
+}
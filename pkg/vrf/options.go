@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package vrf is the main package of the application
+package vrf
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/vishvananda/netlink"
+	"go.einride.tech/aip/fieldmask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+)
+
+// optionsPattern is the name of the options singleton below a VRF
+const optionsPattern = "//network.opiproject.org/vrfs/{vrf}/options"
+
+// GetVrfOptions gets the options of a VRF
+func (s *Server) GetVrfOptions(ctx context.Context, in *pa.GetVrfOptionsRequest) (*pa.VrfOptions, error) {
+	// check input correctness
+	if err := validateOptionsName(in.GetName()); err != nil {
+		return nil, err
+	}
+	options, err := s.loadOptions(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	utils.SendEtag(ctx, options)
+	if err := s.readOptionsStatus(ctx, options); err != nil {
+		return nil, err
+	}
+	return options, nil
+}
+
+// UpdateVrfOptions sets the options of a VRF and applies
+// them to its devices, if the VRF exists
+func (s *Server) UpdateVrfOptions(ctx context.Context, in *pa.UpdateVrfOptionsRequest) (*pa.VrfOptions, error) {
+	// check input correctness
+	if err := s.ValidateUpdateVrfOptionsRequest(in); err != nil {
+		return nil, err
+	}
+	// on dry run only record the changes and send them back
+	if !s.dryRun && utils.IsDryRun(ctx) {
+		srv, recorder := s.dryRunServer()
+		response, err := srv.UpdateVrfOptions(ctx, in)
+		recorder.SendHeader(ctx)
+		return response, err
+	}
+	// serialize writers, so the etag check and the write are atomic
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	options, err := s.loadOptions(in.VrfOptions.Name)
+	if err != nil {
+		return nil, err
+	}
+	// reject stale etag, see https://google.aip.dev/154
+	if err := utils.CheckEtag(ctx, options); err != nil {
+		return nil, err
+	}
+	// status is output only
+	in.VrfOptions.Status = nil
+	// copy the old options, the mask can update fields of them in place
+	oldVxlan := proto.Clone(options.Spec.GetVxlan()).(*pa.VxlanOptions)
+	oldImports := options.Spec.GetImportVrfs()
+	oldEvpn := proto.Clone(options.Spec.GetEvpn()).(*pa.EvpnOptions)
+	fieldmask.Update(in.UpdateMask, options, in.VrfOptions)
+	// validate the merged options, the request may only set some fields of a range
+	if err := utils.ValidateVxlanOptions(options.Spec.GetVxlan()); err != nil {
+		return nil, err
+	}
+	if err := utils.ValidateEvpnOptions(options.Spec.GetEvpn()); err != nil {
+		return nil, err
+	}
+	if err := validateImportVrfs(options); err != nil {
+		return nil, err
+	}
+	vrf, err := s.optionsVrf(options.Name)
+	if err != nil {
+		return nil, err
+	}
+	added, removed := diffImportVrfs(oldImports, options.Spec.GetImportVrfs())
+	if err := s.checkImportVrfs(vrf, options, added); err != nil {
+		return nil, err
+	}
+	if vrf != nil && vrf.Spec.Vni != nil {
+		if err := checkL3VxlanPort(options); err != nil {
+			return nil, err
+		}
+	}
+	// VXLAN parameters are fixed when the device is created, so replace it
+	if vrf != nil && vrf.Spec.Vni != nil && !proto.Equal(oldVxlan, options.Spec.GetVxlan()) {
+		if err := s.netlinkReplaceVxlan(ctx, vrf, oldVxlan, options); err != nil {
+			return nil, err
+		}
+	}
+	// leak the routes of the imported VRFs into the VRF and set its route targets
+	if vrf != nil && vrf.Spec.Vni != nil {
+		if err := s.frrImportVrfs(ctx, vrf, added, removed); err != nil {
+			return nil, err
+		}
+		if err := s.frrUpdateEvpn(ctx, vrf, oldEvpn, options.Spec.GetEvpn()); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.store.Set(options.Name, options); err != nil {
+		return nil, err
+	}
+	utils.SendEtag(ctx, options)
+	if err := s.readOptionsStatus(ctx, options); err != nil {
+		return nil, err
+	}
+	return options, nil
+}
+
+// optionsName returns the name of the options of a VRF
+func optionsName(vrfName string) string {
+	return vrfName + "/options"
+}
+
+// netlinkReplaceVxlan replaces the L3 VXLAN device of an existing VRF,
+// the device with the old options comes back when the new one fails
+func (s *Server) netlinkReplaceVxlan(ctx context.Context, vrf *pb.Vrf, oldVxlan *pa.VxlanOptions, options *pa.VrfOptions) error {
+	if err := s.netlinkDeleteVxlan(ctx, vrf); err != nil {
+		return err
+	}
+	bridgeName := fmt.Sprintf("br%d", *vrf.Spec.Vni)
+	bridge, err := s.nLink.LinkByName(ctx, bridgeName)
+	if err != nil {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", bridgeName)
+		return err
+	}
+	if err := s.netlinkCreateVxlan(ctx, vrf, bridge, options); err != nil {
+		old := utils.ProtoClone(options)
+		old.Spec.Vxlan = oldVxlan
+		s.netlinkRestoreVxlan(ctx, vrf, bridge, old)
+		return err
+	}
+	return nil
+}
+
+// netlinkRestoreVxlan deletes what was created of the replacing L3 VXLAN
+// device and creates the device with the old options again
+func (s *Server) netlinkRestoreVxlan(ctx context.Context, vrf *pb.Vrf, bridge netlink.Link, old *pa.VrfOptions) {
+	vxlanName := fmt.Sprintf("vni%d", *vrf.Spec.Vni)
+	// Example: ip link del vni100
+	if vxlan, err := s.nLink.LinkByName(ctx, vxlanName); err == nil {
+		if err := s.nLink.LinkDel(ctx, vxlan); err != nil {
+			log.Printf("Failed to delete Vxlan link %v: %v", vxlanName, err)
+			return
+		}
+	}
+	if err := s.netlinkCreateVxlan(ctx, vrf, bridge, old); err != nil {
+		log.Printf("Failed to restore Vxlan link %v: %v", vxlanName, err)
+	}
+}
+
+// loadOptions returns the stored options, or the defaults when they were never set
+func (s *Server) loadOptions(name string) (*pa.VrfOptions, error) {
+	options := new(pa.VrfOptions)
+	ok, err := s.store.Get(name, options)
+	if err != nil {
+		fmt.Printf("Failed to interact with store: %v", err)
+		return nil, err
+	}
+	if !ok {
+		options = &pa.VrfOptions{Name: name}
+	}
+	if options.Spec == nil {
+		options.Spec = &pa.VrfOptionsSpec{}
+	}
+	return options, nil
+}
+
+// optionsVrf returns the VRF the options belong to, or nil when it does not exist
+func (s *Server) optionsVrf(name string) (*pb.Vrf, error) {
+	vrf := new(pb.Vrf)
+	ok, err := s.store.Get(strings.TrimSuffix(name, "/options"), vrf)
+	if err != nil {
+		fmt.Printf("Failed to interact with store: %v", err)
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return vrf, nil
+}
+
+// readOptionsStatus reads the options in effect from the devices of the VRF
+func (s *Server) readOptionsStatus(ctx context.Context, options *pa.VrfOptions) error {
+	options.Status = &pa.VrfOptionsStatus{}
+	vrf, err := s.optionsVrf(options.Name)
+	if err != nil || vrf == nil || vrf.Spec.Vni == nil {
+		return err
+	}
+	vxlanName := fmt.Sprintf("vni%d", *vrf.Spec.Vni)
+	vxlan, err := s.nLink.LinkByName(ctx, vxlanName)
+	if err != nil {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", vxlanName)
+		return err
+	}
+	options.Status.Vxlan = utils.VxlanOptionsOf(vxlan)
+	evpn, err := s.frrReadEvpn(ctx, *vrf.Spec.Vni)
+	if err != nil {
+		log.Printf("Failed to read EVPN options of %v: %v", vxlanName, err)
+		return nil
+	}
+	options.Status.Evpn = evpn
+	return nil
+}
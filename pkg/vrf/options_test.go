@@ -0,0 +1,367 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package vrf is the main package of the application
+package vrf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/vishvananda/netlink"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils/mocks"
+)
+
+var (
+	testOptionsName = optionsName(testVrfName)
+	testVxlan       = &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: "vni1000"}, VxlanId: 1000, Port: 4789}
+	testVxlanStatus = &pa.VxlanOptions{DstPort: proto.Uint32(4789)}
+	testEvpnShow    = `show bgp l2vpn evpn vni 1000 json
+{
+  "vni":1000,
+  "type":"L3",
+  "inKernel":"True",
+  "rd":"10.0.0.2:3",
+  "originatorIp":"10.0.0.2",
+  "importRts":["65000:1000"],
+  "exportRts":["65000:1000"]
+}
+opi-evpn-bridge#`
+	testEvpnStatus = &pa.EvpnOptions{Rd: "10.0.0.2:3", ImportRts: []string{"65000:1000"}, ExportRts: []string{"65000:1000"}}
+)
+
+func Test_UpdateVrfOptions(t *testing.T) {
+	tests := map[string]struct {
+		mask    *fieldmaskpb.FieldMask
+		in      *pa.VrfOptions
+		stored  *pa.VrfOptions
+		out     *pa.VrfOptions
+		errCode codes.Code
+		errMsg  string
+		exist   bool
+		on      func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string)
+	}{
+		"invalid name": {
+			in:      &pa.VrfOptions{Name: testVrfName, Spec: &pa.VrfOptionsSpec{}},
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("name %s does not match %s", testVrfName, optionsPattern),
+		},
+		"missing spec": {
+			in:      &pa.VrfOptions{Name: testOptionsName},
+			errCode: codes.InvalidArgument,
+			errMsg:  "missing required field: vrf_options.spec",
+		},
+		"status can not be updated": {
+			mask:    &fieldmaskpb.FieldMask{Paths: []string{"status"}},
+			in:      &pa.VrfOptions{Name: testOptionsName, Spec: &pa.VrfOptionsSpec{}},
+			errCode: codes.InvalidArgument,
+			errMsg:  "field status can not be updated",
+		},
+		"stored until the vrf is created": {
+			in: &pa.VrfOptions{Name: testOptionsName, Spec: &pa.VrfOptionsSpec{
+				Vxlan: &pa.VxlanOptions{DstPort: proto.Uint32(4790)},
+			}},
+			out: &pa.VrfOptions{
+				Name: testOptionsName,
+				Spec: &pa.VrfOptionsSpec{
+					Vxlan: &pa.VxlanOptions{DstPort: proto.Uint32(4790)},
+				},
+				Status: &pa.VrfOptionsStatus{},
+			},
+			errCode: codes.OK,
+		},
+		"unchanged options keep the device": {
+			in: &pa.VrfOptions{Name: testOptionsName, Spec: &pa.VrfOptionsSpec{}},
+			out: &pa.VrfOptions{
+				Name:   testOptionsName,
+				Spec:   &pa.VrfOptionsSpec{},
+				Status: &pa.VrfOptionsStatus{Vxlan: testVxlanStatus, Evpn: testEvpnStatus},
+			},
+			errCode: codes.OK,
+			exist:   true,
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni1000").Return(testVxlan, nil).Once()
+				mockFrr.EXPECT().FrrBgpCmd(mock.Anything, utils.EvpnShowCommand(1000)).Return(testEvpnShow, nil).Once()
+			},
+		},
+		"vxlan options replace the device": {
+			mask: &fieldmaskpb.FieldMask{Paths: []string{"spec.vxlan"}},
+			in: &pa.VrfOptions{Name: testOptionsName, Spec: &pa.VrfOptionsSpec{
+				Vxlan: &pa.VxlanOptions{DstPort: proto.Uint32(4790), UdpCsum: true, SrcPortLow: 49152, SrcPortHigh: 65535},
+			}},
+			out: &pa.VrfOptions{
+				Name: testOptionsName,
+				Spec: &pa.VrfOptionsSpec{
+					Vxlan: &pa.VxlanOptions{DstPort: proto.Uint32(4790), UdpCsum: true, SrcPortLow: 49152, SrcPortHigh: 65535},
+				},
+				Status: &pa.VrfOptionsStatus{
+					Vxlan: &pa.VxlanOptions{DstPort: proto.Uint32(4790), UdpCsum: true, SrcPortLow: 49152, SrcPortHigh: 65535},
+					Evpn:  testEvpnStatus,
+				},
+			},
+			errCode: codes.OK,
+			exist:   true,
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				// delete the old device
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni1000").Return(testVxlan, nil).Once()
+				mockNetlink.EXPECT().LinkSetDown(mock.Anything, testVxlan).Return(nil).Once()
+				mockNetlink.EXPECT().LinkDel(mock.Anything, testVxlan).Return(nil).Once()
+				// create the new one
+				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: "br1000"}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "br1000").Return(bridge, nil).Once()
+				vxlan := &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: "vni1000"}, VxlanId: 1000, Port: 4790, UDPCSum: true, PortLow: 49152, PortHigh: 65535, SrcAddr: net.IP{10, 0, 0, 2}}
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, vxlan).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetMaster(mock.Anything, vxlan, bridge).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetUp(mock.Anything, vxlan).Return(nil).Once()
+				// read the status
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni1000").Return(vxlan, nil).Once()
+				mockFrr.EXPECT().FrrBgpCmd(mock.Anything, utils.EvpnShowCommand(1000)).Return(testEvpnShow, nil).Once()
+			},
+		},
+		"invalid vxlan options": {
+			in: &pa.VrfOptions{Name: testOptionsName, Spec: &pa.VrfOptionsSpec{
+				Vxlan: &pa.VxlanOptions{Ttl: 256},
+			}},
+			errCode: codes.InvalidArgument,
+			errMsg:  "vxlan.ttl value (256) have to be between 0 and 255",
+		},
+		"invalid merged vxlan options": {
+			mask: &fieldmaskpb.FieldMask{Paths: []string{"spec.vxlan.tos_inherit"}},
+			in: &pa.VrfOptions{Name: testOptionsName, Spec: &pa.VrfOptionsSpec{
+				Vxlan: &pa.VxlanOptions{TosInherit: true},
+			}},
+			stored: &pa.VrfOptions{Name: testOptionsName, Spec: &pa.VrfOptionsSpec{
+				Vxlan: &pa.VxlanOptions{Tos: 16},
+			}},
+			errCode: codes.InvalidArgument,
+			errMsg:  "vxlan.tos (16) can not be set together with vxlan.tos_inherit",
+		},
+		"invalid evpn options": {
+			in: &pa.VrfOptions{Name: testOptionsName, Spec: &pa.VrfOptionsSpec{
+				Evpn: &pa.EvpnOptions{Rd: "10.0.0.2:65536"},
+			}},
+			errCode: codes.InvalidArgument,
+			errMsg:  "evpn.rd value (10.0.0.2:65536) has to be ASN:NN or IPv4:NN",
+		},
+		"evpn options change the BGP instance": {
+			mask: &fieldmaskpb.FieldMask{Paths: []string{"spec.evpn"}},
+			in: &pa.VrfOptions{Name: testOptionsName, Spec: &pa.VrfOptionsSpec{
+				Evpn: &pa.EvpnOptions{Rd: "65000:1000", ImportRts: []string{"65000:1000"}, ExportRts: []string{"65000:1000"}},
+			}},
+			stored: &pa.VrfOptions{Name: testOptionsName, Spec: &pa.VrfOptionsSpec{
+				Evpn: &pa.EvpnOptions{ImportRts: []string{"65000:999"}},
+			}},
+			out: &pa.VrfOptions{
+				Name: testOptionsName,
+				Spec: &pa.VrfOptionsSpec{
+					Evpn: &pa.EvpnOptions{Rd: "65000:1000", ImportRts: []string{"65000:1000"}, ExportRts: []string{"65000:1000"}},
+				},
+				Status: &pa.VrfOptionsStatus{Vxlan: testVxlanStatus, Evpn: testEvpnStatus},
+			},
+			errCode: codes.OK,
+			exist:   true,
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				command := mock.MatchedBy(func(command string) bool {
+					return strings.Contains(command, " vrf opi-vrf8\n") &&
+						strings.Contains(command, "address-family l2vpn evpn\n\t\t\t\trd 65000:1000\n\t\t\t\tno route-target import 65000:999\n\t\t\t\troute-target import 65000:1000\n\t\t\t\troute-target export 65000:1000\n")
+				})
+				mockFrr.EXPECT().FrrBgpCmd(mock.Anything, command).Return("", nil).Once()
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni1000").Return(testVxlan, nil).Once()
+				mockFrr.EXPECT().FrrBgpCmd(mock.Anything, utils.EvpnShowCommand(1000)).Return(testEvpnShow, nil).Once()
+			},
+		},
+		"failed LinkAdd call": {
+			in: &pa.VrfOptions{Name: testOptionsName, Spec: &pa.VrfOptionsSpec{
+				Vxlan: &pa.VxlanOptions{Ttl: 64},
+			}},
+			errCode: codes.Unknown,
+			errMsg:  "Failed to call LinkAdd",
+			exist:   true,
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni1000").Return(testVxlan, nil).Once()
+				mockNetlink.EXPECT().LinkSetDown(mock.Anything, testVxlan).Return(nil).Once()
+				mockNetlink.EXPECT().LinkDel(mock.Anything, testVxlan).Return(nil).Once()
+				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: "br1000"}}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "br1000").Return(bridge, nil).Once()
+				vxlan := &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: "vni1000"}, VxlanId: 1000, Port: 4789, TTL: 64, SrcAddr: net.IP{10, 0, 0, 2}}
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, vxlan).Return(errors.New(errMsg)).Once()
+				// the old device comes back
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni1000").Return(nil, errors.New("Link not found")).Once()
+				old := &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: "vni1000"}, VxlanId: 1000, Port: 4789, SrcAddr: net.IP{10, 0, 0, 2}}
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, old).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetMaster(mock.Anything, old, bridge).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetUp(mock.Anything, old).Return(nil).Once()
+			},
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pa.NewVrfOptionsServiceClient(env.conn)
+
+			if tt.exist {
+				_ = env.opi.store.Set(testVrfName, &testVrfWithStatus)
+			}
+			if tt.stored != nil {
+				_ = env.opi.store.Set(tt.stored.Name, tt.stored)
+			}
+			if tt.on != nil {
+				tt.on(env.mockNetlink, env.mockFrr, tt.errMsg)
+			}
+
+			request := &pa.UpdateVrfOptionsRequest{VrfOptions: tt.in, UpdateMask: tt.mask}
+			response, err := client.UpdateVrfOptions(ctx, request)
+			if !proto.Equal(tt.out, response) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+		})
+	}
+}
+
+func Test_GetVrfOptions(t *testing.T) {
+	tests := map[string]struct {
+		in      string
+		out     *pa.VrfOptions
+		errCode codes.Code
+		errMsg  string
+		on      func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string)
+	}{
+		"defaults": {
+			in: testOptionsName,
+			out: &pa.VrfOptions{
+				Name:   testOptionsName,
+				Spec:   &pa.VrfOptionsSpec{},
+				Status: &pa.VrfOptionsStatus{Vxlan: testVxlanStatus, Evpn: testEvpnStatus},
+			},
+			errCode: codes.OK,
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni1000").Return(testVxlan, nil).Once()
+				mockFrr.EXPECT().FrrBgpCmd(mock.Anything, utils.EvpnShowCommand(1000)).Return(testEvpnShow, nil).Once()
+			},
+		},
+		"tos inherit": {
+			in: testOptionsName,
+			out: &pa.VrfOptions{
+				Name:   testOptionsName,
+				Spec:   &pa.VrfOptionsSpec{},
+				Status: &pa.VrfOptionsStatus{Vxlan: &pa.VxlanOptions{DstPort: proto.Uint32(4789), TosInherit: true}, Evpn: testEvpnStatus},
+			},
+			errCode: codes.OK,
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				vxlan := &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: "vni1000"}, VxlanId: 1000, Port: 4789, TOS: 1}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni1000").Return(vxlan, nil).Once()
+				mockFrr.EXPECT().FrrBgpCmd(mock.Anything, utils.EvpnShowCommand(1000)).Return(testEvpnShow, nil).Once()
+			},
+		},
+		"failed LinkByName call": {
+			in:      testOptionsName,
+			errCode: codes.NotFound,
+			errMsg:  "unable to find key vni1000",
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, "vni1000").Return(nil, errors.New(errMsg)).Once()
+			},
+		},
+		"invalid name": {
+			in:      resourceIDToFullName("blue/unknown"),
+			errCode: codes.InvalidArgument,
+			errMsg:  fmt.Sprintf("name %s does not match %s", resourceIDToFullName("blue/unknown"), optionsPattern),
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pa.NewVrfOptionsServiceClient(env.conn)
+
+			_ = env.opi.store.Set(testVrfName, &testVrfWithStatus)
+			if tt.on != nil {
+				tt.on(env.mockNetlink, env.mockFrr, tt.errMsg)
+			}
+
+			request := &pa.GetVrfOptionsRequest{Name: tt.in}
+			response, err := client.GetVrfOptions(ctx, request)
+			if !proto.Equal(tt.out, response) {
+				t.Error("response: expected", tt.out, "received", response)
+			}
+
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+		})
+	}
+}
+
+func Test_L3VniSingleVxlan(t *testing.T) {
+	cfg := *config.GetConfig()
+	t.Cleanup(func() { config.SetConfig(cfg) })
+	changed := cfg
+	changed.Linux.VxlanMode = config.SingleVxlan
+	config.SetConfig(changed)
+	errMsg := "the L3 VNI needs a vxlan dst_port other than 4789 of vxlan0 in linux.vxlanmode single"
+
+	ctx := context.Background()
+	env := newTestEnv(ctx, t)
+	defer env.Close()
+
+	// the L3 VNI device would fail to come up next to the shared device
+	vrfClient := pb.NewVrfServiceClient(env.conn)
+	_, err := vrfClient.CreateVrf(ctx, &pb.CreateVrfRequest{Vrf: utils.ProtoClone(&testVrf), VrfId: testVrfID})
+	if er, ok := status.FromError(err); !ok || er.Code() != codes.FailedPrecondition || er.Message() != errMsg {
+		t.Error("create: expected", errMsg, "received", err)
+	}
+
+	// nor can an existing VRF move to the port of the shared device
+	_ = env.opi.store.Set(testVrfName, &testVrfWithStatus)
+	_ = env.opi.store.Set(testOptionsName, &pa.VrfOptions{Name: testOptionsName, Spec: &pa.VrfOptionsSpec{
+		Vxlan: &pa.VxlanOptions{DstPort: proto.Uint32(4790)},
+	}})
+	optionsClient := pa.NewVrfOptionsServiceClient(env.conn)
+	request := &pa.UpdateVrfOptionsRequest{VrfOptions: &pa.VrfOptions{Name: testOptionsName, Spec: &pa.VrfOptionsSpec{
+		Vxlan: &pa.VxlanOptions{DstPort: proto.Uint32(4789)},
+	}}}
+	_, err = optionsClient.UpdateVrfOptions(ctx, request)
+	if er, ok := status.FromError(err); !ok || er.Code() != codes.FailedPrecondition || er.Message() != errMsg {
+		t.Error("update options: expected", errMsg, "received", err)
+	}
+}
@@ -5,15 +5,26 @@
 package vrf
 
 import (
+	"fmt"
+	"strings"
+
 	"go.einride.tech/aip/fieldbehavior"
 	"go.einride.tech/aip/fieldmask"
 	"go.einride.tech/aip/resourceid"
 	"go.einride.tech/aip/resourcename"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
 )
 
-func (s *Server) validateCreateVrfRequest(in *pb.CreateVrfRequest) error {
+// ValidateCreateVrfRequest checks a create request without executing it
+func (s *Server) ValidateCreateVrfRequest(in *pb.CreateVrfRequest) error {
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
 		return err
@@ -58,3 +69,74 @@ func (s *Server) validateGetVrfRequest(in *pb.GetVrfRequest) error {
 	// Validate that a resource name conforms to the restrictions outlined in AIP-122.
 	return resourcename.Validate(in.Name)
 }
+
+// ValidateUpdateVrfOptionsRequest checks an options update without executing it
+func (s *Server) ValidateUpdateVrfOptionsRequest(in *pa.UpdateVrfOptionsRequest) error {
+	// check required fields
+	if in.VrfOptions == nil {
+		return status.Error(codes.InvalidArgument, "missing required field: vrf_options")
+	}
+	if in.VrfOptions.Spec == nil {
+		return status.Error(codes.InvalidArgument, "missing required field: vrf_options.spec")
+	}
+	// update_mask = 2
+	if err := fieldmask.Validate(in.UpdateMask, in.VrfOptions); err != nil {
+		return err
+	}
+	for _, path := range in.UpdateMask.GetPaths() {
+		if path != "spec" && !strings.HasPrefix(path, "spec.") {
+			return status.Errorf(codes.InvalidArgument, "field %s can not be updated", path)
+		}
+	}
+	return validateOptionsName(in.VrfOptions.Name)
+}
+
+// validateOptionsName checks that name is a VRF name followed by /options
+func validateOptionsName(name string) error {
+	if name == "" {
+		return status.Error(codes.InvalidArgument, "missing required field: name")
+	}
+	if err := resourcename.Validate(name); err != nil {
+		return err
+	}
+	id := strings.TrimSuffix(strings.TrimPrefix(name, "//network.opiproject.org/vrfs/"), "/options")
+	if id == "" || strings.Contains(id, "/") || name != optionsName(resourceIDToFullName(id)) {
+		return status.Errorf(codes.InvalidArgument, "name %s does not match %s", name, optionsPattern)
+	}
+	return nil
+}
+
+// checkL3VxlanPort rejects the L3 VNI device on the UDP port of the shared VXLAN
+// device of the single VXLAN device mode, the kernel can not bind both to it
+func checkL3VxlanPort(options *pa.VrfOptions) error {
+	linux := config.GetConfig().Linux
+	if linux.VxlanMode != config.SingleVxlan {
+		return nil
+	}
+	if vxlan := options.Spec.GetVxlan(); vxlan != nil && vxlan.DstPort != nil && int(vxlan.GetDstPort()) != linux.VxlanPort {
+		return nil
+	}
+	return status.Errorf(codes.FailedPrecondition, "the L3 VNI needs a vxlan dst_port other than %d of %s in linux.vxlanmode %v",
+		linux.VxlanPort, linux.VxlanDevice, config.SingleVxlan)
+}
+
+// checkStaticRoutes rejects deleting a VRF while static routes go through it,
+// their kernel routes go with the table of the VRF and are not added again
+func (s *Server) checkStaticRoutes(obj *pb.Vrf) error {
+	for _, key := range utils.StoreKeys(s.store) {
+		if !strings.HasPrefix(key, "//network.opiproject.org/routes/") {
+			continue
+		}
+		route := new(pa.StaticRoute)
+		ok, err := s.store.Get(key, route)
+		if err != nil {
+			fmt.Printf("Failed to interact with store: %v", err)
+			return err
+		}
+		if ok && route.Spec.GetVrf() == obj.Name {
+			err := status.Errorf(codes.FailedPrecondition, "VRF %s is used by static route %s, delete the route first", obj.Name, key)
+			return err
+		}
+	}
+	return nil
+}
@@ -16,8 +16,11 @@ import (
 
 	"github.com/stretchr/testify/mock"
 	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -26,6 +29,7 @@ import (
 	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
 	pc "github.com/opiproject/opi-api/network/opinetcommon/v1alpha1/gen/go"
 
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
 	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
 	"github.com/opiproject/opi-evpn-bridge/pkg/utils/mocks"
 )
@@ -141,6 +145,9 @@ func Test_CreateVrf(t *testing.T) {
 				vrf := &netlink.Vrf{LinkAttrs: netlink.LinkAttrs{Name: testVrfID}, Table: 1000}
 				mockNetlink.EXPECT().LinkAdd(mock.Anything, vrf).Return(nil).Once()
 				mockNetlink.EXPECT().LinkSetUp(mock.Anything, vrf).Return(nil).Once()
+				for _, route := range throwRoutes(1000) {
+					mockNetlink.EXPECT().RouteAdd(mock.Anything, route).Return(nil).Once()
+				}
 				// frr
 				mockFrr.EXPECT().FrrZebraCmd(mock.Anything, mock.Anything).Return("", nil).Once()
 			},
@@ -170,6 +177,20 @@ func Test_CreateVrf(t *testing.T) {
 				mockNetlink.EXPECT().LinkSetUp(mock.Anything, vrf).Return(errors.New(errMsg)).Once()
 			},
 		},
+		"failed RouteAdd call": {
+			id:      testVrfID,
+			in:      &testVrf,
+			out:     nil,
+			errCode: codes.Unknown,
+			errMsg:  "Failed to call RouteAdd",
+			exist:   false,
+			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+				vrf := &netlink.Vrf{LinkAttrs: netlink.LinkAttrs{Name: testVrfID}, Table: 1001}
+				mockNetlink.EXPECT().LinkAdd(mock.Anything, vrf).Return(nil).Once()
+				mockNetlink.EXPECT().LinkSetUp(mock.Anything, vrf).Return(nil).Once()
+				mockNetlink.EXPECT().RouteAdd(mock.Anything, throwRoutes(1001)[0]).Return(errors.New(errMsg)).Once()
+			},
+		},
 		"failed bridge LinkAdd call": {
 			id:      testVrfID,
 			in:      &testVrf,
@@ -183,6 +204,9 @@ func Test_CreateVrf(t *testing.T) {
 				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: bridgeName}}
 				mockNetlink.EXPECT().LinkAdd(mock.Anything, vrf).Return(nil).Once()
 				mockNetlink.EXPECT().LinkSetUp(mock.Anything, vrf).Return(nil).Once()
+				for _, route := range throwRoutes(1001) {
+					mockNetlink.EXPECT().RouteAdd(mock.Anything, route).Return(nil).Once()
+				}
 				mockNetlink.EXPECT().LinkAdd(mock.Anything, bridge).Return(errors.New(errMsg)).Once()
 			},
 		},
@@ -199,6 +223,9 @@ func Test_CreateVrf(t *testing.T) {
 				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: bridgeName}}
 				mockNetlink.EXPECT().LinkAdd(mock.Anything, vrf).Return(nil).Once()
 				mockNetlink.EXPECT().LinkSetUp(mock.Anything, vrf).Return(nil).Once()
+				for _, route := range throwRoutes(1001) {
+					mockNetlink.EXPECT().RouteAdd(mock.Anything, route).Return(nil).Once()
+				}
 				mockNetlink.EXPECT().LinkAdd(mock.Anything, bridge).Return(nil).Once()
 				mockNetlink.EXPECT().LinkSetMaster(mock.Anything, bridge, vrf).Return(errors.New(errMsg)).Once()
 			},
@@ -216,6 +243,9 @@ func Test_CreateVrf(t *testing.T) {
 				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: bridgeName}}
 				mockNetlink.EXPECT().LinkAdd(mock.Anything, vrf).Return(nil).Once()
 				mockNetlink.EXPECT().LinkSetUp(mock.Anything, vrf).Return(nil).Once()
+				for _, route := range throwRoutes(1001) {
+					mockNetlink.EXPECT().RouteAdd(mock.Anything, route).Return(nil).Once()
+				}
 				mockNetlink.EXPECT().LinkAdd(mock.Anything, bridge).Return(nil).Once()
 				mockNetlink.EXPECT().LinkSetMaster(mock.Anything, bridge, vrf).Return(nil).Once()
 				mockNetlink.EXPECT().LinkSetHardwareAddr(mock.Anything, bridge, mock.Anything).Return(errors.New(errMsg)).Once()
@@ -234,6 +264,9 @@ func Test_CreateVrf(t *testing.T) {
 				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: bridgeName}}
 				mockNetlink.EXPECT().LinkAdd(mock.Anything, vrf).Return(nil).Once()
 				mockNetlink.EXPECT().LinkSetUp(mock.Anything, vrf).Return(nil).Once()
+				for _, route := range throwRoutes(1001) {
+					mockNetlink.EXPECT().RouteAdd(mock.Anything, route).Return(nil).Once()
+				}
 				mockNetlink.EXPECT().LinkAdd(mock.Anything, bridge).Return(nil).Once()
 				mockNetlink.EXPECT().LinkSetMaster(mock.Anything, bridge, vrf).Return(nil).Once()
 				mockNetlink.EXPECT().LinkSetHardwareAddr(mock.Anything, bridge, mock.Anything).Return(nil).Once()
@@ -253,6 +286,9 @@ func Test_CreateVrf(t *testing.T) {
 				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: bridgeName}}
 				mockNetlink.EXPECT().LinkAdd(mock.Anything, vrf).Return(nil).Once()
 				mockNetlink.EXPECT().LinkSetUp(mock.Anything, vrf).Return(nil).Once()
+				for _, route := range throwRoutes(1001) {
+					mockNetlink.EXPECT().RouteAdd(mock.Anything, route).Return(nil).Once()
+				}
 				mockNetlink.EXPECT().LinkAdd(mock.Anything, bridge).Return(nil).Once()
 				mockNetlink.EXPECT().LinkSetMaster(mock.Anything, bridge, vrf).Return(nil).Once()
 				mockNetlink.EXPECT().LinkSetHardwareAddr(mock.Anything, bridge, mock.Anything).Return(nil).Once()
@@ -277,6 +313,9 @@ func Test_CreateVrf(t *testing.T) {
 				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: bridgeName}}
 				mockNetlink.EXPECT().LinkAdd(mock.Anything, vrf).Return(nil).Once()
 				mockNetlink.EXPECT().LinkSetUp(mock.Anything, vrf).Return(nil).Once()
+				for _, route := range throwRoutes(1001) {
+					mockNetlink.EXPECT().RouteAdd(mock.Anything, route).Return(nil).Once()
+				}
 				mockNetlink.EXPECT().LinkAdd(mock.Anything, bridge).Return(nil).Once()
 				mockNetlink.EXPECT().LinkSetMaster(mock.Anything, bridge, vrf).Return(nil).Once()
 				mockNetlink.EXPECT().LinkSetHardwareAddr(mock.Anything, bridge, mock.Anything).Return(nil).Once()
@@ -302,6 +341,9 @@ func Test_CreateVrf(t *testing.T) {
 				bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: bridgeName}}
 				mockNetlink.EXPECT().LinkAdd(mock.Anything, vrf).Return(nil).Once()
 				mockNetlink.EXPECT().LinkSetUp(mock.Anything, vrf).Return(nil).Once()
+				for _, route := range throwRoutes(1001) {
+					mockNetlink.EXPECT().RouteAdd(mock.Anything, route).Return(nil).Once()
+				}
 				mockNetlink.EXPECT().LinkAdd(mock.Anything, bridge).Return(nil).Once()
 				mockNetlink.EXPECT().LinkSetMaster(mock.Anything, bridge, vrf).Return(nil).Once()
 				mockNetlink.EXPECT().LinkSetHardwareAddr(mock.Anything, bridge, mock.Anything).Return(nil).Once()
@@ -360,13 +402,118 @@ func Test_CreateVrf(t *testing.T) {
 	}
 }
 
+func Test_CreateVrfDryRun(t *testing.T) {
+	tests := map[string]struct {
+		errCode codes.Code
+		errMsg  string
+		netlink []string
+		frr     []string
+		on      func(mockNetlink *mocks.Netlink)
+	}{
+		"records changes": {
+			errCode: codes.OK,
+			netlink: []string{
+				"ip link add opi-vrf8 type vrf table 1001",
+				"ip link set opi-vrf8 up",
+				"ip route add throw default table 1001 proto 108 metric 9999",
+				"ip -6 route add throw default table 1001 proto 108 metric 9999",
+				"ip link add br1000 type bridge",
+				"ip link set br1000 master opi-vrf8",
+			},
+			frr: []string{
+				"zebra: configure terminal",
+				"zebra: vrf opi-vrf8",
+				"zebra: vni 1000",
+			},
+			on: func(mockNetlink *mocks.Netlink) {
+				mockNetlink.EXPECT().LinkByName(mock.Anything, mock.Anything).Return(nil, errors.New("Link not found"))
+			},
+		},
+		"link already exists": {
+			errCode: codes.Unknown,
+			errMsg:  "link opi-vrf8 already exists",
+			on: func(mockNetlink *mocks.Netlink) {
+				vrf := &netlink.Vrf{LinkAttrs: netlink.LinkAttrs{Name: testVrfID}, Table: 1001}
+				mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(vrf, nil).Once()
+			},
+		},
+	}
+
+	// run tests
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			env := newTestEnv(ctx, t)
+			defer env.Close()
+			client := pb.NewVrfServiceClient(env.conn)
+
+			tt.on(env.mockNetlink)
+
+			var header metadata.MD
+			ctx = metadata.AppendToOutgoingContext(ctx, utils.DryRunHeader, "true")
+			request := &pb.CreateVrfRequest{Vrf: utils.ProtoClone(&testVrf), VrfId: testVrfID}
+			_, err := client.CreateVrf(ctx, request, grpc.Header(&header))
+			if er, ok := status.FromError(err); ok {
+				if er.Code() != tt.errCode {
+					t.Error("error code: expected", tt.errCode, "received", er.Code())
+				}
+				if er.Message() != tt.errMsg {
+					t.Error("error message: expected", tt.errMsg, "received", er.Message())
+				}
+			} else {
+				t.Error("expected grpc error status")
+			}
+			operations := header.Get(utils.DryRunNetlinkHeader)
+			if len(operations) < len(tt.netlink) || !reflect.DeepEqual(operations[:len(tt.netlink)], tt.netlink) {
+				t.Error("netlink operations: expected", tt.netlink, "received", operations)
+			}
+			commands := header.Get(utils.DryRunFrrHeader)
+			if len(commands) < len(tt.frr) || !reflect.DeepEqual(commands[:len(tt.frr)], tt.frr) {
+				t.Error("FRR commands: expected", tt.frr, "received", commands)
+			}
+			// nothing is saved
+			ok, _ := env.opi.store.Get(testVrfName, new(pb.Vrf))
+			if ok || len(env.opi.ListHelper) != 0 {
+				t.Error("expected dry run to leave the store untouched")
+			}
+		})
+	}
+}
+
 func Test_DeleteVrf(t *testing.T) {
+	const testRouteName = "//network.opiproject.org/routes/default"
+	successfulDelete := func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+		myip := make(net.IP, 4)
+		binary.BigEndian.PutUint32(myip, 167772162)
+		vxlanName := fmt.Sprintf("vni%d", *testVrf.Spec.Vni)
+		vxlan := &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: vxlanName}, VxlanId: int(*testVrf.Spec.Vni), Port: 4789, Learning: false, SrcAddr: myip}
+		mockNetlink.EXPECT().LinkByName(mock.Anything, vxlanName).Return(vxlan, nil).Once()
+		mockNetlink.EXPECT().LinkSetDown(mock.Anything, vxlan).Return(nil).Once()
+		mockNetlink.EXPECT().LinkDel(mock.Anything, vxlan).Return(nil).Once()
+		bridgeName := fmt.Sprintf("br%d", *testVrf.Spec.Vni)
+		bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: bridgeName}}
+		mockNetlink.EXPECT().LinkByName(mock.Anything, bridgeName).Return(bridge, nil).Once()
+		mockNetlink.EXPECT().LinkSetDown(mock.Anything, bridge).Return(nil).Once()
+		mockNetlink.EXPECT().LinkDel(mock.Anything, bridge).Return(nil).Once()
+		vrf := &netlink.Vrf{LinkAttrs: netlink.LinkAttrs{Name: testVrfID}, Table: 1001}
+		mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(vrf, nil).Once()
+		for _, route := range throwRoutes(1001) {
+			mockNetlink.EXPECT().RouteDel(mock.Anything, route).Return(nil).Once()
+		}
+		mockNetlink.EXPECT().LinkSetDown(mock.Anything, vrf).Return(nil).Once()
+		mockNetlink.EXPECT().LinkDel(mock.Anything, vrf).Return(nil).Once()
+		// frr
+		mockFrr.EXPECT().FrrBgpCmd(mock.Anything, mock.Anything).Return("", nil).Once()
+		mockFrr.EXPECT().FrrZebraCmd(mock.Anything, mock.Anything).Return("", nil).Once()
+	}
 	tests := map[string]struct {
 		in      string
 		out     *emptypb.Empty
 		errCode codes.Code
 		errMsg  string
 		missing bool
+		etag    string
+		route   bool
 		on      func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string)
 	}{
 		"valid request with unknown key": {
@@ -516,11 +663,11 @@ func Test_DeleteVrf(t *testing.T) {
 				mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(nil, errors.New(errMsg)).Once()
 			},
 		},
-		"failed vrf LinkSetDown call": {
+		"failed vrf RouteDel call": {
 			in:      testVrfID,
 			out:     &emptypb.Empty{},
 			errCode: codes.Unknown,
-			errMsg:  "Failed to call LinkSetDown",
+			errMsg:  "Failed to call RouteDel",
 			missing: false,
 			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
 				myip := make(net.IP, 4)
@@ -537,14 +684,14 @@ func Test_DeleteVrf(t *testing.T) {
 				mockNetlink.EXPECT().LinkDel(mock.Anything, bridge).Return(nil).Once()
 				vrf := &netlink.Vrf{LinkAttrs: netlink.LinkAttrs{Name: testVrfID}, Table: 1001}
 				mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(vrf, nil).Once()
-				mockNetlink.EXPECT().LinkSetDown(mock.Anything, vrf).Return(errors.New(errMsg)).Once()
+				mockNetlink.EXPECT().RouteDel(mock.Anything, throwRoutes(1001)[0]).Return(errors.New(errMsg)).Once()
 			},
 		},
-		"failed vrf LinkDel call": {
+		"failed vrf LinkSetDown call": {
 			in:      testVrfID,
 			out:     &emptypb.Empty{},
 			errCode: codes.Unknown,
-			errMsg:  "Failed to call LinkDel",
+			errMsg:  "Failed to call LinkSetDown",
 			missing: false,
 			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
 				myip := make(net.IP, 4)
@@ -561,15 +708,17 @@ func Test_DeleteVrf(t *testing.T) {
 				mockNetlink.EXPECT().LinkDel(mock.Anything, bridge).Return(nil).Once()
 				vrf := &netlink.Vrf{LinkAttrs: netlink.LinkAttrs{Name: testVrfID}, Table: 1001}
 				mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(vrf, nil).Once()
-				mockNetlink.EXPECT().LinkSetDown(mock.Anything, vrf).Return(nil).Once()
-				mockNetlink.EXPECT().LinkDel(mock.Anything, vrf).Return(errors.New(errMsg)).Once()
+				for _, route := range throwRoutes(1001) {
+					mockNetlink.EXPECT().RouteDel(mock.Anything, route).Return(nil).Once()
+				}
+				mockNetlink.EXPECT().LinkSetDown(mock.Anything, vrf).Return(errors.New(errMsg)).Once()
 			},
 		},
-		"successful call": {
+		"failed vrf LinkDel call": {
 			in:      testVrfID,
 			out:     &emptypb.Empty{},
-			errCode: codes.OK,
-			errMsg:  "",
+			errCode: codes.Unknown,
+			errMsg:  "Failed to call LinkDel",
 			missing: false,
 			on: func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
 				myip := make(net.IP, 4)
@@ -586,13 +735,48 @@ func Test_DeleteVrf(t *testing.T) {
 				mockNetlink.EXPECT().LinkDel(mock.Anything, bridge).Return(nil).Once()
 				vrf := &netlink.Vrf{LinkAttrs: netlink.LinkAttrs{Name: testVrfID}, Table: 1001}
 				mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(vrf, nil).Once()
+				for _, route := range throwRoutes(1001) {
+					mockNetlink.EXPECT().RouteDel(mock.Anything, route).Return(nil).Once()
+				}
 				mockNetlink.EXPECT().LinkSetDown(mock.Anything, vrf).Return(nil).Once()
-				mockNetlink.EXPECT().LinkDel(mock.Anything, vrf).Return(nil).Once()
-				// frr
-				mockFrr.EXPECT().FrrBgpCmd(mock.Anything, mock.Anything).Return("", nil).Once()
-				mockFrr.EXPECT().FrrZebraCmd(mock.Anything, mock.Anything).Return("", nil).Once()
+				mockNetlink.EXPECT().LinkDel(mock.Anything, vrf).Return(errors.New(errMsg)).Once()
 			},
 		},
+		"successful call": {
+			in:      testVrfID,
+			out:     &emptypb.Empty{},
+			errCode: codes.OK,
+			errMsg:  "",
+			missing: false,
+			on:      successfulDelete,
+		},
+		"used by static route": {
+			in:      testVrfID,
+			out:     nil,
+			errCode: codes.FailedPrecondition,
+			errMsg:  fmt.Sprintf("VRF %v is used by static route %v, delete the route first", testVrfName, testRouteName),
+			missing: false,
+			route:   true,
+			on:      nil,
+		},
+		"stale etag": {
+			in:      testVrfID,
+			out:     nil,
+			errCode: codes.Aborted,
+			errMsg:  fmt.Sprintf("etag %v does not match the current etag %v of %v, re-read the resource and retry", "0123456789abcdef", utils.Etag(&testVrfWithStatus), testVrfName),
+			missing: false,
+			etag:    "0123456789abcdef",
+			on:      nil,
+		},
+		"current etag": {
+			in:      testVrfID,
+			out:     &emptypb.Empty{},
+			errCode: codes.OK,
+			errMsg:  "",
+			missing: false,
+			etag:    utils.Etag(&testVrfWithStatus),
+			on:      successfulDelete,
+		},
 	}
 
 	// run tests
@@ -605,9 +789,16 @@ func Test_DeleteVrf(t *testing.T) {
 
 			fname1 := resourceIDToFullName(tt.in)
 			_ = env.opi.store.Set(testVrfName, &testVrfWithStatus)
+			if tt.route {
+				route := &pa.StaticRoute{Name: testRouteName, Spec: &pa.StaticRouteSpec{Vrf: testVrfName}}
+				_ = env.opi.store.Set(testRouteName, route)
+			}
 			if tt.on != nil {
 				tt.on(env.mockNetlink, env.mockFrr, tt.errMsg)
 			}
+			if tt.etag != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, utils.EtagHeader, tt.etag)
+			}
 
 			request := &pb.DeleteVrfRequest{Name: fname1, AllowMissing: tt.missing}
 			response, err := client.DeleteVrf(ctx, request)
@@ -630,6 +821,50 @@ func Test_DeleteVrf(t *testing.T) {
 	}
 }
 
+func Test_VrfsSharingTable(t *testing.T) {
+	ctx := context.Background()
+	env := newTestEnv(ctx, t)
+	defer env.Close()
+	client := pb.NewVrfServiceClient(env.conn)
+
+	// VRFs without VNI all use table 1000, the second one finds the routes
+	spec := &pb.VrfSpec{LoopbackIpPrefix: &pc.IPPrefix{Len: 24}}
+	blue := &netlink.Vrf{LinkAttrs: netlink.LinkAttrs{Name: "blue"}, Table: 1000}
+	green := &netlink.Vrf{LinkAttrs: netlink.LinkAttrs{Name: "green"}, Table: 1000}
+	env.mockNetlink.EXPECT().LinkAdd(mock.Anything, blue).Return(nil).Once()
+	env.mockNetlink.EXPECT().LinkSetUp(mock.Anything, blue).Return(nil).Once()
+	env.mockNetlink.EXPECT().LinkAdd(mock.Anything, green).Return(nil).Once()
+	env.mockNetlink.EXPECT().LinkSetUp(mock.Anything, green).Return(nil).Once()
+	for _, route := range throwRoutes(1000) {
+		env.mockNetlink.EXPECT().RouteAdd(mock.Anything, route).Return(nil).Once()
+		env.mockNetlink.EXPECT().RouteAdd(mock.Anything, route).Return(unix.EEXIST).Once()
+	}
+	env.mockFrr.EXPECT().FrrZebraCmd(mock.Anything, mock.Anything).Return("", nil).Twice()
+	for _, id := range []string{"blue", "green"} {
+		request := &pb.CreateVrfRequest{Vrf: &pb.Vrf{Spec: spec}, VrfId: id}
+		if _, err := client.CreateVrf(ctx, request); err != nil {
+			t.Fatal("create", id, "failed:", err)
+		}
+	}
+
+	// the routes stay until the last VRF of the table is deleted
+	env.mockNetlink.EXPECT().LinkByName(mock.Anything, "blue").Return(blue, nil).Once()
+	env.mockNetlink.EXPECT().LinkSetDown(mock.Anything, blue).Return(nil).Once()
+	env.mockNetlink.EXPECT().LinkDel(mock.Anything, blue).Return(nil).Once()
+	if _, err := client.DeleteVrf(ctx, &pb.DeleteVrfRequest{Name: resourceIDToFullName("blue")}); err != nil {
+		t.Fatal("delete blue failed:", err)
+	}
+	env.mockNetlink.EXPECT().LinkByName(mock.Anything, "green").Return(green, nil).Once()
+	for _, route := range throwRoutes(1000) {
+		env.mockNetlink.EXPECT().RouteDel(mock.Anything, route).Return(nil).Once()
+	}
+	env.mockNetlink.EXPECT().LinkSetDown(mock.Anything, green).Return(nil).Once()
+	env.mockNetlink.EXPECT().LinkDel(mock.Anything, green).Return(nil).Once()
+	if _, err := client.DeleteVrf(ctx, &pb.DeleteVrfRequest{Name: resourceIDToFullName("green")}); err != nil {
+		t.Fatal("delete green failed:", err)
+	}
+}
+
 func Test_UpdateVrf(t *testing.T) {
 	spec := &pb.VrfSpec{
 		Vni: proto.Uint32(1000),
@@ -637,6 +872,16 @@ func Test_UpdateVrf(t *testing.T) {
 			Len: 24,
 		},
 	}
+	stored := &pb.Vrf{
+		Name:   testVrfName,
+		Spec:   testVrf.Spec,
+		Status: &pb.VrfStatus{LocalAs: 4, RoutingTable: 1001, Rmac: []byte{0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}},
+	}
+	successfulUpdate := func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string) {
+		vrf := &netlink.Vrf{LinkAttrs: netlink.LinkAttrs{Name: testVrfID}, Table: 1001}
+		mockNetlink.EXPECT().LinkByName(mock.Anything, testVrfID).Return(vrf, nil).Once()
+		mockNetlink.EXPECT().LinkModify(mock.Anything, vrf).Return(nil).Once()
+	}
 	tests := map[string]struct {
 		mask    *fieldmaskpb.FieldMask
 		in      *pb.Vrf
@@ -645,6 +890,8 @@ func Test_UpdateVrf(t *testing.T) {
 		errMsg  string
 		start   bool
 		exist   bool
+		etag    string
+		on      func(mockNetlink *mocks.Netlink, mockFrr *mocks.Frr, errMsg string)
 	}{
 		"invalid fieldmask": {
 			mask: &fieldmaskpb.FieldMask{Paths: []string{"*", "author"}},
@@ -670,6 +917,46 @@ func Test_UpdateVrf(t *testing.T) {
 			start:   false,
 			exist:   true,
 		},
+		"stale etag": {
+			mask: nil,
+			in: &pb.Vrf{
+				Name: testVrfName,
+				Spec: spec,
+			},
+			out:     nil,
+			errCode: codes.Aborted,
+			errMsg:  fmt.Sprintf("etag %v does not match the current etag %v of %v, re-read the resource and retry", "0123456789abcdef", utils.Etag(stored), testVrfName),
+			start:   false,
+			exist:   true,
+			etag:    "0123456789abcdef",
+		},
+		"current etag": {
+			mask: nil,
+			in: &pb.Vrf{
+				Name: testVrfName,
+				Spec: spec,
+			},
+			out:     &pb.Vrf{Spec: spec, Status: stored.Status},
+			errCode: codes.OK,
+			errMsg:  "",
+			start:   false,
+			exist:   true,
+			etag:    utils.Etag(stored),
+			on:      successfulUpdate,
+		},
+		"missing etag": {
+			mask: nil,
+			in: &pb.Vrf{
+				Name: testVrfName,
+				Spec: spec,
+			},
+			out:     &pb.Vrf{Spec: spec, Status: stored.Status},
+			errCode: codes.OK,
+			errMsg:  "",
+			start:   false,
+			exist:   true,
+			on:      successfulUpdate,
+		},
 	}
 
 	// run tests
@@ -681,15 +968,26 @@ func Test_UpdateVrf(t *testing.T) {
 			client := pb.NewVrfServiceClient(env.conn)
 
 			if tt.exist {
-				_ = env.opi.store.Set(testVrfName, &testVrfWithStatus)
+				_ = env.opi.store.Set(testVrfName, stored)
 			}
 			if tt.out != nil {
 				tt.out = utils.ProtoClone(tt.out)
 				tt.out.Name = testVrfName
 			}
 
+			if tt.on != nil {
+				tt.on(env.mockNetlink, env.mockFrr, tt.errMsg)
+			}
+			if tt.etag != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, utils.EtagHeader, tt.etag)
+			}
+
+			var header metadata.MD
 			request := &pb.UpdateVrfRequest{Vrf: tt.in, UpdateMask: tt.mask}
-			response, err := client.UpdateVrf(ctx, request)
+			response, err := client.UpdateVrf(ctx, request, grpc.Header(&header))
+			if etags := header.Get(utils.EtagHeader); tt.errCode == codes.OK && (len(etags) == 0 || etags[0] != utils.Etag(tt.out)) {
+				t.Error("etag: expected", utils.Etag(tt.out), "received", header.Get(utils.EtagHeader))
+			}
 			if !proto.Equal(tt.out, response) {
 				t.Error("response: expected", tt.out, "received", response)
 			}
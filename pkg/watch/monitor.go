@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package watch streams change events of the EVPN resources
+package watch
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"path"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils"
+)
+
+// MonitorLinks keeps the oper status of the resources in sync with the state
+// of their netlink devices until ctx is done, every transition is published
+// as MODIFIED event
+func (s *Store) MonitorLinks(ctx context.Context, nLink utils.Netlink) error {
+	updates := make(chan netlink.LinkUpdate, subscriberBuffer)
+	if err := nLink.LinkSubscribe(ctx, updates, ctx.Done()); err != nil {
+		return fmt.Errorf("failed to subscribe to netlink: %w", err)
+	}
+	go func() {
+		for update := range updates {
+			if err := s.linkChanged(update.Attrs().Name, linkUp(update)); err != nil {
+				log.Printf("Failed to update status from link %v: %v", update.Attrs().Name, err)
+			}
+		}
+	}()
+	return nil
+}
+
+// linkUp tells whether the link is administratively up and has carrier
+func linkUp(update netlink.LinkUpdate) bool {
+	if update.Header.Type == unix.RTM_DELLINK || update.Attrs().Flags&net.FlagUp == 0 {
+		return false
+	}
+	switch update.Attrs().OperState {
+	case netlink.OperDown, netlink.OperLowerLayerDown, netlink.OperNotPresent:
+		return false
+	}
+	return true
+}
+
+// linkChanged updates the oper status of the resources backed by the device
+func (s *Store) linkChanged(device string, up bool) error {
+	for _, k := range s.Keys() {
+		obj := NewResource(k)
+		if obj == nil {
+			continue
+		}
+		found, err := s.Get(k, obj)
+		if err != nil {
+			return err
+		}
+		if !found || s.deviceOf(obj) != device || !setOperStatus(obj, up) {
+			continue
+		}
+		log.Printf("Link %v of %v changed, up: %v", device, k, up)
+		if err := s.Set(k, obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deviceOf returns the name of the netlink device backing the resource,
+// following the naming used when the resource is created
+func (s *Store) deviceOf(obj interface{}) string {
+	switch o := obj.(type) {
+	case *pb.Vrf:
+		return path.Base(o.Name)
+	case *pb.LogicalBridge:
+		if o.GetSpec().Vni != nil {
+			return utils.L2VxlanName(o.GetSpec().GetVni())
+		}
+	case *pb.BridgePort:
+		return path.Base(o.Name)
+	case *pb.Svi:
+		bridge := new(pb.LogicalBridge)
+		if found, err := s.Get(o.GetSpec().GetLogicalBridge(), bridge); err == nil && found {
+			return fmt.Sprintf("vlan%d", bridge.GetSpec().GetVlanId())
+		}
+	}
+	return ""
+}
+
+// setOperStatus sets the oper status and tells whether it changed
+func setOperStatus(obj interface{}, up bool) bool {
+	switch o := obj.(type) {
+	case *pb.Vrf:
+		value := pb.VRFOperStatus_VRF_OPER_STATUS_DOWN
+		if up {
+			value = pb.VRFOperStatus_VRF_OPER_STATUS_UP
+		}
+		if o.Status == nil {
+			o.Status = &pb.VrfStatus{}
+		}
+		changed := o.Status.OperStatus != value
+		o.Status.OperStatus = value
+		return changed
+	case *pb.LogicalBridge:
+		value := pb.LBOperStatus_LB_OPER_STATUS_DOWN
+		if up {
+			value = pb.LBOperStatus_LB_OPER_STATUS_UP
+		}
+		if o.Status == nil {
+			o.Status = &pb.LogicalBridgeStatus{}
+		}
+		changed := o.Status.OperStatus != value
+		o.Status.OperStatus = value
+		return changed
+	case *pb.BridgePort:
+		value := pb.BPOperStatus_BP_OPER_STATUS_DOWN
+		if up {
+			value = pb.BPOperStatus_BP_OPER_STATUS_UP
+		}
+		if o.Status == nil {
+			o.Status = &pb.BridgePortStatus{}
+		}
+		changed := o.Status.OperStatus != value
+		o.Status.OperStatus = value
+		return changed
+	case *pb.Svi:
+		value := pb.SVIOperStatus_SVI_OPER_STATUS_DOWN
+		if up {
+			value = pb.SVIOperStatus_SVI_OPER_STATUS_UP
+		}
+		if o.Status == nil {
+			o.Status = &pb.SviStatus{}
+		}
+		changed := o.Status.OperStatus != value
+		o.Status.OperStatus = value
+		return changed
+	}
+	return false
+}
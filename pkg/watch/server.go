@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package watch streams change events of the EVPN resources
+package watch
+
+import (
+	"log"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server represents the Server object
+type Server struct {
+	pa.UnimplementedWatchServiceServer
+	store *Store
+}
+
+// NewServer creates initialized instance of Watch server
+func NewServer(store *Store) *Server {
+	if store == nil {
+		log.Panic("nil for Store is not allowed")
+	}
+	return &Server{store: store}
+}
+
+// Watch sends the events matching the request until the client goes away
+func (s *Server) Watch(in *pa.WatchRequest, stream pa.WatchService_WatchServer) error {
+	sub, replay, err := s.store.subscribe(in.Collections, in.ResourceVersion)
+	if err != nil {
+		return err
+	}
+	defer s.store.unsubscribe(sub)
+	for _, event := range replay {
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case event, ok := <-sub.events:
+			if !ok {
+				return status.Error(codes.Unavailable, "watch was closed, resume from the last received resource version")
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,312 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package watch streams change events of the EVPN resources
+package watch
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	"github.com/philippgille/gokv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+)
+
+// historySize is the number of past events kept for resuming watches
+const historySize = 1024
+
+// subscriberBuffer is the number of events queued for a slow watcher,
+// a watcher falling further behind is disconnected and has to resume
+const subscriberBuffer = 256
+
+// name prefixes of the watched collections, by collection name
+var collections = map[string]string{
+	"vrfs":    "//network.opiproject.org/vrfs/",
+	"bridges": "//network.opiproject.org/bridges/",
+	"ports":   "//network.opiproject.org/ports/",
+	"svis":    "//network.opiproject.org/svis/",
+	"routes":  "//network.opiproject.org/routes/",
+}
+
+// KeyedStore is a gokv.Store able to enumerate its keys
+type KeyedStore interface {
+	gokv.Store
+	Keys() []string
+}
+
+// Store is a gokv.Store publishing an event for every write of an EVPN resource
+type Store struct {
+	backend KeyedStore
+	// mu orders writes, so events are published in the order of the writes
+	mu          sync.Mutex
+	version     uint64
+	history     []*pa.WatchEvent
+	subscribers map[*subscriber]bool
+	stopped     bool
+}
+
+// build time check that struct implements interface
+var _ KeyedStore = (*Store)(nil)
+
+type subscriber struct {
+	prefixes []string
+	events   chan *pa.WatchEvent
+}
+
+// NewStore wraps backend. Resource versions start at the current time,
+// so versions from before a restart are never mistaken for new ones.
+func NewStore(backend KeyedStore) *Store {
+	if backend == nil {
+		log.Panic("nil for Store is not allowed")
+	}
+	return &Store{
+		backend:     backend,
+		version:     uint64(time.Now().UnixNano()),
+		subscribers: make(map[*subscriber]bool),
+	}
+}
+
+// Set stores the value and publishes ADDED or MODIFIED, writes
+// which do not change the resource publish nothing
+func (s *Store) Set(k string, v interface{}) error {
+	old := NewResource(k)
+	msg, ok := v.(proto.Message)
+	if old == nil || !ok {
+		return s.backend.Set(k, v)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found, err := s.backend.Get(k, old)
+	if err != nil {
+		return err
+	}
+	if err := s.backend.Set(k, v); err != nil {
+		return err
+	}
+	switch {
+	case !found:
+		s.publish(pa.WatchEvent_EVENT_TYPE_ADDED, k, msg)
+	case !proto.Equal(old, msg):
+		s.publish(pa.WatchEvent_EVENT_TYPE_MODIFIED, k, msg)
+	}
+	return nil
+}
+
+// Get reads from the backend
+func (s *Store) Get(k string, v interface{}) (bool, error) {
+	return s.backend.Get(k, v)
+}
+
+// Delete deletes the value and publishes DELETED with its last state
+func (s *Store) Delete(k string) error {
+	old := NewResource(k)
+	if old == nil {
+		return s.backend.Delete(k)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found, err := s.backend.Get(k, old)
+	if err != nil {
+		return err
+	}
+	if err := s.backend.Delete(k); err != nil {
+		return err
+	}
+	if found {
+		s.publish(pa.WatchEvent_EVENT_TYPE_DELETED, k, old)
+	}
+	return nil
+}
+
+// Close ends all watches and closes the backend
+func (s *Store) Close() error {
+	s.StopWatches()
+	return s.backend.Close()
+}
+
+// StopWatches ends all watches and refuses new ones, so that a graceful
+// stop of the gRPC server does not wait for streams which never end
+func (s *Store) StopWatches() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stopped = true
+	for sub := range s.subscribers {
+		s.unsubscribeLocked(sub)
+	}
+}
+
+// Keys returns the keys of the backend
+func (s *Store) Keys() []string {
+	return s.backend.Keys()
+}
+
+// subscribe registers a watcher of the given collections. With version zero
+// it returns ADDED events for the current resources, otherwise the events
+// after version, which must still be in the history.
+func (s *Store) subscribe(names []string, version uint64) (*subscriber, []*pa.WatchEvent, error) {
+	sub := &subscriber{events: make(chan *pa.WatchEvent, subscriberBuffer)}
+	if len(names) == 0 {
+		for _, prefix := range collections {
+			sub.prefixes = append(sub.prefixes, prefix)
+		}
+	}
+	for _, name := range names {
+		prefix, ok := collections[name]
+		if !ok {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "unknown collection %q, use vrfs, bridges, ports, svis or routes", name)
+		}
+		sub.prefixes = append(sub.prefixes, prefix)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopped {
+		return nil, nil, status.Error(codes.Unavailable, "server is shutting down")
+	}
+	var replay []*pa.WatchEvent
+	if version == 0 {
+		for _, k := range s.backend.Keys() {
+			obj := NewResource(k)
+			if obj == nil || !sub.matches(k) {
+				continue
+			}
+			found, err := s.backend.Get(k, obj)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !found {
+				continue
+			}
+			event, err := newEvent(pa.WatchEvent_EVENT_TYPE_ADDED, s.version, k, obj)
+			if err != nil {
+				log.Printf("Skipping %s in replay: %v", k, err)
+				continue
+			}
+			replay = append(replay, event)
+		}
+	} else {
+		if !s.resumable(version) {
+			return nil, nil, status.Errorf(codes.OutOfRange, "resource version %d is not available anymore, restart the watch from version 0", version)
+		}
+		for _, event := range s.history {
+			if event.ResourceVersion > version && sub.matches(event.Name) {
+				replay = append(replay, event)
+			}
+		}
+	}
+	s.subscribers[sub] = true
+	return sub, replay, nil
+}
+
+// resumable tells whether all events after version are still in the history,
+// must be called with mu held
+func (s *Store) resumable(version uint64) bool {
+	if version == s.version {
+		return true
+	}
+	if version > s.version || len(s.history) == 0 {
+		return false
+	}
+	return version >= s.history[0].ResourceVersion-1
+}
+
+// unsubscribe removes the watcher and closes its channel
+func (s *Store) unsubscribe(sub *subscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.unsubscribeLocked(sub)
+}
+
+func (s *Store) unsubscribeLocked(sub *subscriber) {
+	if s.subscribers[sub] {
+		delete(s.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+// publish must be called with mu held
+func (s *Store) publish(eventType pa.WatchEvent_EventType, k string, obj proto.Message) {
+	event, err := newEvent(eventType, s.version+1, k, proto.Clone(obj))
+	if err != nil {
+		log.Printf("Not publishing %v of %s: %v", eventType, k, err)
+		return
+	}
+	s.version++
+	s.history = append(s.history, event)
+	if len(s.history) > historySize {
+		s.history = s.history[len(s.history)-historySize:]
+	}
+	for sub := range s.subscribers {
+		if !sub.matches(k) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			log.Printf("Watcher is too slow, dropping it at version %d", event.ResourceVersion)
+			s.unsubscribeLocked(sub)
+		}
+	}
+}
+
+func (sub *subscriber) matches(k string) bool {
+	for _, prefix := range sub.prefixes {
+		if strings.HasPrefix(k, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewResource returns an empty message of the resource stored under key,
+// or nil if the key does not belong to an EVPN resource
+func NewResource(k string) proto.Message {
+	// singletons like options are stored below the name of their resource
+	if strings.Count(k, "/") > strings.Count(collections["vrfs"], "/") {
+		return nil
+	}
+	switch {
+	case strings.HasPrefix(k, collections["vrfs"]):
+		return new(pb.Vrf)
+	case strings.HasPrefix(k, collections["bridges"]):
+		return new(pb.LogicalBridge)
+	case strings.HasPrefix(k, collections["ports"]):
+		return new(pb.BridgePort)
+	case strings.HasPrefix(k, collections["svis"]):
+		return new(pb.Svi)
+	case strings.HasPrefix(k, collections["routes"]):
+		return new(pa.StaticRoute)
+	}
+	return nil
+}
+
+// newEvent wraps obj into an event, it fails for messages which are
+// not a resource of the watch API
+func newEvent(eventType pa.WatchEvent_EventType, version uint64, k string, obj proto.Message) (*pa.WatchEvent, error) {
+	event := &pa.WatchEvent{Type: eventType, ResourceVersion: version, Name: k}
+	switch o := obj.(type) {
+	case *pb.Vrf:
+		event.Resource = &pa.WatchEvent_Vrf{Vrf: o}
+	case *pb.LogicalBridge:
+		event.Resource = &pa.WatchEvent_LogicalBridge{LogicalBridge: o}
+	case *pb.BridgePort:
+		event.Resource = &pa.WatchEvent_BridgePort{BridgePort: o}
+	case *pb.Svi:
+		event.Resource = &pa.WatchEvent_Svi{Svi: o}
+	case *pa.StaticRoute:
+		event.Resource = &pa.WatchEvent_StaticRoute{StaticRoute: o}
+	default:
+		return nil, fmt.Errorf("unexpected resource %T", obj)
+	}
+	return event, nil
+}
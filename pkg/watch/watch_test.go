@@ -0,0 +1,326 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+
+// Package watch streams change events of the EVPN resources
+package watch
+
+import (
+	"context"
+	"io"
+	"log"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/vishvananda/netlink"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/opiproject/opi-api/network/evpn-gw/v1alpha1/gen/go"
+
+	pa "github.com/opiproject/opi-evpn-bridge/api/v1alpha1/gen/go"
+	"github.com/opiproject/opi-evpn-bridge/pkg/config"
+	"github.com/opiproject/opi-evpn-bridge/pkg/store"
+	"github.com/opiproject/opi-evpn-bridge/pkg/utils/mocks"
+)
+
+var (
+	testVrfName    = "//network.opiproject.org/vrfs/blue"
+	testBridgeName = "//network.opiproject.org/bridges/crimson"
+	testSviName    = "//network.opiproject.org/svis/yellow"
+	testVrf        = pb.Vrf{
+		Name:   testVrfName,
+		Spec:   &pb.VrfSpec{Vni: proto.Uint32(1000)},
+		Status: &pb.VrfStatus{LocalAs: 4},
+	}
+	testBridge = pb.LogicalBridge{
+		Name:   testBridgeName,
+		Spec:   &pb.LogicalBridgeSpec{VlanId: 10, Vni: proto.Uint32(10)},
+		Status: &pb.LogicalBridgeStatus{OperStatus: pb.LBOperStatus_LB_OPER_STATUS_UP},
+	}
+	testSvi = pb.Svi{
+		Name:   testSviName,
+		Spec:   &pb.SviSpec{Vrf: testVrfName, LogicalBridge: testBridgeName},
+		Status: &pb.SviStatus{OperStatus: pb.SVIOperStatus_SVI_OPER_STATUS_UP},
+	}
+)
+
+func newTestStore(t *testing.T) *Store {
+	kvStore, err := store.NewStore(config.DatabaseConfig{Type: config.MemoryStore})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewStore(kvStore)
+}
+
+// receive reads the events queued for the subscriber
+func receive(sub *subscriber) []*pa.WatchEvent {
+	var events []*pa.WatchEvent
+	for {
+		select {
+		case event, ok := <-sub.events:
+			if !ok {
+				return events
+			}
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func eventTypes(events []*pa.WatchEvent) []pa.WatchEvent_EventType {
+	var types []pa.WatchEvent_EventType
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+	return types
+}
+
+func TestWatch_Events(t *testing.T) {
+	s := newTestStore(t)
+	sub, replay, err := s.subscribe(nil, 0)
+	if err != nil || len(replay) != 0 {
+		t.Fatal("Expect empty replay, received", replay, err)
+	}
+	start := s.version
+
+	modified := proto.Clone(&testVrf).(*pb.Vrf)
+	modified.Spec.Vni = proto.Uint32(2000)
+	_ = s.Set(testVrfName, &testVrf)
+	_ = s.Set(testVrfName, &testVrf)
+	_ = s.Set(testVrfName, modified)
+	_ = s.Delete(testVrfName)
+	_ = s.Delete(testVrfName)
+
+	events := receive(sub)
+	expected := []pa.WatchEvent_EventType{
+		pa.WatchEvent_EVENT_TYPE_ADDED,
+		pa.WatchEvent_EVENT_TYPE_MODIFIED,
+		pa.WatchEvent_EVENT_TYPE_DELETED,
+	}
+	if types := eventTypes(events); len(types) != len(expected) || types[0] != expected[0] || types[1] != expected[1] || types[2] != expected[2] {
+		t.Fatal("Expect", expected, "received", types)
+	}
+	for i, event := range events {
+		if event.ResourceVersion != start+uint64(i)+1 || event.Name != testVrfName {
+			t.Error("Unexpected event", event)
+		}
+	}
+	if !proto.Equal(events[2].GetVrf(), modified) {
+		t.Error("Expect deleted event to carry the last state, received", events[2].GetVrf())
+	}
+}
+
+func TestWatch_StaticRouteEvents(t *testing.T) {
+	s := newTestStore(t)
+	sub, _, err := s.subscribe([]string{"routes"}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	route := &pa.StaticRoute{
+		Name: "//network.opiproject.org/routes/default",
+		Spec: &pa.StaticRouteSpec{Vrf: testVrfName, Metric: 10},
+	}
+	_ = s.Set(testVrfName, &testVrf)
+	_ = s.Set(route.Name, route)
+
+	events := receive(sub)
+	if len(events) != 1 || events[0].Type != pa.WatchEvent_EVENT_TYPE_ADDED || events[0].Name != route.Name {
+		t.Fatal("Expect ADDED event of the route only, received", events)
+	}
+	if !proto.Equal(events[0].GetStaticRoute(), route) {
+		t.Error("Expect event to carry the route, received", events[0].GetStaticRoute())
+	}
+}
+
+func TestWatch_UnknownResource(t *testing.T) {
+	s := newTestStore(t)
+	sub, _, err := s.subscribe(nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := s.version
+	// a message which is no resource of the watch API is stored, but not published
+	options := &pa.LogicalBridgeOptions{Name: testBridgeName + "/options"}
+	if err := s.Set(testBridgeName, options); err != nil {
+		t.Fatal("Expect write to succeed, received", err)
+	}
+	if events := receive(sub); len(events) != 0 || s.version != start {
+		t.Fatal("Expect no event, received", events)
+	}
+	_ = s.Set(testVrfName, &testVrf)
+	if events := receive(sub); len(events) != 1 || events[0].ResourceVersion != start+1 {
+		t.Error("Expect ADDED event of the VRF only, received", events)
+	}
+}
+
+func TestWatch_Subscribe(t *testing.T) {
+	s := newTestStore(t)
+	start := s.version
+	_ = s.Set(testVrfName, &testVrf)
+	_ = s.Set(testBridgeName, &testBridge)
+
+	tests := map[string]struct {
+		collections []string
+		version     uint64
+		errCode     codes.Code
+		names       []string
+	}{
+		"current state": {
+			version: 0,
+			errCode: codes.OK,
+			names:   []string{testBridgeName, testVrfName},
+		},
+		"current state of one collection": {
+			collections: []string{"bridges"},
+			version:     0,
+			errCode:     codes.OK,
+			names:       []string{testBridgeName},
+		},
+		"resume": {
+			version: start + 1,
+			errCode: codes.OK,
+			names:   []string{testBridgeName},
+		},
+		"resume at latest version": {
+			version: start + 2,
+			errCode: codes.OK,
+		},
+		"version from before restart": {
+			version: start - 1,
+			errCode: codes.OutOfRange,
+		},
+		"version from the future": {
+			version: start + 3,
+			errCode: codes.OutOfRange,
+		},
+		"unknown collection": {
+			collections: []string{"interfaces"},
+			errCode:     codes.InvalidArgument,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			sub, replay, err := s.subscribe(tt.collections, tt.version)
+			if status.Code(err) != tt.errCode {
+				t.Fatal("error code: expected", tt.errCode, "received", err)
+			}
+			if err != nil {
+				return
+			}
+			defer s.unsubscribe(sub)
+			if len(replay) != len(tt.names) {
+				t.Fatal("Expect", tt.names, "received", replay)
+			}
+			for i, event := range replay {
+				if event.Name != tt.names[i] {
+					t.Error("Expect", tt.names[i], "received", event.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestWatch_SlowWatcher(t *testing.T) {
+	s := newTestStore(t)
+	sub, _, err := s.subscribe(nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i <= subscriberBuffer; i++ {
+		vrf := proto.Clone(&testVrf).(*pb.Vrf)
+		vrf.Spec.Vni = proto.Uint32(uint32(i))
+		_ = s.Set(testVrfName, vrf)
+	}
+	if s.subscribers[sub] {
+		t.Fatal("Expect slow watcher to be dropped")
+	}
+	if events := receive(sub); len(events) != subscriberBuffer {
+		t.Error("Expect buffered events to be delivered, received", len(events))
+	}
+}
+
+func TestWatch_MonitorLinks(t *testing.T) {
+	s := newTestStore(t)
+	_ = s.Set(testBridgeName, &testBridge)
+	_ = s.Set(testSviName, &testSvi)
+	sub, _, err := s.subscribe(nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mockNetlink := mocks.NewNetlink(t)
+	mockNetlink.EXPECT().LinkSubscribe(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, ch chan<- netlink.LinkUpdate, _ <-chan struct{}) {
+			// vlan of the SVI lost its carrier
+			down := &netlink.Vlan{LinkAttrs: netlink.LinkAttrs{Name: "vlan10", Flags: net.FlagUp, OperState: netlink.OperLowerLayerDown}}
+			ch <- netlink.LinkUpdate{Link: down}
+			close(ch)
+		}).Return(nil).Once()
+	if err := s.MonitorLinks(ctx, mockNetlink); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case event := <-sub.events:
+		if event.Type != pa.WatchEvent_EVENT_TYPE_MODIFIED || event.GetSvi().GetStatus().GetOperStatus() != pb.SVIOperStatus_SVI_OPER_STATUS_DOWN {
+			t.Error("Expect SVI to go down, received", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expect event for the SVI")
+	}
+	bridge := new(pb.LogicalBridge)
+	if _, err := s.Get(testBridgeName, bridge); err != nil || bridge.Status.OperStatus != pb.LBOperStatus_LB_OPER_STATUS_UP {
+		t.Error("Expect bridge to stay up, received", bridge.Status)
+	}
+}
+
+func TestWatch_Server(t *testing.T) {
+	s := newTestStore(t)
+	_ = s.Set(testVrfName, &testVrf)
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	pa.RegisterWatchServiceServer(server, NewServer(s))
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			log.Fatal(err)
+		}
+	}()
+	defer server.Stop()
+	conn, err := grpc.DialContext(context.Background(), "",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	stream, err := pa.NewWatchServiceClient(conn).Watch(context.Background(), &pa.WatchRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	event, err := stream.Recv()
+	if err != nil || event.Type != pa.WatchEvent_EVENT_TYPE_ADDED || !proto.Equal(event.GetVrf(), &testVrf) {
+		t.Fatal("Expect current VRF, received", event, err)
+	}
+	_ = s.Set(testBridgeName, &testBridge)
+	event, err = stream.Recv()
+	if err != nil || event.Type != pa.WatchEvent_EVENT_TYPE_ADDED || event.Name != testBridgeName {
+		t.Fatal("Expect new bridge, received", event, err)
+	}
+	// shutdown ends the stream
+	s.StopWatches()
+	if _, err := stream.Recv(); status.Code(err) != codes.Unavailable && err != io.EOF {
+		t.Error("Expect stream to end, received", err)
+	}
+}